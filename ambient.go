@@ -5,6 +5,7 @@ import (
 	"html/template"
 	"io"
 	"net/http"
+	"time"
 )
 
 // PluginCore represents the core of any plugin.
@@ -26,6 +27,7 @@ type Plugin interface {
 	Routes()                                         // optional, called during enable
 	Assets() ([]Asset, FileSystemReader)             // optional, called during enable
 	Settings() []Setting                             // optional, called during special operations
+	ContentTypes() []ContentType                     // optional, called during load
 	GrantRequests() []GrantRequest                   // optional, called during every plugin operation against data provider
 	FuncMap() func(r *http.Request) template.FuncMap // optional, called on every render
 }
@@ -41,7 +43,22 @@ type LoggingPlugin interface {
 // package.
 type StoragePluginGroup struct {
 	Storage    StoragePlugin
+	Mirrors    []StoragePlugin // optional, receive a copy of every write and are read if the storage fails
 	Encryption StorageEncryption
+	Snapshots  *SnapshotPolicy // optional, keeps point-in-time copies of the site
+	Codec      StorageCodec    // optional, defaults to JSON
+	Compress   bool            // optional, compresses the data before it is encrypted
+	Checksum   bool            // optional, adds a checksum to unencrypted JSON so truncated data is reported as corrupt
+
+	PostRevisionLimit    int // optional, number of revisions to keep for each post, defaults to 20
+	MigrationBackupLimit int // optional, number of backups taken before schema migrations to keep, defaults to 5
+
+	SessionEncryption    StorageEncryption // optional, defaults to Encryption for the session storage
+	SessionSweepInterval time.Duration     // optional, removes expired sessions on this interval if the session storage can't expire them
+
+	MigrationDryRun bool          // optional, reports the schema migrations without saving them and refuses writes
+	WatchInterval   time.Duration // optional, checks for changes by another process if the storage can't be watched
+	FlushInterval   time.Duration // optional, holds the changes in memory and writes them together on this interval
 }
 
 // StoragePlugin represents a storage plugin.
@@ -58,6 +75,27 @@ type StorageEncryption interface {
 	Decrypt(enc []byte) ([]byte, error)
 }
 
+// EncryptionRotator represents storage that can re-encrypt its data with the
+// current encryption key.
+type EncryptionRotator interface {
+	RotateEncryption() error
+}
+
+// StorageRepairer represents storage that is copied to mirrors and can
+// re-sync mirrors that missed writes.
+type StorageRepairer interface {
+	StorageDivergence() []ReplicaDivergence
+	RepairStorage() error
+}
+
+// StorageCodec represents a serialization format for the storage data. The
+// name is written with the data so it can be detected when read.
+type StorageCodec interface {
+	Name() string
+	Marshal(v interface{}) ([]byte, error)
+	Unmarshal(data []byte, v interface{}) error
+}
+
 // RouterPlugin represents a router engine plugin.
 type RouterPlugin interface {
 	PluginCore
@@ -6,21 +6,41 @@ type DataStorer interface {
 	Load() ([]byte, error)
 }
 
-// // DataStorer reads and writes data to a store.
-// type DataStorer interface {
-// 	// Commit should add the data to the store. If the key already exists, then
-// 	// the data and should be overwritten.
-// 	Commit(collection string, key string, b []byte) (err error)
+// KeyedDataStorer reads and writes data to a store.
+type KeyedDataStorer interface {
+	// Commit should add the data to the store. If the key already exists, then
+	// the data and should be overwritten.
+	Commit(collection string, key string, b []byte) (err error)
 
-// 	// Find should return the data for a key from the store. If the key is not
-// 	// found, the found return value should be false (and the err return value
-// 	// should be nil). Similarly, malformed data should result in a found return
-// 	// value of false and a nil err value. The err return value should be used
-// 	// for system errors only.
-// 	Find(collection string, key string) (b []byte, found bool, err error)
+	// Find should return the data for a key from the store. If the key is not
+	// found, the found return value should be false (and the err return value
+	// should be nil). Similarly, malformed data should result in a found return
+	// value of false and a nil err value. The err return value should be used
+	// for system errors only.
+	Find(collection string, key string) (b []byte, found bool, err error)
 
-// 	// Delete should remove the key and corresponding data from the store. If
-// 	// the key does not exist then Delete should be a no-op and return nil
-// 	// (not an error).
-// 	Delete(collection string, key string) (err error)
-// }
+	// Delete should remove the key and corresponding data from the store. If
+	// the key does not exist then Delete should be a no-op and return nil
+	// (not an error).
+	Delete(collection string, key string) (err error)
+
+	// Keys should return the list of keys in a collection. If the collection
+	// does not exist, then Keys should return an empty list (not an error).
+	Keys(collection string) (keys []string, err error)
+}
+
+// ChangeTokenStorer is implemented by data storers that can return a token
+// that changes every time the data is written, like a modified time or an
+// ETag. It's used to detect changes made by another process.
+type ChangeTokenStorer interface {
+	// ChangeToken should return a value that is different after each write.
+	ChangeToken() (token string, err error)
+}
+
+// WatchableStorer is implemented by data storers that are notified when the
+// data changes, like a file watcher.
+type WatchableStorer interface {
+	// Watch should call the changed function after each change to the data
+	// until the stop function is called.
+	Watch(changed func()) (stop func(), err error)
+}
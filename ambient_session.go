@@ -17,3 +17,10 @@ type AppSession interface {
 	SetSessionValue(r *http.Request, name string, value string) error
 	DeleteSessionValue(r *http.Request, name string)
 }
+
+// AppUserSessions is implemented by session managers that can list and log
+// out the sessions of any user.
+type AppUserSessions interface {
+	UserSessions(r *http.Request, username string) ([]UserSession, error)
+	LogoutUser(r *http.Request, username string) error
+}
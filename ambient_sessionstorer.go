@@ -1,7 +1,44 @@
 package ambient
 
+import "time"
+
 // SessionStorer reads and writes data to an object.
 type SessionStorer interface {
 	Save([]byte) error
 	Load() ([]byte, error)
 }
+
+// KeyedSessionStorer reads and writes one session at a time so a login
+// doesn't rewrite every session. Each session expires on its own and can be
+// found by the user it belongs to.
+type KeyedSessionStorer interface {
+	// Commit should add the session data to the store. If the token already
+	// exists, then the data and the expiry should be overwritten. The username
+	// is empty if the session isn't logged in.
+	Commit(token string, username string, b []byte, expiry time.Time) (err error)
+
+	// Find should return the session data for a token from the store. If the
+	// token is not found or the session is expired, the found return value
+	// should be false (and the err return value should be nil).
+	Find(token string) (b []byte, found bool, err error)
+
+	// Delete should remove the session from the store. If the token does not
+	// exist then Delete should be a no-op and return nil (not an error).
+	Delete(token string) (err error)
+
+	// UserSessions should return the sessions for a username that are not
+	// expired.
+	UserSessions(username string) (sessions []UserSession, err error)
+
+	// DeleteUserSessions should remove all of the sessions for a username.
+	DeleteUserSessions(username string) (err error)
+}
+
+// SessionRewriter is implemented by keyed session storers that can replace the
+// data of every session, like when the session encryption is rotated.
+type SessionRewriter interface {
+	// Rewrite should call the function with the data of each session that is
+	// not expired and store the data it returns, keeping the username and the
+	// expiry.
+	Rewrite(fn func(b []byte) ([]byte, error)) (err error)
+}
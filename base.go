@@ -39,6 +39,11 @@ func (p *PluginBase) Settings() []Setting {
 	return nil
 }
 
+// ContentTypes returns a list of content types and custom fields for posts.
+func (p *PluginBase) ContentTypes() []ContentType {
+	return nil
+}
+
 // GrantRequests returns a list of grants requested by the plugin.
 func (p *PluginBase) GrantRequests() []GrantRequest {
 	return nil
@@ -32,3 +32,32 @@ func GlobalFuncMap(fm template.FuncMap) template.FuncMap {
 
 	return fm
 }
+
+// Navigation represents the menus and page trees that are available in all
+// templates.
+type Navigation interface {
+	MenuLinks(name string) []MenuLink
+	Breadcrumbs(ID string) PostWithIDList
+	PageChildren(ID string) PostWithIDList
+}
+
+// NavigationFuncMap returns the FuncMaps for the menus and page trees so
+// themes can render them the same way. The functions are:
+// Menu "name", Breadcrumbs "postID", and PageChildren "postID".
+func NavigationFuncMap(fm template.FuncMap, nav Navigation) template.FuncMap {
+	if fm == nil {
+		fm = template.FuncMap{}
+	}
+
+	fm["Menu"] = func(name string) []MenuLink {
+		return nav.MenuLinks(name)
+	}
+	fm["Breadcrumbs"] = func(ID string) PostWithIDList {
+		return nav.Breadcrumbs(ID)
+	}
+	fm["PageChildren"] = func(ID string) PostWithIDList {
+		return nav.PageChildren(ID)
+	}
+
+	return fm
+}
@@ -8,15 +8,54 @@ import (
 
 // PluginSystem provides config functions.
 type PluginSystem interface {
+	// Subscribe returns a channel that receives the changes to the site and a
+	// function to stop receiving them.
+	Subscribe() (<-chan Event, func())
+	// SubscribePlugin returns a channel that receives the changes to the site for
+	// a plugin and a function to stop receiving them. The channel is closed when
+	// the plugin is disabled or removed, or when the grant to read the events is
+	// removed.
+	SubscribePlugin(pluginName string) (<-chan Event, func())
+	// ContentTypes returns the content types with the custom fields from the
+	// enabled plugins sorted by name. The post and page content types are always
+	// included.
+	ContentTypes() []ContentType
+	// TagDetails returns the tags with their metadata and the number of posts
+	// with each tag sorted by name.
+	TagDetails(onlyPublished bool) []TagDetail
+	// TagBySlug returns the tag with the slug. If more than one tag has the slug,
+	// the tag with the lowest name is returned.
+	TagBySlug(slug string, onlyPublished bool) (TagDetail, error)
+	// RenameTag renames a tag on every post and keeps its metadata. Returns
+	// amberror.ErrInvalidTag if the new name is empty or is already used by a
+	// post.
+	RenameTag(name string, newName string) error
+	// MergeTags replaces the tag with the other tag on every post. The metadata
+	// of the replaced tag is removed. Returns amberror.ErrNotFound if either tag
+	// is not on a post.
+	MergeTags(name string, into string) error
+	// DeleteTag removes a tag from every post along with its metadata. Returns
+	// amberror.ErrNotFound if the tag is not on a post.
+	DeleteTag(name string) error
+	// SetTagMeta sets the description and slug of a tag. An empty slug is
+	// generated from the tag name. Returns amberror.ErrNotFound if the tag is not
+	// on a post and amberror.ErrInvalidTag if the slug is used by another tag.
+	SetTagMeta(name string, meta TagMeta) error
+	// PageChildren returns the published posts and pages under the parent sorted
+	// by order and then by title. If the ID is empty, the published top level
+	// pages are returned.
+	PageChildren(ID string) PostWithIDList
+	// Breadcrumbs returns the post with its published parent pages with the top
+	// level page first. Returns an empty list if the post is not found.
+	Breadcrumbs(ID string) PostWithIDList
 	// LoaderPlugins returns the loader plugins, these include initial gRPC plugins
 	// as well.
 	LoaderPlugins() []Plugin
 	// LoaderMiddleware returns the loader middleware, these include initial gRPC plugins
 	// as well.
 	LoaderMiddleware() []MiddlewarePlugin
-	// Plugins returns the map of plugins. This returns a map that is passed by
-	// reference and all the values are pointers so any changes to it will
-	// be reflected in the plugin system.
+	// Plugins returns a copy of the map of plugins. The values are pointers so
+	// changes to the plugins will be reflected in the plugin system.
 	Plugins() map[string]Plugin
 	// SessionManager returns the session manager.
 	SessionManager() SessionManagerPlugin
@@ -79,6 +118,74 @@ type PluginSystem interface {
 	SettingDefault(pluginName string, settingName string) (interface{}, error)
 	// SetRoute saves a route.
 	SetRoute(pluginName string, route []Route)
+	// RotateEncryption re-encrypts the storage with the current encryption key.
+	RotateEncryption() error
+	// Batch runs the function and writes the changes it makes together once it
+	// returns instead of after each change. It's a batch, not a transaction:
+	// there is no rollback, so changes made before an error are still written.
+	// Changes made by other callers while the function runs are written in the
+	// same batch. In write-behind mode, the changes are written on the next
+	// flush.
+	Batch(fn func() error) (err error)
+	// Flush writes the changes that are held in memory.
+	Flush() error
+	// Menus returns the menus sorted by name.
+	Menus() []Menu
+	// Menu returns the menu by name.
+	Menu(name string) (Menu, error)
+	// SaveMenu saves a menu by its name. Returns amberror.ErrInvalidMenu if the
+	// menu doesn't have a name or an item doesn't link to exactly one saved post
+	// or URL.
+	SaveMenu(menu Menu) error
+	// DeleteMenu deletes a menu by name.
+	DeleteMenu(name string) error
+	// MenuLinks returns the links of a menu so it can be rendered. Links to posts
+	// that are missing or not published are left out along with their children.
+	// Returns an empty list if the menu is not found.
+	MenuLinks(name string) []MenuLink
+	// PluginStoreGet returns a value from the private store of a plugin.
+	PluginStoreGet(pluginName string, key string) ([]byte, error)
+	// PluginStorePut writes a value to the private store of a plugin.
+	PluginStorePut(pluginName string, key string, value []byte) error
+	// PluginStoreDelete removes a value from the private store of a plugin.
+	PluginStoreDelete(pluginName string, key string) error
+	// PluginStoreList returns the keys in the private store of a plugin.
+	PluginStoreList(pluginName string) ([]string, error)
+	// CreatePostPreview returns a new preview of a post with a token that is
+	// valid until it expires or is revoked. The token expires after a week if
+	// the duration is 0. Storage encryption must be set.
+	CreatePostPreview(ID string, expires time.Duration, author string) (PostPreview, error)
+	// PostPreviews returns the previews of a post that have not expired with the
+	// newest first.
+	PostPreviews(ID string) ([]PostPreview, error)
+	// RevokePostPreview revokes the token of a post preview.
+	RevokePostPreview(ID string, previewID string) error
+	// PreviewPost returns the post for a preview token even if it's not
+	// published.
+	PreviewPost(token string) (PostWithID, error)
+	// SavePostAs saves a post and keeps a revision with the username as the
+	// author. If the post doesn't have an author, the author is kept from the
+	// saved post or set to the username for a new post. The custom fields are
+	// validated against the content type of the post and the parent must be a
+	// saved post that isn't a child of the post.
+	SavePostAs(ID string, post Post, author string) error
+	// PostRevisions returns the revisions of a post with the newest first.
+	PostRevisions(ID string) ([]PostRevision, error)
+	// PostRevision returns a revision of a post.
+	PostRevision(ID string, revisionID string) (PostRevision, error)
+	// RestorePostRevision saves the post from a revision. The post is validated
+	// like SavePostAs and the restore is kept as a new revision so it can be
+	// undone.
+	RestorePostRevision(ID string, revisionID string, author string) error
+	// PostRevisionDiff returns a unified diff of the title, tags, custom fields,
+	// and content from a revision to the current post.
+	PostRevisionDiff(ID string, revisionID string) (string, error)
+	// PublishScheduledPosts sends an event for each published post that reached
+	// its publish or unpublish time since the last check. The posts are not
+	// changed since Post.IsPublished uses the schedule. The time of the check is
+	// stored so the events are not sent again after a restart. The first check on
+	// a site only stores the time so the events are not sent for old posts.
+	PublishScheduledPosts(now time.Time) error
 	// SetTitle sets the title.
 	SetTitle(title string) error
 	// Title returns the title.
@@ -111,8 +218,24 @@ type PluginSystem interface {
 	PublishedPages() []Post
 	// PostBySlug returns the post by slug.
 	PostBySlug(slug string) PostWithID
+	// SearchPosts returns a page of the posts that match the query with the best
+	// match first. A limit of 0 returns all of the matches.
+	SearchPosts(query string, onlyPublished bool, limit int, offset int) PostSearchResults
+	// QueryPosts returns a page of the posts that match the query.
+	QueryPosts(q PostQuery) (PostQueryResult, error)
 	// PostByID returns the post by ID.
 	PostByID(ID string) (Post, error)
 	// DeletePostByID deletes a post.
 	DeletePostByID(ID string) error
+	// Snapshots returns the list of site snapshots with the newest first.
+	Snapshots() ([]Snapshot, error)
+	// CreateSnapshot stores a copy of the current site.
+	CreateSnapshot() (Snapshot, error)
+	// SnapshotDiff returns the changes that restoring a snapshot would make.
+	SnapshotDiff(ID string) ([]SiteChange, error)
+	// RestoreSnapshot replaces the site with a snapshot.
+	RestoreSnapshot(ID string) error
+	// ReloadIfChanged reloads the site if the storage was written by another
+	// process and returns the changes.
+	ReloadIfChanged() ([]SiteChange, bool, error)
 }
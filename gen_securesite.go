@@ -15,6 +15,10 @@ type SecureSite interface {
 	Load() error
 	// Authorized determines if the current context has access.
 	Authorized(grant Grant) bool
+	// Subscribe returns a channel that receives the changes to the site and a
+	// function to stop receiving them. The channel is closed when the plugin is
+	// disabled or the grant is removed.
+	Subscribe() (<-chan Event, func(), error)
 	// NeighborPluginGrantList gets the grants requests for a neighbor plugin.
 	NeighborPluginGrantList(pluginName string) ([]GrantRequest, error)
 	// NeighborPluginGrants gets the map of granted permissions.
@@ -26,6 +30,21 @@ type SecureSite interface {
 	NeighborPluginRequestedGrant(pluginName string, grantName Grant) (bool, error)
 	// SetNeighborPluginGrant sets a grant for a neighbor plugin.
 	SetNeighborPluginGrant(pluginName string, grantName Grant, granted bool) error
+	// Menus returns the menus sorted by name.
+	Menus() ([]Menu, error)
+	// Menu returns a menu by name.
+	Menu(name string) (Menu, error)
+	// SaveMenu saves a menu by its name.
+	SaveMenu(menu Menu) error
+	// DeleteMenu deletes a menu by name.
+	DeleteMenu(name string) error
+	// PageChildren returns the published posts and pages under the parent sorted
+	// by order and then by title. If the ID is empty, the published top level
+	// pages are returned.
+	PageChildren(ID string) (PostWithIDList, error)
+	// Breadcrumbs returns the post with its published parent pages with the top
+	// level page first.
+	Breadcrumbs(ID string) (PostWithIDList, error)
 	// Plugins returns the plugin list.
 	Plugins() (map[string]PluginData, error)
 	// PluginNames returns the list of plugin name.
@@ -38,6 +57,14 @@ type SecureSite interface {
 	LoadSinglePluginPages(name string)
 	// DisablePlugin disables a plugin.
 	DisablePlugin(pluginName string, unloadPlugin bool) error
+	// PluginStoreGet returns a value from the private store of the plugin.
+	PluginStoreGet(key string) ([]byte, error)
+	// PluginStorePut writes a value to the private store of the plugin.
+	PluginStorePut(key string, value []byte) error
+	// PluginStoreDelete removes a value from the private store of the plugin.
+	PluginStoreDelete(key string) error
+	// PluginStoreList returns the keys in the private store of the plugin.
+	PluginStoreList() ([]string, error)
 	// SavePost saves a post.
 	SavePost(ID string, post Post) error
 	// PostsAndPages returns the list of posts and pages.
@@ -48,10 +75,45 @@ type SecureSite interface {
 	PublishedPages() ([]Post, error)
 	// PostBySlug returns the post by slug.
 	PostBySlug(slug string) (PostWithID, error)
+	// SearchPosts returns a page of the published posts and pages that match the
+	// query with the best match first. A limit of 0 returns all of the matches.
+	SearchPosts(query string, limit int, offset int) (PostSearchResults, error)
+	// QueryPosts returns a page of the posts that match the query.
+	QueryPosts(q PostQuery) (PostQueryResult, error)
+	// ContentTypes returns the content types with the custom fields from the
+	// enabled plugins sorted by name.
+	ContentTypes() ([]ContentType, error)
 	// PostByID returns the post by ID.
 	PostByID(ID string) (Post, error)
 	// DeletePostByID deletes a post.
 	DeletePostByID(ID string) error
+	// CreatePostPreview returns a new preview of a post with the current user as
+	// the author. The token in the preview allows anyone with it to view the post
+	// until it expires or is revoked. The token expires after a week if the
+	// duration is 0. Storage encryption must be set.
+	CreatePostPreview(r *http.Request, ID string, expires time.Duration) (PostPreview, error)
+	// PostPreviews returns the previews of a post that have not expired with the
+	// newest first. The tokens are not included.
+	PostPreviews(ID string) ([]PostPreview, error)
+	// RevokePostPreview revokes the token of a post preview.
+	RevokePostPreview(ID string, previewID string) error
+	// PreviewPostBySlug returns the post by slug for a request. The post is
+	// returned if it's published or if the request has a valid preview token for
+	// it in the query string.
+	PreviewPostBySlug(r *http.Request, slug string) (PostWithID, error)
+	// SavePostAs saves a post and keeps a revision with the current user as the
+	// author.
+	SavePostAs(r *http.Request, ID string, post Post) error
+	// PostRevisions returns the revisions of a post with the newest first.
+	PostRevisions(ID string) ([]PostRevision, error)
+	// PostRevision returns a revision of a post.
+	PostRevision(ID string, revisionID string) (PostRevision, error)
+	// PostRevisionDiff returns a unified diff of the title, tags, custom fields,
+	// and content from a revision to the current post.
+	PostRevisionDiff(ID string, revisionID string) (string, error)
+	// RestorePostRevision saves the post from a revision with the current user as
+	// the author.
+	RestorePostRevision(r *http.Request, ID string, revisionID string) error
 	// PluginNeighborRoutesList gets the routes for a neighbor plugin.
 	PluginNeighborRoutesList(pluginName string) ([]Route, error)
 	// AuthenticatedUser returns if the current user is authenticated.
@@ -64,6 +126,14 @@ type SecureSite interface {
 	UserLogout(r *http.Request) error
 	// LogoutAllUsers logs out all users.
 	LogoutAllUsers(r *http.Request) error
+	// UserSessions returns the sessions for a user. Returns
+	// amberror.ErrUserSessionsNotSupported if the session manager doesn't support
+	// it.
+	UserSessions(r *http.Request, username string) ([]UserSession, error)
+	// LogoutUser logs out all of the sessions for a user. Returns
+	// amberror.ErrUserSessionsNotSupported if the session manager doesn't support
+	// it.
+	LogoutUser(r *http.Request, username string) error
 	// SetCSRF sets the session with a token and returns the token for use in a form
 	// or header.
 	SetCSRF(r *http.Request) string
@@ -115,4 +185,27 @@ type SecureSite interface {
 	Content() (string, error)
 	// Tags returns the list of tags.
 	Tags(onlyPublished bool) (TagList, error)
+	// Snapshots returns the list of site snapshots with the newest first.
+	Snapshots() ([]Snapshot, error)
+	// CreateSnapshot stores a copy of the current site.
+	CreateSnapshot() (Snapshot, error)
+	// SnapshotDiff returns the changes that restoring a snapshot would make. The
+	// settings of other plugins are redacted unless the plugin can read them.
+	SnapshotDiff(ID string) ([]SiteChange, error)
+	// RestoreSnapshot replaces the site with a snapshot and then enables or
+	// disables the plugins that changed.
+	RestoreSnapshot(ID string) error
+	// TagDetails returns the tags with their description, slug, and the number of
+	// posts with each tag sorted by name.
+	TagDetails(onlyPublished bool) ([]TagDetail, error)
+	// TagBySlug returns the tag with the slug.
+	TagBySlug(slug string, onlyPublished bool) (TagDetail, error)
+	// RenameTag renames a tag on every post.
+	RenameTag(name string, newName string) error
+	// MergeTags replaces the tag with the other tag on every post.
+	MergeTags(name string, into string) error
+	// DeleteTag removes a tag from every post.
+	DeleteTag(name string) error
+	// SetTagMeta sets the description and slug of a tag.
+	SetTagMeta(name string, meta TagMeta) error
 }
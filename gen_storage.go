@@ -2,13 +2,19 @@
 
 package ambient
 
+import (
+	"time"
+)
+
 // Storage provides app config functions.
 type Storage interface {
-	// Save writes the site object to the data storage and returns an error if it
-	// cannot be written.
+	// MigrationReport returns the schema migrations from the last load.
+	MigrationReport() MigrationReport
+	// Save writes the items of the site object that changed to the data storage
+	// and returns an error if they cannot be written.
 	Save() error
-	// SaveDecrypted writes the site object to the data storage always decrypted and
-	// returns an error if it cannot be written.
+	// SaveDecrypted writes the items of the site object that changed to the data
+	// storage always decrypted and returns an error if they cannot be written.
 	SaveDecrypted() error
 	// Load reads the site object from the data storage and returns an error if
 	// it cannot be read.
@@ -16,4 +22,64 @@ type Storage interface {
 	// LoadDecrypted reads the site object from the data storage always decrypted
 	// and returns an error if it cannot be read.
 	LoadDecrypted() error
+	// Flush writes the changes that are held in memory.
+	Flush() error
+	// PluginStoreGet returns a value from the plugin store. Returns
+	// amberror.ErrNotFound if the key is not found.
+	PluginStoreGet(pluginName string, key string) ([]byte, error)
+	// PluginStorePut writes a value to the plugin store.
+	PluginStorePut(pluginName string, key string, value []byte) error
+	// PluginStoreDelete removes a value from the plugin store.
+	PluginStoreDelete(pluginName string, key string) error
+	// PluginStoreList returns the sorted keys in the plugin store.
+	PluginStoreList(pluginName string) ([]string, error)
+	// PluginStoreClear removes all of the values from the plugin store.
+	PluginStoreClear(pluginName string) error
+	// CreatePostPreview returns a new preview with a token that is valid until it
+	// expires or is revoked. The expired previews are removed. Returns
+	// amberror.ErrNotFound if the post is not found and
+	// amberror.ErrEncryptionDisabled if storage encryption is not set since the
+	// key that signs the tokens would be stored in plaintext.
+	CreatePostPreview(postID string, expires time.Duration, author string) (PostPreview, error)
+	// PostPreviews returns the previews of a post that have not expired with the
+	// newest first.
+	PostPreviews(postID string) ([]PostPreview, error)
+	// RevokePostPreview deletes a preview so its token is no longer valid.
+	// Returns amberror.ErrNotFound if the preview is not found.
+	RevokePostPreview(postID string, previewID string) error
+	// PreviewPost returns the post for a preview token even if it's not
+	// published. Returns amberror.ErrInvalidToken if the token is not signed with
+	// the key, has expired, or was revoked, or if storage encryption is not set.
+	PreviewPost(token string) (PostWithID, error)
+	// RecoveryReport returns the fallback from the last load. It's empty if the
+	// stored site was read without a fallback.
+	RecoveryReport() RecoveryReport
+	// PostRevisions returns the revisions of a post with the newest first.
+	PostRevisions(postID string) ([]PostRevision, error)
+	// PostRevision returns a revision of a post. Returns amberror.ErrNotFound if
+	// the revision is not found.
+	PostRevision(postID string, revisionID string) (PostRevision, error)
+	// RotateEncryption re-encrypts the site object, the backups, the post
+	// revisions, the plugin stores, and the snapshots. The storage encryption
+	// should decrypt with the old key and encrypt with the new key, like a
+	// keyring.
+	RotateEncryption() error
+	// CreateSnapshot stores a copy of the current site object and removes the
+	// oldest snapshots over the limit.
+	CreateSnapshot() (Snapshot, error)
+	// Snapshots returns the list of snapshots with the newest first.
+	Snapshots() ([]Snapshot, error)
+	// SnapshotDiff returns the changes that restoring the snapshot would make to
+	// the current site object.
+	SnapshotDiff(ID string) ([]SiteChange, error)
+	// RestoreSnapshot replaces the site object with the snapshot and saves it. A
+	// snapshot of the current site object is taken first so the restore can be
+	// undone.
+	RestoreSnapshot(ID string) error
+	// Changed returns true if the data storage was written by another process
+	// since the last load or save.
+	Changed() (bool, error)
+	// Watch calls the changed function when the data storage changes if it
+	// supports watching. Returns amberror.ErrWatchNotSupported if it doesn't.
+	Watch(changed func()) (func(), error)
 }
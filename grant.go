@@ -26,13 +26,41 @@ const (
 	// GrantSiteLoadTrigger allows trigger access to the site load from data storage.
 	GrantSiteLoadTrigger Grant = "site.load:trigger"
 
+	// GrantSiteSnapshotRead allows read access to the site snapshots and the
+	// differences between a snapshot and the current site.
+	GrantSiteSnapshotRead Grant = "site.snapshot:read"
+	// GrantSiteSnapshotWrite allows access to create and restore site snapshots.
+	GrantSiteSnapshotWrite Grant = "site.snapshot:write"
+
+	// GrantSiteEventRead allows access to subscribe to the changes to the site.
+	GrantSiteEventRead Grant = "site.event:read"
+
 	// GrantSitePostRead allows read access to the site posts.
 	// Allows access to calls like: postsandpages, publishedpages, postbyslug, tags.
 	GrantSitePostRead Grant = "site.post:read"
 	// GrantSitePostWrite allows write access to the site posts.
 	GrantSitePostWrite Grant = "site.post:write"
+	// GrantSitePostSearch allows access to search the published site posts.
+	GrantSitePostSearch Grant = "site.post:search"
+	// GrantSitePostRevisionRead allows read access to the revisions of the site posts.
+	GrantSitePostRevisionRead Grant = "site.postrevision:read"
+	// GrantSitePostRevisionWrite allows access to restore a revision of a site post.
+	GrantSitePostRevisionWrite Grant = "site.postrevision:write"
+	// GrantSitePostPreviewRead allows read access to the preview tokens of the
+	// site posts.
+	GrantSitePostPreviewRead Grant = "site.postpreview:read"
+	// GrantSitePostPreviewWrite allows access to create and revoke preview
+	// tokens for the site posts.
+	GrantSitePostPreviewWrite Grant = "site.postpreview:write"
 	// GrantSitePostDelete allows delete access to the site posts.
 	GrantSitePostDelete Grant = "site.post:delete"
+	// GrantSiteTagWrite allows access to rename, merge, and delete the tags on
+	// the site posts and to change the tag metadata.
+	GrantSiteTagWrite Grant = "site.tag:write"
+	// GrantSiteMenuRead allows read access to the site menus.
+	GrantSiteMenuRead Grant = "site.menu:read"
+	// GrantSiteMenuWrite allows write access to the site menus.
+	GrantSiteMenuWrite Grant = "site.menu:write"
 
 	// GrantSitePluginRead allows read access to the site plugins.
 	GrantSitePluginRead Grant = "site.plugin:read"
@@ -52,6 +80,10 @@ const (
 	GrantPluginSettingRead Grant = "plugin.setting:read"
 	// GrantPluginSettingWrite allows write access to the plugin setting.
 	GrantPluginSettingWrite Grant = "plugin.setting:write"
+	// GrantPluginStorageRead allows read access to the private plugin storage.
+	GrantPluginStorageRead Grant = "plugin.storage:read"
+	// GrantPluginStorageWrite allows write access to the private plugin storage.
+	GrantPluginStorageWrite Grant = "plugin.storage:write"
 	// GrantPluginNeighborSettingRead allows read access to a setting in another plugin.
 	GrantPluginNeighborSettingRead Grant = "plugin.neighborsetting:read"
 	// GrantPluginNeighborSettingWrite allows write access to a setting in another plugin.
@@ -71,6 +103,8 @@ const (
 	GrantUserAuthenticatedWrite Grant = "user.authenticated:write"
 	// GrantUserPersistWrite allows write access to login or logout a user.
 	GrantUserPersistWrite Grant = "user.persist:write"
+	// GrantAllUserAuthenticatedRead allows read access to the sessions of any user.
+	GrantAllUserAuthenticatedRead Grant = "alluser.authenticated:read"
 	// GrantAllUserAuthenticatedWrite allows write access to login or logout any user.
 	GrantAllUserAuthenticatedWrite Grant = "alluser.authenticated:write"
 
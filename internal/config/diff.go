@@ -0,0 +1,109 @@
+package config
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/ambientkit/ambient"
+)
+
+// ignoredChanges are top level fields that change on every save so they are
+// not reported as differences.
+var ignoredChanges = map[string]bool{
+	"updated":  true,
+	"revision": true,
+}
+
+// diffSites returns the changes needed to turn the from site into the to site.
+func diffSites(from *ambient.Site, to *ambient.Site) ([]ambient.SiteChange, error) {
+	fromDoc, err := toDocument(from)
+	if err != nil {
+		return nil, err
+	}
+
+	toDoc, err := toDocument(to)
+	if err != nil {
+		return nil, err
+	}
+
+	return diffDocuments(fromDoc, toDoc), nil
+}
+
+// toDocument converts an object to a generic JSON document.
+func toDocument(v interface{}) (map[string]interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := make(map[string]interface{})
+	err = json.Unmarshal(b, &doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+// diffDocuments returns the changes needed to turn the from document into the
+// to document sorted by path.
+func diffDocuments(from map[string]interface{}, to map[string]interface{}) []ambient.SiteChange {
+	changes := make([]ambient.SiteChange, 0)
+	for key := range ignoredChanges {
+		from, to = withoutKey(from, key), withoutKey(to, key)
+	}
+
+	diffValues(nil, from, to, &changes)
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+
+	return changes
+}
+
+// withoutKey returns a shallow copy of the document without the key.
+func withoutKey(doc map[string]interface{}, key string) map[string]interface{} {
+	if _, found := doc[key]; !found {
+		return doc
+	}
+
+	out := make(map[string]interface{}, len(doc))
+	for k, v := range doc {
+		if k != key {
+			out[k] = v
+		}
+	}
+
+	return out
+}
+
+// diffValues adds a change for every leaf value that is different. Objects
+// are compared field by field while all other values are compared as a whole.
+func diffValues(path []string, from interface{}, to interface{}, changes *[]ambient.SiteChange) {
+	fromMap, fromIsMap := from.(map[string]interface{})
+	toMap, toIsMap := to.(map[string]interface{})
+	if fromIsMap && toIsMap {
+		for k, v := range fromMap {
+			diffValues(append(path, k), v, toMap[k], changes)
+		}
+		for k, v := range toMap {
+			if _, found := fromMap[k]; !found {
+				diffValues(append(path, k), nil, v, changes)
+			}
+		}
+		return
+	}
+
+	if reflect.DeepEqual(from, to) {
+		return
+	}
+
+	*changes = append(*changes, ambient.SiteChange{
+		Path: strings.Join(path, "."),
+		From: from,
+		To:   to,
+	})
+}
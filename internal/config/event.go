@@ -0,0 +1,123 @@
+package config
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ambientkit/ambient"
+)
+
+// eventBuffer is the number of events held for each subscriber before events
+// are dropped.
+const eventBuffer = 64
+
+// eventBus sends events to each of the subscribers.
+type eventBus struct {
+	log ambient.AppLogger
+
+	m    sync.Mutex
+	next int
+	subs map[int]subscriber
+}
+
+// subscriber is a channel that receives the events and the plugin that owns
+// it. The plugin is empty if the subscriber is not a plugin.
+type subscriber struct {
+	plugin string
+	ch     chan ambient.Event
+}
+
+// newEventBus returns an event bus.
+func newEventBus(log ambient.AppLogger) *eventBus {
+	return &eventBus{
+		log:  log,
+		subs: make(map[int]subscriber),
+	}
+}
+
+// publish sends the event to each of the subscribers. Subscribers that are
+// not keeping up miss the event instead of blocking the change.
+func (b *eventBus) publish(e ambient.Event) {
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+
+	b.m.Lock()
+	defer b.m.Unlock()
+
+	for ID, sub := range b.subs {
+		select {
+		case sub.ch <- e:
+		default:
+			b.log.Warn("event subscriber (%v) is full, dropped event: %v", ID, e.Type)
+		}
+	}
+}
+
+// subscribe returns a channel that receives the events and a function to
+// stop receiving events. The channel is closed when unsubscribed or when the
+// subscriptions of the plugin are dropped.
+func (b *eventBus) subscribe(pluginName string) (<-chan ambient.Event, func()) {
+	b.m.Lock()
+	defer b.m.Unlock()
+
+	ID := b.next
+	b.next++
+
+	ch := make(chan ambient.Event, eventBuffer)
+	b.subs[ID] = subscriber{plugin: pluginName, ch: ch}
+
+	unsubscribe := func() {
+		b.m.Lock()
+		defer b.m.Unlock()
+
+		if _, found := b.subs[ID]; found {
+			delete(b.subs, ID)
+			close(ch)
+		}
+	}
+
+	return ch, unsubscribe
+}
+
+// drop closes each of the subscriptions of a plugin.
+func (b *eventBus) drop(pluginName string) {
+	b.m.Lock()
+	defer b.m.Unlock()
+
+	for ID, sub := range b.subs {
+		if sub.plugin == pluginName {
+			delete(b.subs, ID)
+			close(sub.ch)
+		}
+	}
+}
+
+// Subscribe returns a channel that receives the changes to the site and a
+// function to stop receiving them.
+func (p *PluginSystem) Subscribe() (<-chan ambient.Event, func()) {
+	return p.events.subscribe("")
+}
+
+// SubscribePlugin returns a channel that receives the changes to the site for
+// a plugin and a function to stop receiving them. The channel is closed when
+// the plugin is disabled or removed, or when the grant to read the events is
+// removed.
+func (p *PluginSystem) SubscribePlugin(pluginName string) (<-chan ambient.Event, func()) {
+	return p.events.subscribe(pluginName)
+}
+
+// updateEvent runs the change like update while holding the storage lock and
+// then sends the event if the change succeeds.
+func (p *PluginSystem) updateEvent(e ambient.Event, change func() error) error {
+	p.storage.m.Lock()
+	err := p.update(change)
+	p.storage.m.Unlock()
+	if err != nil {
+		return err
+	}
+
+	p.events.publish(e)
+
+	return nil
+}
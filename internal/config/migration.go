@@ -0,0 +1,275 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ambientkit/ambient"
+	"github.com/ambientkit/ambient/pkg/codec"
+)
+
+const (
+	// collectionBackups contains a copy of the site before each migration.
+	collectionBackups = "backups"
+
+	// defaultBackupLimit is the number of migration backups to keep if not
+	// set.
+	defaultBackupLimit = 5
+
+	// fieldSchemaVersion is the name of the schema version in the document.
+	fieldSchemaVersion = "schemaversion"
+)
+
+// migration changes the stored document from the previous schema version to
+// the version of the migration. The document contains the entire site object
+// including the posts and plugins.
+type migration struct {
+	version     int
+	description string
+	migrate     func(doc map[string]interface{}) error
+}
+
+// migrations are run in order on load. Append a new migration for every
+// change to the structure of the site, post, or plugin data.
+var migrations = []migration{
+	{
+		version:     1,
+		description: "add schema version to the site",
+		migrate: func(doc map[string]interface{}) error {
+			return nil
+		},
+	},
+}
+
+// CurrentSchemaVersion returns the schema version of the site after all of
+// the migrations are run.
+func CurrentSchemaVersion() int {
+	if len(migrations) == 0 {
+		return 0
+	}
+
+	return migrations[len(migrations)-1].version
+}
+
+// schemaVersion returns the schema version of the document. Returns 0 if the
+// document was written before schema versions.
+func schemaVersion(doc map[string]interface{}) int {
+	v, ok := doc[fieldSchemaVersion].(float64)
+	if !ok {
+		return 0
+	}
+
+	return int(v)
+}
+
+// migrateDocument runs each migration newer than the schema version of the
+// document and returns the report. The document is changed in place.
+func migrateDocument(doc map[string]interface{}) (ambient.MigrationReport, error) {
+	report := ambient.MigrationReport{
+		From:    schemaVersion(doc),
+		To:      CurrentSchemaVersion(),
+		Steps:   make([]ambient.MigrationStep, 0),
+		Changes: make([]ambient.SiteChange, 0),
+	}
+
+	if report.From > report.To {
+		return report, fmt.Errorf("storage schema version (%v) is newer than supported version (%v)", report.From, report.To)
+	} else if report.From == report.To {
+		return report, nil
+	}
+
+	original, err := copyDocument(doc)
+	if err != nil {
+		return report, err
+	}
+
+	for _, m := range migrations {
+		if m.version <= report.From {
+			continue
+		}
+
+		err := m.migrate(doc)
+		if err != nil {
+			return report, fmt.Errorf("storage migration (%v) failed: %v", m.version, err.Error())
+		}
+		doc[fieldSchemaVersion] = m.version
+
+		report.Steps = append(report.Steps, ambient.MigrationStep{
+			Version:     m.version,
+			Description: m.description,
+		})
+	}
+
+	// Compare the JSON form so the values are the same types.
+	migrated, err := copyDocument(doc)
+	if err != nil {
+		return report, err
+	}
+	report.Changes = diffDocuments(original, migrated)
+
+	return report, nil
+}
+
+// copyDocument returns a deep copy of the document.
+func copyDocument(doc map[string]interface{}) (map[string]interface{}, error) {
+	return toDocument(doc)
+}
+
+// siteFromDocument converts the document to a site object.
+func siteFromDocument(doc map[string]interface{}) (*ambient.Site, error) {
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	site := &ambient.Site{}
+	err = json.Unmarshal(b, site)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fill in the missing defaults.
+	site.Correct()
+
+	return site, nil
+}
+
+// MigrationReport returns the schema migrations from the last load.
+func (s *Storage) MigrationReport() ambient.MigrationReport {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	return s.migrations
+}
+
+// backupLimitOrDefault returns the number of migration backups to keep.
+func (s *Storage) backupLimitOrDefault() int {
+	if s.backupLimit > 0 {
+		return s.backupLimit
+	}
+
+	return defaultBackupLimit
+}
+
+// backupDocument stores a copy of the document before it is migrated, removes
+// the oldest backups over the limit, and returns the key.
+func (s *Storage) backupDocument(doc map[string]interface{}) (string, error) {
+	key := fmt.Sprintf("schema-%v-%v", schemaVersion(doc), time.Now().UTC().Format(snapshotTimeFormat))
+
+	b, err := s.marshalCodec(codec.JSON{}, doc, true)
+	if err != nil {
+		return "", err
+	}
+
+	err = s.datastorer.Commit(collectionBackups, key, b)
+	if err != nil {
+		return "", err
+	}
+
+	// Remove the oldest backups. The keys are sorted by the time after the
+	// schema version.
+	keys, err := s.datastorer.Keys(collectionBackups)
+	if err != nil {
+		return "", err
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return backupTime(keys[i]) < backupTime(keys[j])
+	})
+	for i := 0; i < len(keys)-s.backupLimitOrDefault(); i++ {
+		err = s.datastorer.Delete(collectionBackups, keys[i])
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return key, nil
+}
+
+// backupTime returns the time part of a backup key: schema-version-time
+func backupTime(key string) string {
+	arr := strings.SplitN(key, "-", 3)
+	return arr[len(arr)-1]
+}
+
+// loadDocument runs the migrations on the document and then sets it as the
+// site object. The document is backed up and saved if it was migrated or if
+// forceSave is true.
+func (s *Storage) loadDocument(doc map[string]interface{}, forceSave bool) error {
+	original, err := copyDocument(doc)
+	if err != nil {
+		return err
+	}
+
+	report, err := migrateDocument(doc)
+	if err != nil {
+		return err
+	}
+
+	site, err := siteFromDocument(doc)
+	if err != nil {
+		return err
+	}
+
+	// A dry run loads the migrated site without saving it. Writes fail until
+	// the site is loaded without the dry run so the stored site is not
+	// partly migrated.
+	migrated := len(report.Steps) > 0
+	if migrated && s.migrationDryRun {
+		report.DryRun = true
+		s.site = site
+		s.migrations = report
+		s.logMigrations(report)
+		return nil
+	}
+
+	if !migrated && !forceSave {
+		s.site = site
+		s.migrations = report
+		return nil
+	}
+
+	return s.batch(func() error {
+		if migrated {
+			var err error
+			report.Backup, err = s.backupDocument(original)
+			if err != nil {
+				return fmt.Errorf("could not back up storage before migration: %v", err.Error())
+			}
+		}
+
+		previous := s.site
+		s.site = site
+		err := s.save(true, false)
+		if err != nil {
+			s.site = previous
+			return err
+		}
+
+		s.migrations = report
+		if migrated {
+			s.logMigrations(report)
+		}
+
+		return nil
+	})
+}
+
+// logMigrations writes the migration report to the log.
+func (s *Storage) logMigrations(report ambient.MigrationReport) {
+	action := "migrated"
+	if report.DryRun {
+		action = "would migrate"
+	}
+
+	s.log.Info("storage %v from schema version %v to %v", action, report.From, report.To)
+	for _, step := range report.Steps {
+		s.log.Info("storage migration %v: %v", step.Version, step.Description)
+	}
+	for _, change := range report.Changes {
+		// Only the path is logged since the values may hold secrets.
+		s.log.Info("storage change: %v", change.Path)
+	}
+}
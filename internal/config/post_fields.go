@@ -0,0 +1,230 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/ambientkit/ambient"
+	"github.com/ambientkit/ambient/pkg/amberror"
+)
+
+// ContentTypes returns the content types with the custom fields from the
+// enabled plugins sorted by name. The post and page content types are always
+// included.
+func (p *PluginSystem) ContentTypes() []ambient.ContentType {
+	p.storage.m.RLock()
+	defer p.storage.m.RUnlock()
+
+	types := p.contentTypes()
+	arr := make([]ambient.ContentType, 0, len(types))
+	for _, ct := range types {
+		arr = append(arr, ct)
+	}
+	sort.Slice(arr, func(i, j int) bool {
+		return arr[i].Name < arr[j].Name
+	})
+
+	return arr
+}
+
+// contentTypes returns the content types from the enabled plugins by name.
+// If more than one plugin registers a content type, the fields are combined
+// and the first plugin to register a field name wins. The storage lock must
+// be held.
+func (p *PluginSystem) contentTypes() map[string]ambient.ContentType {
+	types := map[string]ambient.ContentType{
+		ambient.ContentTypePost: {Name: ambient.ContentTypePost, Fields: []ambient.Field{}},
+		ambient.ContentTypePage: {Name: ambient.ContentTypePage, Fields: []ambient.Field{}},
+	}
+
+	for _, name := range p.pluginNames {
+		if !p.storage.site.PluginStorage[name].Enabled {
+			continue
+		}
+
+		for _, ct := range p.pluginTypes[name] {
+			existing, found := types[ct.Name]
+			if !found {
+				existing = ambient.ContentType{Name: ct.Name, Fields: []ambient.Field{}}
+			}
+			if len(existing.Description) == 0 {
+				existing.Description = ct.Description
+			}
+
+			for _, f := range ct.Fields {
+				if _, found := fieldByName(existing.Fields, f.Name); found {
+					p.log.Debug("plugin (%v) field (%v) on content type (%v) is already registered", name, f.Name, ct.Name)
+					continue
+				}
+				existing.Fields = append(existing.Fields, f)
+			}
+
+			types[ct.Name] = existing
+		}
+	}
+
+	return types
+}
+
+// fieldByName returns the field with the name.
+func fieldByName(fields []ambient.Field, name string) (ambient.Field, bool) {
+	for _, f := range fields {
+		if f.Name == name {
+			return f, true
+		}
+	}
+
+	return ambient.Field{}, false
+}
+
+// validatePost returns the post with the custom field values normalized so
+// they are the same after they are read from storage. Returns an error if
+// the content type is not registered, a field is not defined on the content
+// type, a required field is missing, or a value doesn't match the field type.
+// The storage lock must be held.
+func (p *PluginSystem) validatePost(post ambient.Post) (ambient.Post, error) {
+	ct, found := p.contentTypes()[post.ContentType()]
+	if !found {
+		return post, &amberror.FieldError{Field: "type", Reason: fmt.Sprintf("has a content type that is not registered: %v", post.ContentType())}
+	}
+
+	if len(post.Fields) == 0 {
+		post.Fields = nil
+	} else {
+		fields := make(ambient.PostFields, len(post.Fields))
+		for name, v := range post.Fields {
+			f, found := fieldByName(ct.Fields, name)
+			if !found {
+				return post, &amberror.FieldError{Field: name, Reason: fmt.Sprintf("is not defined on content type: %v", ct.Name)}
+			}
+
+			v, err := fieldValue(f, normalizeValue(v))
+			if err != nil {
+				return post, err
+			} else if v != nil {
+				fields[name] = v
+			}
+		}
+		post.Fields = fields
+	}
+
+	for _, f := range ct.Fields {
+		if !f.Required {
+			continue
+		}
+
+		v, found := post.Fields[f.Name]
+		if s, ok := v.(string); !found || (ok && len(s) == 0) {
+			return post, &amberror.FieldError{Field: f.Name, Reason: "is required"}
+		}
+	}
+
+	return post, nil
+}
+
+// normalizeValue returns numbers as float64 and times as RFC 3339 strings so
+// the value is the same after it's decoded from JSON.
+func normalizeValue(v interface{}) interface{} {
+	switch t := v.(type) {
+	case time.Time:
+		return t.UTC().Format(time.RFC3339Nano)
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32:
+		return reflect.ValueOf(t).Convert(reflect.TypeOf(float64(0))).Float()
+	}
+
+	return v
+}
+
+// fieldValue returns the value if it matches the field type. Dates are
+// returned in UTC.
+func fieldValue(f ambient.Field, v interface{}) (interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	invalid := &amberror.FieldError{Field: f.Name, Reason: fmt.Sprintf("is not a valid value for type: %v", f.Type)}
+
+	switch f.Type {
+	case ambient.FieldText, ambient.FieldTextarea:
+		if _, ok := v.(string); ok {
+			return v, nil
+		}
+	case ambient.FieldNumber:
+		if _, ok := v.(float64); ok {
+			return v, nil
+		}
+	case ambient.FieldBool:
+		if _, ok := v.(bool); ok {
+			return v, nil
+		}
+	case ambient.FieldDate:
+		s, ok := v.(string)
+		if !ok {
+			return nil, invalid
+		} else if len(s) == 0 {
+			return s, nil
+		}
+
+		t, err := time.Parse(time.RFC3339Nano, s)
+		if err == nil {
+			return t.UTC().Format(time.RFC3339Nano), nil
+		}
+	case ambient.FieldURL:
+		s, ok := v.(string)
+		if !ok {
+			return nil, invalid
+		}
+
+		if validURL(s) {
+			return s, nil
+		}
+	default:
+		return nil, &amberror.FieldError{Field: f.Name, Reason: fmt.Sprintf("has a type that is not supported: %v", f.Type)}
+	}
+
+	return nil, invalid
+}
+
+// validURL returns true if the string is empty, an http or https URL with a
+// host, or a relative path. Other schemes like javascript: are not allowed.
+func validURL(s string) bool {
+	if len(s) == 0 {
+		return true
+	}
+
+	if strings.IndexFunc(s, func(r rune) bool { return unicode.IsSpace(r) || unicode.IsControl(r) }) >= 0 {
+		return false
+	}
+
+	u, err := url.Parse(s)
+	if err != nil {
+		return false
+	}
+
+	switch u.Scheme {
+	case "http", "https":
+		return len(u.Host) > 0
+	case "":
+		// A URL that starts with // is on another host.
+		return len(u.Host) == 0 && !strings.HasPrefix(s, "//")
+	}
+
+	return false
+}
+
+// fieldsMatch returns true if the post has each of the field values.
+func fieldsMatch(post ambient.Post, fields ambient.PostFields) bool {
+	for name, want := range fields {
+		v, found := post.Fields[name]
+		if !found || !reflect.DeepEqual(v, normalizeValue(want)) {
+			return false
+		}
+	}
+
+	return true
+}
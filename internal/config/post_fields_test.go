@@ -0,0 +1,144 @@
+package config_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ambientkit/ambient"
+	"github.com/ambientkit/ambient/internal/config"
+	"github.com/ambientkit/ambient/pkg/amberror"
+	"github.com/ambientkit/ambient/pkg/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPluginSystemPostFields(t *testing.T) {
+	log := newLogger(t)
+
+	plugina := mock.NewPlugin("plugina", "1.0.0")
+	plugina.MockContentTypes = []ambient.ContentType{
+		{
+			Name: "event",
+			Fields: []ambient.Field{
+				{Name: "starts", Type: ambient.FieldDate, Required: true},
+				{Name: "seats", Type: ambient.FieldNumber},
+				{Name: "venue", Type: ambient.FieldText},
+			},
+		},
+		{
+			Name:   ambient.ContentTypePost,
+			Fields: []ambient.Field{{Name: "hero", Type: ambient.FieldURL}},
+		},
+	}
+	pluginb := mock.NewPlugin("pluginb", "1.0.0")
+	pluginb.MockContentTypes = []ambient.ContentType{
+		{
+			Name: "event",
+			Fields: []ambient.Field{
+				{Name: "seats", Type: ambient.FieldText},
+				{Name: "online", Type: ambient.FieldBool},
+			},
+		},
+	}
+
+	storage, err := config.NewStorage(log, newKeyedStore(), ambient.StoragePluginGroup{})
+	assert.NoError(t, err)
+	ps, err := config.NewPluginSystem(log, storage, &ambient.PluginLoader{
+		Plugins: []ambient.Plugin{plugina, pluginb},
+	})
+	assert.NoError(t, err)
+
+	starts := time.Date(2030, time.May, 1, 18, 0, 0, 0, time.FixedZone("EST", -5*60*60))
+	event := ambient.Post{
+		Title: "Meetup",
+		Type:  "event",
+		Fields: ambient.PostFields{
+			"starts": starts,
+			"seats":  40,
+		},
+	}
+
+	// The content types are only registered while the plugin is enabled.
+	err = ps.SavePost("1", event)
+	assert.True(t, errors.Is(err, amberror.ErrInvalidPost))
+	assert.Len(t, ps.ContentTypes(), 2)
+
+	assert.NoError(t, ps.SetEnabled("plugina", true))
+	assert.NoError(t, ps.SetEnabled("pluginb", true))
+
+	// The fields are combined and the first plugin wins.
+	types := ps.ContentTypes()
+	assert.Equal(t, []string{"event", "page", "post"}, []string{types[0].Name, types[1].Name, types[2].Name})
+	assert.Equal(t, []ambient.Field{
+		{Name: "starts", Type: ambient.FieldDate, Required: true},
+		{Name: "seats", Type: ambient.FieldNumber},
+		{Name: "venue", Type: ambient.FieldText},
+		{Name: "online", Type: ambient.FieldBool},
+	}, types[0].Fields)
+
+	// The values are stored the same way they are read.
+	assert.NoError(t, ps.SavePost("1", event))
+	post, err := ps.PostByID("1")
+	assert.NoError(t, err)
+	assert.Equal(t, ambient.PostFields{"starts": "2030-05-01T23:00:00Z", "seats": float64(40)}, post.Fields)
+	assert.Equal(t, "event", post.ContentType())
+
+	assert.NoError(t, ps.Load())
+	post, err = ps.PostByID("1")
+	assert.NoError(t, err)
+	assert.Equal(t, ambient.PostFields{"starts": "2030-05-01T23:00:00Z", "seats": float64(40)}, post.Fields)
+
+	// Fields on the built-in content types.
+	assert.NoError(t, ps.SavePost("2", ambient.Post{Title: "Post", Fields: ambient.PostFields{"hero": "/hero.png"}}))
+	err = ps.SavePost("3", ambient.Post{Title: "Page", Page: true, Fields: ambient.PostFields{"hero": "/hero.png"}})
+	assert.True(t, errors.Is(err, amberror.ErrInvalidPost))
+
+	// Bad values.
+	for _, fields := range []ambient.PostFields{
+		{"seats": 40},
+		{"starts": "tomorrow"},
+		{"starts": ""},
+		{"starts": starts, "seats": "many"},
+		{"starts": starts, "online": "yes"},
+		{"starts": starts, "other": "value"},
+	} {
+		err = ps.SavePost("4", ambient.Post{Type: "event", Fields: fields})
+		assert.True(t, errors.Is(err, amberror.ErrInvalidPost), fields)
+	}
+	err = ps.SavePost("4", ambient.Post{Type: "product"})
+	assert.True(t, errors.Is(err, amberror.ErrInvalidPost))
+	_, err = ps.PostByID("4")
+	assert.Equal(t, amberror.ErrNotFound, err)
+
+	// Only http, https, and relative URLs are allowed.
+	for _, hero := range []string{"https://example.com/hero.png", "http://example.com", "hero.png", "/img/hero.png?size=2"} {
+		assert.NoError(t, ps.SavePost("4", ambient.Post{Title: "Post", Fields: ambient.PostFields{"hero": hero}}), hero)
+	}
+	for _, hero := range []string{"javascript:alert(1)", "JavaScript:alert(1)", "data:text/html,hi", "/hero image.png", "//example.com/hero.png", "https:///hero.png"} {
+		err = ps.SavePost("4", ambient.Post{Title: "Post", Fields: ambient.PostFields{"hero": hero}})
+		assert.True(t, errors.Is(err, amberror.ErrInvalidPost), hero)
+	}
+	assert.NoError(t, ps.DeletePostByID("4"))
+
+	// Queries by content type and field values.
+	assert.NoError(t, ps.SavePost("5", ambient.Post{
+		Title:  "Workshop",
+		Type:   "event",
+		Fields: ambient.PostFields{"starts": starts.Add(time.Hour), "online": true, "venue": "Library hall"},
+	}))
+	result, err := ps.QueryPosts(ambient.PostQuery{Type: "event"})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, result.Total)
+	result, err = ps.QueryPosts(ambient.PostQuery{Kind: ambient.PostKindPost})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"2"}, postIDs(result.Posts))
+	result, err = ps.QueryPosts(ambient.PostQuery{Fields: ambient.PostFields{"seats": 40}})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1"}, postIDs(result.Posts))
+	result, err = ps.QueryPosts(ambient.PostQuery{Fields: ambient.PostFields{"starts": starts.Add(time.Hour)}})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"5"}, postIDs(result.Posts))
+
+	// Text fields are searched.
+	assert.Equal(t, []string{"5"}, resultIDs(ps.SearchPosts("library", false, 0, 0)))
+}
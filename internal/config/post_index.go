@@ -0,0 +1,333 @@
+package config
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ambientkit/ambient"
+)
+
+// postIndex keeps the post lookups, the sorted lists of posts, and the search
+// index so they don't need to be built from the site object on every read.
+// It's rebuilt when the site object is replaced and updated when a post is
+// saved or deleted. The published list is rebuilt when a scheduled post is due.
+type postIndex struct {
+	site      *ambient.Site
+	byID      map[string]ambient.PostWithID
+	slugs     map[string][]string
+	tags      map[string]map[string]ambient.Tag
+	all       ambient.PostWithIDList
+	published ambient.PostWithIDList
+	live      map[string]bool
+	next      time.Time
+	tagLists  map[bool]ambient.TagList
+	postLists map[bool][]ambient.Post
+	search    searchIndex
+
+	// m guards the index since it's updated by readers that only hold the
+	// storage read lock.
+	m sync.Mutex
+}
+
+// newer returns true if the post comes before the other post in the sorted
+// lists: newest first, then by title, then by ID.
+func newer(a ambient.PostWithID, b ambient.PostWithID) bool {
+	if !a.Timestamp.Equal(b.Timestamp) {
+		return a.Timestamp.After(b.Timestamp)
+	}
+	if a.Title != b.Title {
+		return a.Title < b.Title
+	}
+	return a.ID < b.ID
+}
+
+// sync rebuilds the index if the site object was replaced and rebuilds the
+// published list if a scheduled post is due. The caller must hold the index
+// lock.
+func (x *postIndex) sync(site *ambient.Site, now time.Time) {
+	if x.site != site {
+		x.rebuild(site, now)
+		return
+	}
+
+	if !x.next.IsZero() && !now.Before(x.next) {
+		x.refreshPublished(now)
+	}
+}
+
+// rebuild builds the index from every post in the site object.
+func (x *postIndex) rebuild(site *ambient.Site, now time.Time) {
+	x.site = site
+	x.byID = make(map[string]ambient.PostWithID, len(site.Posts))
+	x.slugs = make(map[string][]string)
+	x.tags = make(map[string]map[string]ambient.Tag)
+	x.all = make(ambient.PostWithIDList, 0, len(site.Posts))
+	x.search.reset()
+
+	for ID, post := range site.Posts {
+		p := ambient.PostWithID{Post: post, ID: ID}
+		x.byID[ID] = p
+		x.addLookups(p)
+		x.search.add(p)
+		x.all = append(x.all, p)
+	}
+	sort.Slice(x.all, func(i, j int) bool {
+		return newer(x.all[i], x.all[j])
+	})
+
+	x.refreshPublished(now)
+}
+
+// refreshPublished rebuilds the published list from the sorted list and
+// finds the next time a scheduled post is due.
+func (x *postIndex) refreshPublished(now time.Time) {
+	x.published = make(ambient.PostWithIDList, 0, len(x.all))
+	x.live = make(map[string]bool, len(x.all))
+	x.next = time.Time{}
+	x.tagLists = nil
+	x.postLists = nil
+
+	for _, p := range x.all {
+		if p.IsPublished(now) {
+			x.published = append(x.published, p)
+			x.live[p.ID] = true
+		}
+		x.schedule(p.Post, now)
+	}
+}
+
+// schedule moves the next due time earlier if the post is scheduled before it.
+func (x *postIndex) schedule(post ambient.Post, now time.Time) {
+	for _, t := range []time.Time{post.PublishAt, post.UnpublishAt} {
+		if !t.IsZero() && t.After(now) && (x.next.IsZero() || t.Before(x.next)) {
+			x.next = t
+		}
+	}
+}
+
+// addLookups adds the post to the slug and tag lookups.
+func (x *postIndex) addLookups(p ambient.PostWithID) {
+	IDs := append(x.slugs[p.URL], p.ID)
+	sort.Strings(IDs)
+	x.slugs[p.URL] = IDs
+
+	for _, t := range p.Tags {
+		if x.tags[t.Name] == nil {
+			x.tags[t.Name] = make(map[string]ambient.Tag)
+		}
+		x.tags[t.Name][p.ID] = t
+	}
+}
+
+// removeLookups removes the post from the slug and tag lookups.
+func (x *postIndex) removeLookups(p ambient.PostWithID) {
+	IDs := x.slugs[p.URL]
+	for i, ID := range IDs {
+		if ID == p.ID {
+			IDs = append(IDs[:i:i], IDs[i+1:]...)
+			break
+		}
+	}
+	if len(IDs) == 0 {
+		delete(x.slugs, p.URL)
+	} else {
+		x.slugs[p.URL] = IDs
+	}
+
+	for _, t := range p.Tags {
+		delete(x.tags[t.Name], p.ID)
+		if len(x.tags[t.Name]) == 0 {
+			delete(x.tags, t.Name)
+		}
+	}
+}
+
+// insertSorted returns the list with the post added in order.
+func insertSorted(arr ambient.PostWithIDList, p ambient.PostWithID) ambient.PostWithIDList {
+	i := sort.Search(len(arr), func(i int) bool {
+		return newer(p, arr[i])
+	})
+
+	arr = append(arr, ambient.PostWithID{})
+	copy(arr[i+1:], arr[i:])
+	arr[i] = p
+
+	return arr
+}
+
+// removeSorted returns the list without the post.
+func removeSorted(arr ambient.PostWithIDList, p ambient.PostWithID) ambient.PostWithIDList {
+	i := sort.Search(len(arr), func(i int) bool {
+		return !newer(arr[i], p)
+	})
+	if i < len(arr) && arr[i].ID == p.ID {
+		arr = append(arr[:i], arr[i+1:]...)
+	}
+
+	return arr
+}
+
+// put adds or replaces a post after it's changed in the site object.
+func (x *postIndex) put(site *ambient.Site, ID string, post ambient.Post) {
+	x.m.Lock()
+	defer x.m.Unlock()
+
+	now := time.Now()
+	if x.site != site {
+		// The post is already in the site object.
+		x.rebuild(site, now)
+		return
+	}
+
+	x.remove(ID)
+
+	p := ambient.PostWithID{Post: post, ID: ID}
+	x.byID[ID] = p
+	x.addLookups(p)
+	x.search.add(p)
+	x.all = insertSorted(x.all, p)
+	if p.IsPublished(now) {
+		x.published = insertSorted(x.published, p)
+		x.live[ID] = true
+	}
+	x.schedule(post, now)
+	x.tagLists = nil
+	x.postLists = nil
+}
+
+// delete removes a post after it's deleted from the site object.
+func (x *postIndex) delete(site *ambient.Site, ID string) {
+	x.m.Lock()
+	defer x.m.Unlock()
+
+	if x.site != site {
+		x.rebuild(site, time.Now())
+		return
+	}
+
+	x.remove(ID)
+}
+
+// remove removes a post from the index. The caller must hold the index lock.
+func (x *postIndex) remove(ID string) {
+	old, found := x.byID[ID]
+	if !found {
+		return
+	}
+
+	delete(x.byID, ID)
+	x.removeLookups(old)
+	x.search.remove(ID)
+	x.all = removeSorted(x.all, old)
+	if x.live[ID] {
+		x.published = removeSorted(x.published, old)
+		delete(x.live, ID)
+	}
+	x.tagLists = nil
+	x.postLists = nil
+}
+
+// postBySlug returns the post with the slug. If more than one post has the
+// slug, the post with the lowest ID is returned.
+func (x *postIndex) postBySlug(site *ambient.Site, slug string) ambient.PostWithID {
+	x.m.Lock()
+	defer x.m.Unlock()
+
+	x.sync(site, time.Now())
+
+	IDs := x.slugs[slug]
+	if len(IDs) == 0 {
+		return ambient.PostWithID{}
+	}
+
+	return x.byID[IDs[0]]
+}
+
+// postsAndPages returns the posts and pages with the newest first.
+func (x *postIndex) postsAndPages(site *ambient.Site, onlyPublished bool) ambient.PostWithIDList {
+	x.m.Lock()
+	defer x.m.Unlock()
+
+	x.sync(site, time.Now())
+
+	src := x.all
+	if onlyPublished {
+		src = x.published
+	}
+
+	arr := make(ambient.PostWithIDList, len(src))
+	copy(arr, src)
+
+	return arr
+}
+
+// publishedPosts returns the published pages or posts with the newest first.
+func (x *postIndex) publishedPosts(site *ambient.Site, pages bool) []ambient.Post {
+	x.m.Lock()
+	defer x.m.Unlock()
+
+	x.sync(site, time.Now())
+
+	if x.postLists == nil {
+		x.postLists = make(map[bool][]ambient.Post)
+	}
+
+	posts, found := x.postLists[pages]
+	if !found {
+		posts = make([]ambient.Post, 0)
+		for _, p := range x.published {
+			if p.Page == pages {
+				posts = append(posts, p.Post)
+			}
+		}
+		x.postLists[pages] = posts
+	}
+
+	arr := make([]ambient.Post, len(posts))
+	copy(arr, posts)
+
+	return arr
+}
+
+// tagList returns the tags sorted by name.
+func (x *postIndex) tagList(site *ambient.Site, onlyPublished bool) ambient.TagList {
+	x.m.Lock()
+	defer x.m.Unlock()
+
+	x.sync(site, time.Now())
+
+	if x.tagLists == nil {
+		x.tagLists = make(map[bool]ambient.TagList)
+	}
+
+	tags, found := x.tagLists[onlyPublished]
+	if !found {
+		tags = make(ambient.TagList, 0, len(x.tags))
+		for _, posts := range x.tags {
+			// Use the tag from the post with the lowest ID so the result
+			// doesn't change between calls.
+			var tag ambient.Tag
+			ID := ""
+			for postID, t := range posts {
+				if onlyPublished && !x.live[postID] {
+					continue
+				}
+				if len(ID) == 0 || postID < ID {
+					ID = postID
+					tag = t
+				}
+			}
+			if len(ID) > 0 {
+				tags = append(tags, tag)
+			}
+		}
+		sort.Sort(tags)
+		x.tagLists[onlyPublished] = tags
+	}
+
+	arr := make(ambient.TagList, len(tags))
+	copy(arr, tags)
+
+	return arr
+}
@@ -0,0 +1,160 @@
+package config_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ambientkit/ambient"
+	"github.com/ambientkit/ambient/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+// newPost returns a post with a unique timestamp and slug. The times are in
+// UTC so they match after they are read from storage.
+func newPost(i int, start time.Time) ambient.Post {
+	start = start.UTC().Round(0)
+	return ambient.Post{
+		Title:     fmt.Sprintf("Post %v", i),
+		URL:       fmt.Sprintf("post-%v", i),
+		Timestamp: start.Add(time.Duration(i) * time.Minute),
+		Published: i%3 != 0,
+		Page:      i%10 == 0,
+		Tags:      ambient.TagList{{Name: fmt.Sprintf("tag%v", i%7)}},
+	}
+}
+
+// loadPosts returns a plugin system with the posts and a site object with
+// the same posts to compare against.
+func loadPosts(tb testing.TB, count int) (*config.PluginSystem, *ambient.Site) {
+	log := newLogger(tb)
+
+	storage, err := config.NewStorage(log, newKeyedStore(), ambient.StoragePluginGroup{})
+	assert.NoError(tb, err)
+	ps, err := config.NewPluginSystem(log, storage, &ambient.PluginLoader{})
+	assert.NoError(tb, err)
+
+	site := &ambient.Site{Posts: make(map[string]ambient.Post)}
+	start := time.Now().Add(-time.Duration(count) * time.Minute)
+	assert.NoError(tb, ps.Batch(func() error {
+		for i := 0; i < count; i++ {
+			post := newPost(i, start)
+			site.Posts[fmt.Sprint(i)] = post
+			err := ps.SavePost(fmt.Sprint(i), post)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+
+	return ps, site
+}
+
+// tagNames returns the names of the tags.
+func tagNames(tags ambient.TagList) []string {
+	arr := make([]string, 0)
+	for _, t := range tags {
+		arr = append(arr, t.Name)
+	}
+	return arr
+}
+
+func TestPluginSystemPostIndex(t *testing.T) {
+	ps, site := loadPosts(t, 100)
+
+	check := func() {
+		assert.Equal(t, site.PostsAndPages(false), ps.PostsAndPages(false))
+		assert.Equal(t, site.PostsAndPages(true), ps.PostsAndPages(true))
+		assert.Equal(t, site.PublishedPosts(), ps.PublishedPosts())
+		assert.Equal(t, site.PublishedPages(), ps.PublishedPages())
+		assert.Equal(t, tagNames(site.Tags(false)), tagNames(ps.Tags(false)))
+		assert.Equal(t, tagNames(site.Tags(true)), tagNames(ps.Tags(true)))
+		for _, slug := range []string{"post-1", "post-50", "post-98", "missing"} {
+			assert.Equal(t, site.PostBySlug(slug), ps.PostBySlug(slug))
+		}
+	}
+	check()
+
+	// Change, delete, and add posts.
+	post := site.Posts["1"]
+	post.URL = "changed"
+	post.Published = false
+	post.Tags = ambient.TagList{{Name: "other"}}
+	site.Posts["1"] = post
+	assert.NoError(t, ps.SavePost("1", post))
+
+	for _, ID := range []string{"50", "2", "3", "4", "5", "6"} {
+		delete(site.Posts, ID)
+		assert.NoError(t, ps.DeletePostByID(ID))
+	}
+
+	post = newPost(200, time.Now())
+	post.URL = "post-99"
+	site.Posts["200"] = post
+	assert.NoError(t, ps.SavePost("200", post))
+	check()
+	// The post with the lowest ID is returned when the slug is used twice.
+	assert.Equal(t, "200", ps.PostBySlug("post-99").ID)
+	assert.Equal(t, "1", ps.PostBySlug("changed").ID)
+
+	// The published lists change when a scheduled post is due.
+	post = newPost(300, time.Now())
+	post.Published = true
+	post.PublishAt = time.Now().UTC().Round(0).Add(50 * time.Millisecond)
+	site.Posts["300"] = post
+	assert.NoError(t, ps.SavePost("300", post))
+	check()
+	time.Sleep(60 * time.Millisecond)
+	check()
+	assert.Equal(t, "300", ps.PostsAndPages(true)[0].ID)
+
+	// The index is rebuilt after a reload.
+	assert.NoError(t, ps.Load())
+	check()
+}
+
+func BenchmarkPostIndex(b *testing.B) {
+	ps, site := loadPosts(b, 10000)
+
+	b.Run("PostBySlug/scan", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			site.PostBySlug("post-5000")
+		}
+	})
+	b.Run("PostBySlug/index", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			ps.PostBySlug("post-5000")
+		}
+	})
+	b.Run("PublishedPosts/scan", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			site.PublishedPosts()
+		}
+	})
+	b.Run("PublishedPosts/index", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			ps.PublishedPosts()
+		}
+	})
+	b.Run("PostsAndPages/scan", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			site.PostsAndPages(true)
+		}
+	})
+	b.Run("PostsAndPages/index", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			ps.PostsAndPages(true)
+		}
+	})
+	b.Run("Tags/scan", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			site.Tags(true)
+		}
+	})
+	b.Run("Tags/index", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			ps.Tags(true)
+		}
+	})
+}
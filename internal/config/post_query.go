@@ -0,0 +1,214 @@
+package config
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ambientkit/ambient"
+	"github.com/ambientkit/ambient/pkg/amberror"
+)
+
+// postCursor is the position of the last post on a page so the next page
+// starts after it even if posts are added or removed in between.
+type postCursor struct {
+	Timestamp time.Time `json:"t"`
+	Title     string    `json:"n"`
+	ID        string    `json:"i"`
+}
+
+// encodeCursor returns the cursor for the position after the post.
+func encodeCursor(p ambient.PostWithID) string {
+	b, err := json.Marshal(postCursor{
+		Timestamp: p.Timestamp,
+		Title:     p.Title,
+		ID:        p.ID,
+	})
+	if err != nil {
+		return ""
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// decodeCursor returns the position from a cursor as a post so it can be
+// compared with the sort order.
+func decodeCursor(s string) (ambient.PostWithID, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return ambient.PostWithID{}, amberror.ErrInvalidQuery
+	}
+
+	c := postCursor{}
+	err = json.Unmarshal(b, &c)
+	if err != nil {
+		return ambient.PostWithID{}, amberror.ErrInvalidQuery
+	}
+
+	return ambient.PostWithID{
+		Post: ambient.Post{
+			Timestamp: c.Timestamp,
+			Title:     c.Title,
+		},
+		ID: c.ID,
+	}, nil
+}
+
+// queryOrder returns a function that returns true if the post comes before
+// the other post in the sort order.
+func queryOrder(s ambient.PostSort) (func(a ambient.PostWithID, b ambient.PostWithID) bool, error) {
+	switch s {
+	case ambient.PostSortNewest:
+		return newer, nil
+	case ambient.PostSortOldest:
+		return func(a ambient.PostWithID, b ambient.PostWithID) bool {
+			return newer(b, a)
+		}, nil
+	case ambient.PostSortTitle:
+		return func(a ambient.PostWithID, b ambient.PostWithID) bool {
+			at, bt := strings.ToLower(a.Title), strings.ToLower(b.Title)
+			if at != bt {
+				return at < bt
+			}
+			return newer(a, b)
+		}, nil
+	}
+
+	return nil, amberror.ErrInvalidQuery
+}
+
+// validQuery returns an error if the query has a kind or status that isn't
+// supported.
+func validQuery(q ambient.PostQuery) error {
+	switch q.Kind {
+	case ambient.PostKindAll, ambient.PostKindPost, ambient.PostKindPage:
+	default:
+		return amberror.ErrInvalidQuery
+	}
+
+	switch q.Status {
+	case ambient.PostStatusAll, ambient.PostStatusPublished, ambient.PostStatusUnpublished:
+	default:
+		return amberror.ErrInvalidQuery
+	}
+
+	return nil
+}
+
+// matches returns true if the post matches the filters in the query. The
+// caller must hold the index lock.
+func (x *postIndex) matches(p ambient.PostWithID, q ambient.PostQuery) bool {
+	switch q.Kind {
+	case ambient.PostKindPost:
+		if p.ContentType() != ambient.ContentTypePost {
+			return false
+		}
+	case ambient.PostKindPage:
+		if p.ContentType() != ambient.ContentTypePage {
+			return false
+		}
+	}
+
+	switch q.Status {
+	case ambient.PostStatusPublished:
+		if !x.live[p.ID] {
+			return false
+		}
+	case ambient.PostStatusUnpublished:
+		if x.live[p.ID] {
+			return false
+		}
+	}
+
+	if len(q.Tag) > 0 {
+		if _, found := x.tags[q.Tag][p.ID]; !found {
+			return false
+		}
+	}
+
+	if !q.After.IsZero() && p.Timestamp.Before(q.After) {
+		return false
+	}
+	if !q.Before.IsZero() && !p.Timestamp.Before(q.Before) {
+		return false
+	}
+
+	if len(q.Author) > 0 && p.Author != q.Author {
+		return false
+	}
+	if len(q.Type) > 0 && p.ContentType() != q.Type {
+		return false
+	}
+
+	return fieldsMatch(p.Post, q.Fields)
+}
+
+// queryPosts returns a page of the posts that match the query. The page
+// starts after the cursor and then skips the offset.
+func (x *postIndex) queryPosts(site *ambient.Site, q ambient.PostQuery) (ambient.PostQueryResult, error) {
+	err := validQuery(q)
+	if err != nil {
+		return ambient.PostQueryResult{}, err
+	}
+
+	order, err := queryOrder(q.Sort)
+	if err != nil {
+		return ambient.PostQueryResult{}, err
+	}
+
+	var cursor ambient.PostWithID
+	if len(q.Cursor) > 0 {
+		cursor, err = decodeCursor(q.Cursor)
+		if err != nil {
+			return ambient.PostQueryResult{}, err
+		}
+	}
+
+	x.m.Lock()
+	defer x.m.Unlock()
+
+	x.sync(site, time.Now())
+
+	arr := make(ambient.PostWithIDList, 0)
+	for _, p := range x.all {
+		if x.matches(p, q) {
+			arr = append(arr, p)
+		}
+	}
+
+	// The list is already sorted with the newest first.
+	if q.Sort != ambient.PostSortNewest {
+		sort.Slice(arr, func(i, j int) bool {
+			return order(arr[i], arr[j])
+		})
+	}
+
+	result := ambient.PostQueryResult{
+		Total: len(arr),
+		Posts: make(ambient.PostWithIDList, 0),
+	}
+
+	start := 0
+	if len(q.Cursor) > 0 {
+		start = sort.Search(len(arr), func(i int) bool {
+			return order(cursor, arr[i])
+		})
+	}
+	if q.Offset > 0 {
+		start += q.Offset
+	}
+	if start >= len(arr) {
+		return result, nil
+	}
+
+	arr = arr[start:]
+	if q.Limit > 0 && q.Limit < len(arr) {
+		arr = arr[:q.Limit]
+		result.Cursor = encodeCursor(arr[len(arr)-1])
+	}
+	result.Posts = append(result.Posts, arr...)
+
+	return result, nil
+}
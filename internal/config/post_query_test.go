@@ -0,0 +1,92 @@
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ambientkit/ambient"
+	"github.com/ambientkit/ambient/pkg/amberror"
+	"github.com/stretchr/testify/assert"
+)
+
+// postIDs returns the IDs of the posts.
+func postIDs(posts ambient.PostWithIDList) []string {
+	arr := make([]string, 0)
+	for _, p := range posts {
+		arr = append(arr, p.ID)
+	}
+	return arr
+}
+
+func TestPluginSystemQueryPosts(t *testing.T) {
+	ps, site := loadPosts(t, 30)
+
+	// The zero query returns every post with the newest first.
+	result, err := ps.QueryPosts(ambient.PostQuery{})
+	assert.NoError(t, err)
+	assert.Equal(t, 30, result.Total)
+	assert.Equal(t, postIDs(site.PostsAndPages(false)), postIDs(result.Posts))
+	assert.Empty(t, result.Cursor)
+
+	// Filters.
+	result, err = ps.QueryPosts(ambient.PostQuery{
+		Tag:    "tag1",
+		Kind:   ambient.PostKindPost,
+		Status: ambient.PostStatusPublished,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"29", "22", "8", "1"}, postIDs(result.Posts))
+
+	result, err = ps.QueryPosts(ambient.PostQuery{
+		Kind:   ambient.PostKindPage,
+		Status: ambient.PostStatusUnpublished,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"0"}, postIDs(result.Posts))
+
+	start := site.Posts["10"].Timestamp
+	result, err = ps.QueryPosts(ambient.PostQuery{
+		After:  start,
+		Before: start.Add(3 * time.Minute),
+		Sort:   ambient.PostSortOldest,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"10", "11", "12"}, postIDs(result.Posts))
+
+	// New posts get the author that saves them and keep it after changes.
+	assert.NoError(t, ps.SavePostAs("101", newPost(101, start), "jsmith"))
+	assert.NoError(t, ps.SavePost("101", newPost(101, start)))
+	result, err = ps.QueryPosts(ambient.PostQuery{Author: "jsmith"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"101"}, postIDs(result.Posts))
+
+	// Pages with an offset.
+	result, err = ps.QueryPosts(ambient.PostQuery{Sort: ambient.PostSortTitle, Limit: 3, Offset: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, 31, result.Total)
+	assert.Equal(t, []string{"10", "101", "11"}, postIDs(result.Posts))
+
+	// Pages with a cursor continue after the last post even if an earlier
+	// post is deleted.
+	q := ambient.PostQuery{Kind: ambient.PostKindPage, Limit: 2}
+	result, err = ps.QueryPosts(q)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"20", "10"}, postIDs(result.Posts))
+	assert.NotEmpty(t, result.Cursor)
+
+	assert.NoError(t, ps.DeletePostByID("20"))
+	q.Cursor = result.Cursor
+	result, err = ps.QueryPosts(q)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"0"}, postIDs(result.Posts))
+	assert.Equal(t, 2, result.Total)
+	assert.Empty(t, result.Cursor)
+
+	// Bad queries.
+	_, err = ps.QueryPosts(ambient.PostQuery{Sort: "random"})
+	assert.Equal(t, amberror.ErrInvalidQuery, err)
+	_, err = ps.QueryPosts(ambient.PostQuery{Kind: "other"})
+	assert.Equal(t, amberror.ErrInvalidQuery, err)
+	_, err = ps.QueryPosts(ambient.PostQuery{Cursor: "!"})
+	assert.Equal(t, amberror.ErrInvalidQuery, err)
+}
@@ -0,0 +1,191 @@
+package config
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/ambientkit/ambient"
+)
+
+// The weight of a word based on where it's found in the post.
+const (
+	searchWeightTitle   = 3
+	searchWeightTag     = 2
+	searchWeightContent = 1
+)
+
+// searchPrefixFactor lowers the score of a word that only starts with the
+// query word.
+const searchPrefixFactor = 0.5
+
+// searchIndex is an inverted index of the words in the post titles, content,
+// tags, and text fields.
+type searchIndex struct {
+	words map[string]map[string]float64 // Word to post ID to weight.
+	posts map[string][]string           // Post ID to words.
+	terms []string                      // Sorted words for prefix matches.
+}
+
+// tokenize returns the lowercase words in the text.
+func tokenize(s string) []string {
+	return strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+}
+
+// reset removes all of the posts from the index.
+func (x *searchIndex) reset() {
+	x.words = make(map[string]map[string]float64)
+	x.posts = make(map[string][]string)
+	x.terms = make([]string, 0)
+}
+
+// add adds the words in the post to the index.
+func (x *searchIndex) add(p ambient.PostWithID) {
+	weights := make(map[string]float64)
+	for _, w := range tokenize(p.Title) {
+		weights[w] += searchWeightTitle
+	}
+	for _, t := range p.Tags {
+		for _, w := range tokenize(t.Name) {
+			weights[w] += searchWeightTag
+		}
+	}
+	for _, w := range tokenize(p.Content) {
+		weights[w] += searchWeightContent
+	}
+	for _, v := range p.Fields {
+		if s, ok := v.(string); ok {
+			for _, w := range tokenize(s) {
+				weights[w] += searchWeightContent
+			}
+		}
+	}
+
+	words := make([]string, 0, len(weights))
+	for w, weight := range weights {
+		if x.words[w] == nil {
+			x.words[w] = make(map[string]float64)
+			i := sort.SearchStrings(x.terms, w)
+			x.terms = append(x.terms, "")
+			copy(x.terms[i+1:], x.terms[i:])
+			x.terms[i] = w
+		}
+		x.words[w][p.ID] = weight
+		words = append(words, w)
+	}
+	x.posts[p.ID] = words
+}
+
+// remove removes the words in the post from the index.
+func (x *searchIndex) remove(ID string) {
+	for _, w := range x.posts[ID] {
+		delete(x.words[w], ID)
+		if len(x.words[w]) > 0 {
+			continue
+		}
+
+		delete(x.words, w)
+		i := sort.SearchStrings(x.terms, w)
+		if i < len(x.terms) && x.terms[i] == w {
+			x.terms = append(x.terms[:i], x.terms[i+1:]...)
+		}
+	}
+	delete(x.posts, ID)
+}
+
+// search returns the score of each post that matches every word in the
+// query. A word matches the words in the post that start with it, but a whole
+// word scores higher. Words that are in fewer posts score higher.
+func (x *searchIndex) search(query string, allowed func(ID string) bool) map[string]float64 {
+	words := tokenize(query)
+	if len(words) == 0 {
+		return map[string]float64{}
+	}
+
+	var scores map[string]float64
+	total := float64(len(x.posts))
+	for _, q := range words {
+		best := make(map[string]float64)
+		for i := sort.SearchStrings(x.terms, q); i < len(x.terms) && strings.HasPrefix(x.terms[i], q); i++ {
+			w := x.terms[i]
+			posts := x.words[w]
+			idf := math.Log(1 + total/float64(len(posts)))
+			for ID, weight := range posts {
+				if !allowed(ID) {
+					continue
+				}
+
+				score := (1 + math.Log(weight)) * idf
+				if w != q {
+					score *= searchPrefixFactor
+				}
+				if score > best[ID] {
+					best[ID] = score
+				}
+			}
+		}
+
+		// Only keep the posts that match all of the words.
+		if scores == nil {
+			scores = best
+			continue
+		}
+		for ID := range scores {
+			if score, found := best[ID]; found {
+				scores[ID] += score
+			} else {
+				delete(scores, ID)
+			}
+		}
+	}
+
+	return scores
+}
+
+// searchPosts returns a page of the posts that match the query with the best
+// match first. Posts with the same score are returned newest first.
+func (x *postIndex) searchPosts(site *ambient.Site, query string, onlyPublished bool, limit int, offset int) ambient.PostSearchResults {
+	x.m.Lock()
+	defer x.m.Unlock()
+
+	x.sync(site, time.Now())
+
+	scores := x.search.search(query, func(ID string) bool {
+		return !onlyPublished || x.live[ID]
+	})
+
+	arr := make([]ambient.PostSearchResult, 0, len(scores))
+	for ID, score := range scores {
+		arr = append(arr, ambient.PostSearchResult{
+			PostWithID: x.byID[ID],
+			Score:      score,
+		})
+	}
+	sort.Slice(arr, func(i, j int) bool {
+		if arr[i].Score != arr[j].Score {
+			return arr[i].Score > arr[j].Score
+		}
+		return newer(arr[i].PostWithID, arr[j].PostWithID)
+	})
+
+	results := ambient.PostSearchResults{
+		Total:   len(arr),
+		Results: make([]ambient.PostSearchResult, 0),
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	if offset < len(arr) {
+		arr = arr[offset:]
+		if limit > 0 && limit < len(arr) {
+			arr = arr[:limit]
+		}
+		results.Results = append(results.Results, arr...)
+	}
+
+	return results
+}
@@ -0,0 +1,89 @@
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ambientkit/ambient"
+	"github.com/ambientkit/ambient/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+// resultIDs returns the post IDs of the search results.
+func resultIDs(results ambient.PostSearchResults) []string {
+	arr := make([]string, 0)
+	for _, r := range results.Results {
+		arr = append(arr, r.ID)
+	}
+	return arr
+}
+
+func TestPluginSystemSearchPosts(t *testing.T) {
+	storage, err := config.NewStorage(newLogger(t), newKeyedStore(), ambient.StoragePluginGroup{})
+	assert.NoError(t, err)
+	ps, err := config.NewPluginSystem(newLogger(t), storage, &ambient.PluginLoader{})
+	assert.NoError(t, err)
+
+	now := time.Now()
+	assert.NoError(t, ps.SavePost("1", ambient.Post{
+		Title:     "Gardening basics",
+		Content:   "How to plant tomatoes and water them.",
+		Timestamp: now.Add(-3 * time.Hour),
+		Published: true,
+	}))
+	assert.NoError(t, ps.SavePost("2", ambient.Post{
+		Title:     "Cooking dinner",
+		Content:   "A recipe with tomatoes from the garden.",
+		Timestamp: now.Add(-2 * time.Hour),
+		Published: true,
+		Tags:      ambient.TagList{{Name: "recipes"}},
+	}))
+	assert.NoError(t, ps.SavePost("3", ambient.Post{
+		Title:     "Draft about gardens",
+		Content:   "Not ready yet.",
+		Timestamp: now.Add(-1 * time.Hour),
+	}))
+
+	// Titles score higher than content and posts with the same score are
+	// returned newest first.
+	results := ps.SearchPosts("garden", false, 0, 0)
+	assert.Equal(t, 3, results.Total)
+	assert.Equal(t, []string{"3", "1", "2"}, resultIDs(results))
+	assert.Equal(t, []string{"1", "2"}, resultIDs(ps.SearchPosts("garden", true, 0, 0)))
+
+	// Every word must match.
+	assert.Equal(t, []string{"2"}, resultIDs(ps.SearchPosts("Tomatoes RECIPE", true, 0, 0)))
+	assert.Equal(t, []string{"2"}, resultIDs(ps.SearchPosts("recipes", true, 0, 0)))
+	assert.Equal(t, 0, ps.SearchPosts("tomatoes missing", true, 0, 0).Total)
+	assert.Equal(t, 0, ps.SearchPosts("  ", true, 0, 0).Total)
+
+	// Pages of results.
+	results = ps.SearchPosts("tomatoes", true, 1, 1)
+	assert.Equal(t, 2, results.Total)
+	assert.Len(t, results.Results, 1)
+	assert.Equal(t, 0, len(ps.SearchPosts("tomatoes", true, 1, 5).Results))
+
+	// The index is updated when posts change.
+	assert.NoError(t, ps.SavePost("1", ambient.Post{
+		Title:     "Watering",
+		Content:   "How to water plants.",
+		Timestamp: now.Add(-3 * time.Hour),
+		Published: true,
+	}))
+	assert.NoError(t, ps.DeletePostByID("2"))
+	assert.Equal(t, 0, ps.SearchPosts("tomatoes", false, 0, 0).Total)
+	assert.Equal(t, []string{"1"}, resultIDs(ps.SearchPosts("water", true, 0, 0)))
+
+	// Whole words score higher than prefixes.
+	assert.NoError(t, ps.SavePost("4", ambient.Post{
+		Title:     "Water",
+		Timestamp: now.Add(-4 * time.Hour),
+		Published: true,
+	}))
+	assert.Equal(t, []string{"4", "1"}, resultIDs(ps.SearchPosts("water", true, 0, 0)))
+	assert.Equal(t, []string{"3"}, resultIDs(ps.SearchPosts("gard", false, 0, 0)))
+
+	// The index is rebuilt after a reload.
+	assert.NoError(t, ps.Load())
+	assert.Equal(t, []string{"1"}, resultIDs(ps.SearchPosts("plants", true, 0, 0)))
+}
@@ -0,0 +1,267 @@
+package config
+
+import (
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/ambientkit/ambient"
+	"github.com/ambientkit/ambient/pkg/amberror"
+)
+
+// tagSlug returns the name in lowercase with each run of characters that are
+// not letters or numbers replaced by a dash.
+func tagSlug(name string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(name) {
+		if unicode.IsLetter(r) || unicode.IsNumber(r) {
+			if dash && b.Len() > 0 {
+				b.WriteRune('-')
+			}
+			b.WriteRune(r)
+			dash = false
+		} else {
+			dash = true
+		}
+	}
+
+	return b.String()
+}
+
+// tagCounts returns the number of posts with each tag.
+func (x *postIndex) tagCounts(site *ambient.Site, onlyPublished bool) map[string]int {
+	x.m.Lock()
+	defer x.m.Unlock()
+
+	x.sync(site, time.Now())
+
+	counts := make(map[string]int, len(x.tags))
+	for name, posts := range x.tags {
+		for postID := range posts {
+			if !onlyPublished || x.live[postID] {
+				counts[name]++
+			}
+		}
+	}
+
+	return counts
+}
+
+// tagDetail returns the tag with its metadata. The storage lock must be held.
+func (p *PluginSystem) tagDetail(name string, count int) ambient.TagDetail {
+	meta := p.storage.site.TagMeta[name]
+	if len(meta.Slug) == 0 {
+		meta.Slug = tagSlug(name)
+	}
+
+	return ambient.TagDetail{
+		Name:        name,
+		Slug:        meta.Slug,
+		Description: meta.Description,
+		Count:       count,
+	}
+}
+
+// tagDetails returns the tags on the posts with their metadata sorted by
+// name. The storage lock must be held.
+func (p *PluginSystem) tagDetails(onlyPublished bool) []ambient.TagDetail {
+	counts := p.storage.posts.tagCounts(p.storage.site, onlyPublished)
+	arr := make([]ambient.TagDetail, 0, len(counts))
+	for name, count := range counts {
+		if count > 0 {
+			arr = append(arr, p.tagDetail(name, count))
+		}
+	}
+	sort.Slice(arr, func(i, j int) bool {
+		return arr[i].Name < arr[j].Name
+	})
+
+	return arr
+}
+
+// TagDetails returns the tags with their metadata and the number of posts
+// with each tag sorted by name.
+func (p *PluginSystem) TagDetails(onlyPublished bool) []ambient.TagDetail {
+	p.storage.m.RLock()
+	defer p.storage.m.RUnlock()
+
+	return p.tagDetails(onlyPublished)
+}
+
+// TagBySlug returns the tag with the slug. If more than one tag has the slug,
+// the tag with the lowest name is returned.
+func (p *PluginSystem) TagBySlug(slug string, onlyPublished bool) (ambient.TagDetail, error) {
+	p.storage.m.RLock()
+	defer p.storage.m.RUnlock()
+
+	for _, tag := range p.tagDetails(onlyPublished) {
+		if tag.Slug == slug {
+			return tag, nil
+		}
+	}
+
+	return ambient.TagDetail{}, amberror.ErrNotFound
+}
+
+// replaceTag changes the tag name on every post with the tag and returns the
+// entries of the changed posts to save. If the new name is empty, the tag is
+// removed from the posts. A post that already has the new tag keeps only one
+// of them. Each changed post is marked as updated and keeps a revision so the
+// change can be undone. Returns amberror.ErrNotFound if no post has the tag.
+// The storage lock must be held.
+func (p *PluginSystem) replaceTag(name string, newName string) ([]entry, error) {
+	now := time.Now()
+	entries := make([]entry, 0)
+	for ID, post := range p.storage.site.Posts {
+		if !hasTag(post.Tags, name) {
+			continue
+		}
+
+		keep := len(newName) > 0 && !hasTag(post.Tags, newName)
+		tags := make(ambient.TagList, 0, len(post.Tags))
+		for _, t := range post.Tags {
+			if t.Name == name {
+				if !keep {
+					continue
+				}
+				t.Name = newName
+			}
+			tags = append(tags, t)
+		}
+		post.Tags = tags
+		post.Updated = now
+
+		p.storage.site.Posts[ID] = post
+		p.storage.posts.put(p.storage.site, ID, post)
+		p.storage.addRevision(ID, post, "")
+		entries = append(entries, entry{collection: collectionPosts, key: ID})
+	}
+
+	if len(entries) == 0 {
+		return nil, amberror.ErrNotFound
+	}
+
+	return entries, nil
+}
+
+// hasTag returns true if the tag name is in the list.
+func hasTag(tags ambient.TagList, name string) bool {
+	for _, t := range tags {
+		if t.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RenameTag renames a tag on every post and keeps its metadata. Returns
+// amberror.ErrInvalidTag if the new name is empty or is already used by a
+// post.
+func (p *PluginSystem) RenameTag(name string, newName string) error {
+	newName = strings.TrimSpace(newName)
+	if len(name) == 0 || len(newName) == 0 {
+		return amberror.ErrInvalidTag
+	}
+
+	e := ambient.Event{Type: ambient.EventTagChanged, Name: name}
+	return p.updateEvent(e, func() error {
+		if p.storage.posts.tagCounts(p.storage.site, false)[newName] > 0 {
+			return amberror.ErrInvalidTag
+		}
+
+		entries, err := p.replaceTag(name, newName)
+		if err != nil {
+			return err
+		}
+
+		if meta, found := p.storage.site.TagMeta[name]; found {
+			delete(p.storage.site.TagMeta, name)
+			p.storage.site.TagMeta[newName] = meta
+		}
+
+		return p.storage.commit(true, entries...)
+	})
+}
+
+// MergeTags replaces the tag with the other tag on every post. The metadata
+// of the replaced tag is removed. Returns amberror.ErrNotFound if either tag
+// is not on a post.
+func (p *PluginSystem) MergeTags(name string, into string) error {
+	if len(name) == 0 || len(into) == 0 || name == into {
+		return amberror.ErrInvalidTag
+	}
+
+	e := ambient.Event{Type: ambient.EventTagChanged, Name: name}
+	return p.updateEvent(e, func() error {
+		if p.storage.posts.tagCounts(p.storage.site, false)[into] == 0 {
+			return amberror.ErrNotFound
+		}
+
+		entries, err := p.replaceTag(name, into)
+		if err != nil {
+			return err
+		}
+
+		delete(p.storage.site.TagMeta, name)
+		return p.storage.commit(true, entries...)
+	})
+}
+
+// DeleteTag removes a tag from every post along with its metadata. Returns
+// amberror.ErrNotFound if the tag is not on a post.
+func (p *PluginSystem) DeleteTag(name string) error {
+	e := ambient.Event{Type: ambient.EventTagChanged, Name: name}
+	return p.updateEvent(e, func() error {
+		entries, err := p.replaceTag(name, "")
+		if err != nil {
+			return err
+		}
+
+		delete(p.storage.site.TagMeta, name)
+		return p.storage.commit(true, entries...)
+	})
+}
+
+// SetTagMeta sets the description and slug of a tag. An empty slug is
+// generated from the tag name. Returns amberror.ErrNotFound if the tag is not
+// on a post and amberror.ErrInvalidTag if the slug is used by another tag.
+func (p *PluginSystem) SetTagMeta(name string, meta ambient.TagMeta) error {
+	if len(meta.Slug) > 0 {
+		meta.Slug = tagSlug(meta.Slug)
+		if len(meta.Slug) == 0 {
+			return amberror.ErrInvalidTag
+		}
+	}
+
+	e := ambient.Event{Type: ambient.EventTagChanged, Name: name}
+	return p.updateEvent(e, func() error {
+		counts := p.storage.posts.tagCounts(p.storage.site, false)
+		if counts[name] == 0 {
+			return amberror.ErrNotFound
+		}
+
+		slug := meta.Slug
+		if len(slug) == 0 {
+			slug = tagSlug(name)
+		}
+		for other, count := range counts {
+			if other != name && p.tagDetail(other, count).Slug == slug {
+				return amberror.ErrInvalidTag
+			}
+		}
+
+		if meta == (ambient.TagMeta{}) {
+			delete(p.storage.site.TagMeta, name)
+		} else {
+			if p.storage.site.TagMeta == nil {
+				p.storage.site.TagMeta = make(map[string]ambient.TagMeta)
+			}
+			p.storage.site.TagMeta[name] = meta
+		}
+
+		return p.storage.saveSite()
+	})
+}
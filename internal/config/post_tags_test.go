@@ -0,0 +1,104 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/ambientkit/ambient"
+	"github.com/ambientkit/ambient/internal/config"
+	"github.com/ambientkit/ambient/pkg/amberror"
+	"github.com/stretchr/testify/assert"
+)
+
+// tagCounts returns the number of posts by tag name.
+func tagCounts(tags []ambient.TagDetail) map[string]int {
+	m := make(map[string]int)
+	for _, t := range tags {
+		m[t.Name] = t.Count
+	}
+	return m
+}
+
+func TestPluginSystemTags(t *testing.T) {
+	log := newLogger(t)
+	ks := newKeyedStore()
+	storage, err := config.NewStorage(log, ks, ambient.StoragePluginGroup{})
+	assert.NoError(t, err)
+	ps, err := config.NewPluginSystem(log, storage, &ambient.PluginLoader{})
+	assert.NoError(t, err)
+
+	tags := func(names ...string) ambient.TagList {
+		arr := make(ambient.TagList, 0)
+		for _, name := range names {
+			arr = append(arr, ambient.Tag{Name: name})
+		}
+		return arr
+	}
+	assert.NoError(t, ps.SavePost("1", ambient.Post{Title: "One", Published: true, Tags: tags("go", "golang")}))
+	assert.NoError(t, ps.SavePost("2", ambient.Post{Title: "Two", Published: true, Tags: tags("golang", "web")}))
+	assert.NoError(t, ps.SavePost("3", ambient.Post{Title: "Three", Tags: tags("Web Dev")}))
+
+	assert.Equal(t, map[string]int{"go": 1, "golang": 2, "web": 1, "Web Dev": 1}, tagCounts(ps.TagDetails(false)))
+	assert.Equal(t, map[string]int{"go": 1, "golang": 2, "web": 1}, tagCounts(ps.TagDetails(true)))
+
+	// Slugs are generated from the name unless they are set.
+	tag, err := ps.TagBySlug("web-dev", false)
+	assert.NoError(t, err)
+	assert.Equal(t, "Web Dev", tag.Name)
+	_, err = ps.TagBySlug("web-dev", true)
+	assert.Equal(t, amberror.ErrNotFound, err)
+
+	assert.Equal(t, amberror.ErrInvalidTag, ps.SetTagMeta("golang", ambient.TagMeta{Slug: "Go"}))
+	assert.Equal(t, amberror.ErrNotFound, ps.SetTagMeta("missing", ambient.TagMeta{Description: "Missing"}))
+	assert.NoError(t, ps.SetTagMeta("golang", ambient.TagMeta{Slug: "Go Lang", Description: "The Go language."}))
+	tag, err = ps.TagBySlug("go-lang", true)
+	assert.NoError(t, err)
+	assert.Equal(t, ambient.TagDetail{Name: "golang", Slug: "go-lang", Description: "The Go language.", Count: 2}, tag)
+
+	// Renames keep the metadata and can't use a name that is already used.
+	assert.Equal(t, amberror.ErrInvalidTag, ps.RenameTag("golang", "web"))
+	assert.Equal(t, amberror.ErrNotFound, ps.RenameTag("missing", "other"))
+	assert.NoError(t, ps.RenameTag("golang", "Go Language"))
+
+	// The changed posts are marked as updated and keep a revision.
+	post, err := ps.PostByID("2")
+	assert.NoError(t, err)
+	assert.False(t, post.Updated.IsZero())
+	revisions, err := ps.PostRevisions("2")
+	assert.NoError(t, err)
+	assert.Len(t, revisions, 2)
+	assert.Equal(t, tags("Go Language", "web"), revisions[0].Post.Tags)
+	revisions, err = ps.PostRevisions("3")
+	assert.NoError(t, err)
+	assert.Len(t, revisions, 1)
+	post, err = ps.PostByID("2")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Go Language", "web"}, tagNames(post.Tags))
+	tag, err = ps.TagBySlug("go-lang", true)
+	assert.NoError(t, err)
+	assert.Equal(t, "Go Language", tag.Name)
+
+	// Merges don't leave a post with the same tag twice.
+	assert.Equal(t, amberror.ErrNotFound, ps.MergeTags("go", "missing"))
+	assert.NoError(t, ps.MergeTags("go", "Go Language"))
+	post, err = ps.PostByID("1")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Go Language"}, tagNames(post.Tags))
+	assert.Equal(t, []string{"Go Language", "Web Dev", "web"}, tagNames(ps.Tags(false)))
+
+	assert.NoError(t, ps.DeleteTag("web"))
+	assert.Equal(t, amberror.ErrNotFound, ps.DeleteTag("web"))
+	assert.Equal(t, map[string]int{"Go Language": 2, "Web Dev": 1}, tagCounts(ps.TagDetails(false)))
+
+	// The changes are saved.
+	storage, err = config.NewStorage(log, ks, ambient.StoragePluginGroup{})
+	assert.NoError(t, err)
+	ps, err = config.NewPluginSystem(log, storage, &ambient.PluginLoader{})
+	assert.NoError(t, err)
+
+	post, err = ps.PostByID("2")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Go Language"}, tagNames(post.Tags))
+	tag, err = ps.TagBySlug("go-lang", false)
+	assert.NoError(t, err)
+	assert.Equal(t, "The Go language.", tag.Description)
+}
@@ -0,0 +1,131 @@
+package config
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ambientkit/ambient"
+	"github.com/ambientkit/ambient/pkg/amberror"
+)
+
+// validateParent returns an error if the parent of the post is not saved or
+// if the post would become its own ancestor. The storage lock must be held.
+func (p *PluginSystem) validateParent(ID string, post ambient.Post) error {
+	if len(post.ParentID) == 0 {
+		return nil
+	}
+
+	seen := make(map[string]bool)
+	for parentID := post.ParentID; len(parentID) > 0 && !seen[parentID]; {
+		if parentID == ID {
+			return &amberror.FieldError{Field: "parentid", Reason: "would make the post its own ancestor"}
+		}
+		seen[parentID] = true
+
+		parent, found := p.storage.site.Posts[parentID]
+		if !found {
+			if parentID == post.ParentID {
+				return &amberror.FieldError{Field: "parentid", Reason: "is not a saved post"}
+			}
+			break
+		}
+		parentID = parent.ParentID
+	}
+
+	return nil
+}
+
+// pageOrder returns true if the page comes before the other page among the
+// pages with the same parent: by order, then by title, then by ID.
+func pageOrder(a ambient.PostWithID, b ambient.PostWithID) bool {
+	if a.Order != b.Order {
+		return a.Order < b.Order
+	}
+	at, bt := strings.ToLower(a.Title), strings.ToLower(b.Title)
+	if at != bt {
+		return at < bt
+	}
+	return a.ID < b.ID
+}
+
+// pageChildren returns the published posts with the parent ID in page order.
+// If the parent ID is empty, the published top level pages are returned.
+func (x *postIndex) pageChildren(site *ambient.Site, parentID string) ambient.PostWithIDList {
+	x.m.Lock()
+	defer x.m.Unlock()
+
+	x.sync(site, time.Now())
+
+	arr := make(ambient.PostWithIDList, 0)
+	for _, p := range x.published {
+		if p.ParentID != parentID {
+			continue
+		} else if len(parentID) == 0 && p.ContentType() != ambient.ContentTypePage {
+			continue
+		}
+		arr = append(arr, p)
+	}
+	sort.Slice(arr, func(i, j int) bool {
+		return pageOrder(arr[i], arr[j])
+	})
+
+	return arr
+}
+
+// breadcrumbs returns the published ancestors of the post with the top level
+// page first followed by the post itself. Ancestors that are not published are
+// left out. Returns an empty list if the post is not found.
+func (x *postIndex) breadcrumbs(site *ambient.Site, ID string) ambient.PostWithIDList {
+	x.m.Lock()
+	defer x.m.Unlock()
+
+	x.sync(site, time.Now())
+
+	arr := make(ambient.PostWithIDList, 0)
+	p, found := x.byID[ID]
+	if !found {
+		return arr
+	}
+	arr = append(arr, p)
+
+	seen := map[string]bool{ID: true}
+	for parentID := p.ParentID; len(parentID) > 0 && !seen[parentID]; {
+		seen[parentID] = true
+
+		parent, found := x.byID[parentID]
+		if !found {
+			break
+		}
+		if x.live[parentID] {
+			arr = append(arr, parent)
+		}
+		parentID = parent.ParentID
+	}
+
+	// Reverse so the top level page is first.
+	for i, j := 0, len(arr)-1; i < j; i, j = i+1, j-1 {
+		arr[i], arr[j] = arr[j], arr[i]
+	}
+
+	return arr
+}
+
+// PageChildren returns the published posts and pages under the parent sorted
+// by order and then by title. If the ID is empty, the published top level
+// pages are returned.
+func (p *PluginSystem) PageChildren(ID string) ambient.PostWithIDList {
+	p.storage.m.RLock()
+	defer p.storage.m.RUnlock()
+
+	return p.storage.posts.pageChildren(p.storage.site, ID)
+}
+
+// Breadcrumbs returns the post with its published parent pages with the top
+// level page first. Returns an empty list if the post is not found.
+func (p *PluginSystem) Breadcrumbs(ID string) ambient.PostWithIDList {
+	p.storage.m.RLock()
+	defer p.storage.m.RUnlock()
+
+	return p.storage.posts.breadcrumbs(p.storage.site, ID)
+}
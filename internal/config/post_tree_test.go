@@ -0,0 +1,124 @@
+package config_test
+
+import (
+	"bytes"
+	"errors"
+	"html/template"
+	"testing"
+
+	"github.com/ambientkit/ambient"
+	"github.com/ambientkit/ambient/internal/config"
+	"github.com/ambientkit/ambient/pkg/amberror"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPluginSystemPageTree(t *testing.T) {
+	log := newLogger(t)
+	ks := newKeyedStore()
+	storage, err := config.NewStorage(log, ks, ambient.StoragePluginGroup{})
+	assert.NoError(t, err)
+	ps, err := config.NewPluginSystem(log, storage, &ambient.PluginLoader{})
+	assert.NoError(t, err)
+
+	assert.NoError(t, ps.SavePost("about", ambient.Post{Title: "About", URL: "about", Page: true, Published: true}))
+	assert.NoError(t, ps.SavePost("team", ambient.Post{Title: "Team", URL: "team", Page: true, Published: true, ParentID: "about", Order: 2}))
+	assert.NoError(t, ps.SavePost("history", ambient.Post{Title: "History", URL: "history", Page: true, Published: true, ParentID: "about", Order: 1}))
+	assert.NoError(t, ps.SavePost("jobs", ambient.Post{Title: "Jobs", URL: "jobs", Page: true, ParentID: "about"}))
+	assert.NoError(t, ps.SavePost("people", ambient.Post{Title: "People", URL: "people", Page: true, Published: true, ParentID: "team"}))
+	assert.NoError(t, ps.SavePost("hello", ambient.Post{Title: "Hello", URL: "hello", Published: true}))
+
+	// The parent must be saved and can't be a child of the post.
+	err = ps.SavePost("team", ambient.Post{Title: "Team", Page: true, ParentID: "missing"})
+	assert.True(t, errors.Is(err, amberror.ErrInvalidPost))
+	err = ps.SavePost("about", ambient.Post{Title: "About", Page: true, ParentID: "people"})
+	assert.True(t, errors.Is(err, amberror.ErrInvalidPost))
+
+	// Children are sorted by order and only published posts are returned.
+	assert.Equal(t, []string{"about"}, postIDs(ps.PageChildren("")))
+	assert.Equal(t, []string{"history", "team"}, postIDs(ps.PageChildren("about")))
+	assert.Equal(t, []string{"about", "team", "people"}, postIDs(ps.Breadcrumbs("people")))
+	assert.Empty(t, ps.Breadcrumbs("missing"))
+
+	// Menus link to posts, routes, and external URLs.
+	assert.Equal(t, amberror.ErrInvalidMenu, ps.SaveMenu(ambient.Menu{Items: []ambient.MenuItem{{PostID: "about"}}}))
+	assert.Equal(t, amberror.ErrInvalidMenu, ps.SaveMenu(ambient.Menu{Name: "main", Items: []ambient.MenuItem{{PostID: "missing"}}}))
+	assert.Equal(t, amberror.ErrInvalidMenu, ps.SaveMenu(ambient.Menu{Name: "main", Items: []ambient.MenuItem{{Title: "Both", PostID: "about", URL: "/about"}}}))
+
+	assert.NoError(t, ps.SaveMenu(ambient.Menu{
+		Name: "main",
+		Items: []ambient.MenuItem{
+			{PostID: "about", Children: []ambient.MenuItem{
+				{PostID: "team", Title: "Our team"},
+				{PostID: "jobs"},
+			}},
+			{Title: "Login", URL: "/login"},
+			{Title: "Source", URL: "https://github.com/ambientkit/ambient"},
+		},
+	}))
+	assert.NoError(t, ps.SaveMenu(ambient.Menu{Name: "footer"}))
+
+	assert.Equal(t, []ambient.MenuLink{
+		{Title: "About", URL: "/about", Children: []ambient.MenuLink{
+			{Title: "Our team", URL: "/team", Children: []ambient.MenuLink{}},
+		}},
+		{Title: "Login", URL: "/login", Children: []ambient.MenuLink{}},
+		{Title: "Source", URL: "https://github.com/ambientkit/ambient", External: true, Children: []ambient.MenuLink{}},
+	}, ps.MenuLinks("main"))
+	assert.Empty(t, ps.MenuLinks("missing"))
+
+	// Templates render the menus and breadcrumbs with the core FuncMap.
+	tmpl, err := template.New("nav").Funcs(ambient.NavigationFuncMap(nil, ps)).Parse(
+		`{{range Menu "main"}}[{{.Title}}]{{end}} {{range Breadcrumbs "people"}}/{{.URL}}{{end}}`)
+	assert.NoError(t, err)
+	buf := new(bytes.Buffer)
+	assert.NoError(t, tmpl.Execute(buf, nil))
+	assert.Equal(t, "[About][Login][Source] /about/team/people", buf.String())
+
+	// The menus are saved with the site.
+	storage, err = config.NewStorage(log, ks, ambient.StoragePluginGroup{})
+	assert.NoError(t, err)
+	ps, err = config.NewPluginSystem(log, storage, &ambient.PluginLoader{})
+	assert.NoError(t, err)
+
+	menus := ps.Menus()
+	assert.Len(t, menus, 2)
+	assert.Equal(t, "footer", menus[0].Name)
+	menu, err := ps.Menu("main")
+	assert.NoError(t, err)
+	assert.Len(t, menu.Items, 3)
+
+	assert.NoError(t, ps.DeleteMenu("footer"))
+	assert.Equal(t, amberror.ErrNotFound, ps.DeleteMenu("footer"))
+	_, err = ps.Menu("footer")
+	assert.Equal(t, amberror.ErrNotFound, err)
+}
+
+func TestPluginSystemRestoreRevisionParent(t *testing.T) {
+	log := newLogger(t)
+	storage, err := config.NewStorage(log, newKeyedStore(), ambient.StoragePluginGroup{})
+	assert.NoError(t, err)
+	ps, err := config.NewPluginSystem(log, storage, &ambient.PluginLoader{})
+	assert.NoError(t, err)
+
+	assert.NoError(t, ps.SavePost("a", ambient.Post{Title: "A", Page: true}))
+	assert.NoError(t, ps.SavePost("b", ambient.Post{Title: "B", Page: true}))
+	assert.NoError(t, ps.SavePostAs("a", ambient.Post{Title: "A", Page: true, ParentID: "b"}, "admin"))
+	revisions, err := ps.PostRevisions("a")
+	assert.NoError(t, err)
+	underB := revisions[0].ID
+
+	// Restoring the revision would make a cycle.
+	assert.NoError(t, ps.SavePost("a", ambient.Post{Title: "A", Page: true}))
+	assert.NoError(t, ps.SavePost("b", ambient.Post{Title: "B", Page: true, ParentID: "a"}))
+	err = ps.RestorePostRevision("a", underB, "admin")
+	assert.True(t, errors.Is(err, amberror.ErrInvalidPost))
+
+	// Restoring the revision would point to a missing parent.
+	assert.NoError(t, ps.DeletePostByID("b"))
+	err = ps.RestorePostRevision("a", underB, "admin")
+	assert.True(t, errors.Is(err, amberror.ErrInvalidPost))
+
+	post, err := ps.PostByID("a")
+	assert.NoError(t, err)
+	assert.Empty(t, post.ParentID)
+}
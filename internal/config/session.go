@@ -0,0 +1,126 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/ambientkit/ambient"
+)
+
+// SessionStorer encrypts the session data before it is written by another
+// SessionStorer. Session data that was written before encryption was enabled
+// is read as plaintext and then written back encrypted.
+type SessionStorer struct {
+	log    ambient.AppLogger
+	ss     ambient.SessionStorer
+	secure ambient.StorageEncryption
+
+	m sync.Mutex
+}
+
+// NewSessionStorer returns a SessionStorer that encrypts the session data.
+func NewSessionStorer(log ambient.AppLogger, ss ambient.SessionStorer, es ambient.StorageEncryption) *SessionStorer {
+	return &SessionStorer{
+		log:    log,
+		ss:     ss,
+		secure: es,
+	}
+}
+
+// Save encrypts and writes the session data.
+func (s *SessionStorer) Save(b []byte) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	return s.save(b, true)
+}
+
+// Load reads and decrypts the session data. Plaintext session data is
+// encrypted and written back.
+func (s *SessionStorer) Load() ([]byte, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	b, plaintext, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+
+	if plaintext {
+		s.log.Info("encrypting plaintext session data")
+		err = s.save(b, true)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return b, nil
+}
+
+// SaveEncrypted writes the stored session data encrypted.
+func (s *SessionStorer) SaveEncrypted() error {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	b, _, err := s.load()
+	if err != nil {
+		return err
+	}
+
+	return s.save(b, true)
+}
+
+// SaveDecrypted writes the stored session data decrypted. It will be
+// encrypted again on the next save.
+func (s *SessionStorer) SaveDecrypted() error {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	b, _, err := s.load()
+	if err != nil {
+		return err
+	}
+
+	return s.save(b, false)
+}
+
+// RotateEncryption re-encrypts the session data with the current encryption
+// key.
+func (s *SessionStorer) RotateEncryption() error {
+	return s.SaveEncrypted()
+}
+
+// save writes the session data and encrypts it if set.
+func (s *SessionStorer) save(b []byte, encrypt bool) error {
+	if encrypt && len(b) > 0 {
+		var err error
+		b, err = s.secure.Encrypt(b)
+		if err != nil {
+			return fmt.Errorf("could not encrypt session data: %v", err.Error())
+		}
+	}
+
+	return s.ss.Save(b)
+}
+
+// load reads and decrypts the session data. Returns true if the data was not
+// encrypted.
+func (s *SessionStorer) load() ([]byte, bool, error) {
+	b, err := s.ss.Load()
+	if err != nil || len(b) == 0 {
+		return b, false, err
+	}
+
+	decrypted, err := s.secure.Decrypt(b)
+	if err != nil {
+		// Session data written before encryption was enabled.
+		if json.Valid(b) {
+			return b, true, nil
+		}
+
+		return nil, false, fmt.Errorf("could not decrypt session data: %v", err.Error())
+	}
+
+	return decrypted, false, nil
+}
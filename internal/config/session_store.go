@@ -0,0 +1,401 @@
+package config
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ambientkit/ambient"
+	"github.com/ambientkit/ambient/pkg/amberror"
+)
+
+const (
+	// sessionFormat marks the session data that is stored by token.
+	sessionFormat = "keyed"
+
+	// sessionTouchInterval is how far the expiry of a session that is stored
+	// by token must move before the session is written again. Sessions with an
+	// idle timeout are committed on every request so this keeps the session
+	// data from being rewritten each time.
+	sessionTouchInterval = time.Minute
+)
+
+// storedSessions is the session data when the sessions are stored by token
+// in a single object.
+type storedSessions struct {
+	Format   string                   `json:"format"`
+	Sessions map[string]storedSession `json:"sessions"`
+}
+
+// storedSession is a single session in the session data.
+type storedSession struct {
+	Username string    `json:"username,omitempty"`
+	Data     []byte    `json:"data"`
+	Created  time.Time `json:"created"`
+	Expires  time.Time `json:"expires"`
+}
+
+// SessionStore lets the session manager read and write one session at a time.
+// If the session storage plugin supports keyed sessions, each session is
+// encrypted and written to it, else the sessions are stored by token in the
+// session data. Save and Load still read and write the whole session data for
+// session managers that don't use keyed sessions.
+type SessionStore struct {
+	log    ambient.AppLogger
+	ss     ambient.SessionStorer
+	ks     ambient.KeyedSessionStorer
+	secure ambient.StorageEncryption
+
+	m sync.Mutex
+}
+
+// NewSessionStore returns a SessionStore that encrypts the session data if
+// the encryption is set.
+func NewSessionStore(log ambient.AppLogger, ss ambient.SessionStorer, es ambient.StorageEncryption) *SessionStore {
+	s := &SessionStore{
+		log: log,
+		ss:  ss,
+	}
+
+	if ks, ok := ss.(ambient.KeyedSessionStorer); ok {
+		s.ks = ks
+		s.secure = es
+	}
+
+	if es != nil {
+		s.ss = NewSessionStorer(log, ss, es)
+	}
+
+	return s
+}
+
+// Save writes the session data.
+func (s *SessionStore) Save(b []byte) error {
+	return s.ss.Save(b)
+}
+
+// Load reads the session data.
+func (s *SessionStore) Load() ([]byte, error) {
+	return s.ss.Load()
+}
+
+// SaveEncrypted writes the stored session data encrypted if the encryption is
+// set.
+func (s *SessionStore) SaveEncrypted() error {
+	if se, ok := s.ss.(*SessionStorer); ok {
+		return se.SaveEncrypted()
+	}
+
+	return nil
+}
+
+// SaveDecrypted writes the stored session data decrypted if the encryption
+// is set.
+func (s *SessionStore) SaveDecrypted() error {
+	if se, ok := s.ss.(*SessionStorer); ok {
+		return se.SaveDecrypted()
+	}
+
+	return nil
+}
+
+// RotateEncryption re-encrypts the session data with the current encryption
+// key. Keyed sessions are re-encrypted if the session storage plugin can
+// rewrite them, else they are re-encrypted the next time they are written.
+func (s *SessionStore) RotateEncryption() error {
+	err := s.SaveEncrypted()
+	if err != nil {
+		return err
+	}
+
+	if s.ks == nil || s.secure == nil {
+		return nil
+	}
+
+	rw, ok := s.ks.(ambient.SessionRewriter)
+	if !ok {
+		s.log.Warn("session storage can't rewrite sessions, they are re-encrypted the next time they are written")
+		return nil
+	}
+
+	err = rw.Rewrite(func(b []byte) ([]byte, error) {
+		decrypted, err := s.secure.Decrypt(b)
+		if err != nil {
+			// A session that can't be read is the same as no session.
+			s.log.Warn("could not decrypt session: %v", err.Error())
+			return b, nil
+		}
+
+		b, err = s.secure.Encrypt(decrypted)
+		if err != nil {
+			return nil, fmt.Errorf("could not encrypt session: %v", err.Error())
+		}
+
+		return b, nil
+	})
+	if errors.Is(err, amberror.ErrSessionRewriteNotSupported) {
+		s.log.Warn("session storage can't rewrite sessions, they are re-encrypted the next time they are written")
+		return nil
+	}
+
+	return err
+}
+
+// Commit writes the session. Expired sessions are removed at the same time.
+// If the sessions are stored by token in the session data, the data is only
+// written if the session changed or its expiry moved by more than the touch
+// interval.
+func (s *SessionStore) Commit(token string, username string, b []byte, expiry time.Time) error {
+	if s.ks != nil {
+		if s.secure != nil {
+			var err error
+			b, err = s.secure.Encrypt(b)
+			if err != nil {
+				return fmt.Errorf("could not encrypt session: %v", err.Error())
+			}
+		}
+
+		return s.ks.Commit(token, username, b, expiry)
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	sessions, err := s.load()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	removed := sweep(sessions, now)
+
+	created := now.UTC()
+	if old, found := sessions[token]; found {
+		if removed == 0 && old.Username == username && bytes.Equal(old.Data, b) &&
+			!expiry.Before(old.Expires) && expiry.Sub(old.Expires) < sessionTouchInterval {
+			return nil
+		}
+		created = old.Created
+	}
+
+	sessions[token] = storedSession{
+		Username: username,
+		Data:     b,
+		Created:  created,
+		Expires:  expiry.UTC(),
+	}
+
+	return s.save(sessions)
+}
+
+// Find returns the session if it's found and not expired.
+func (s *SessionStore) Find(token string) ([]byte, bool, error) {
+	if s.ks != nil {
+		b, found, err := s.ks.Find(token)
+		if err != nil || !found || s.secure == nil {
+			return b, found, err
+		}
+
+		b, err = s.secure.Decrypt(b)
+		if err != nil {
+			// A session that can't be read is the same as no session.
+			s.log.Warn("could not decrypt session: %v", err.Error())
+			return nil, false, nil
+		}
+
+		return b, true, nil
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	sessions, err := s.load()
+	if err != nil {
+		return nil, false, err
+	}
+
+	session, found := sessions[token]
+	if !found || !session.Expires.After(time.Now()) {
+		return nil, false, nil
+	}
+
+	return session.Data, true, nil
+}
+
+// Delete removes the session.
+func (s *SessionStore) Delete(token string) error {
+	if s.ks != nil {
+		return s.ks.Delete(token)
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	sessions, err := s.load()
+	if err != nil {
+		return err
+	}
+
+	if _, found := sessions[token]; !found {
+		return nil
+	}
+
+	delete(sessions, token)
+
+	return s.save(sessions)
+}
+
+// UserSessions returns the sessions for a username that are not expired with
+// the oldest first.
+func (s *SessionStore) UserSessions(username string) ([]ambient.UserSession, error) {
+	if s.ks != nil {
+		return s.ks.UserSessions(username)
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	sessions, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	arr := make([]ambient.UserSession, 0)
+	for token, session := range sessions {
+		if session.Username != username || !session.Expires.After(now) {
+			continue
+		}
+
+		arr = append(arr, ambient.UserSession{
+			ID:       sessionID(token),
+			Username: session.Username,
+			Created:  session.Created,
+			Expires:  session.Expires,
+		})
+	}
+
+	sort.Slice(arr, func(i, j int) bool {
+		if !arr[i].Created.Equal(arr[j].Created) {
+			return arr[i].Created.Before(arr[j].Created)
+		}
+		return arr[i].ID < arr[j].ID
+	})
+
+	return arr, nil
+}
+
+// DeleteUserSessions removes all of the sessions for a username.
+func (s *SessionStore) DeleteUserSessions(username string) error {
+	if s.ks != nil {
+		return s.ks.DeleteUserSessions(username)
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	sessions, err := s.load()
+	if err != nil {
+		return err
+	}
+
+	removed := 0
+	for token, session := range sessions {
+		if session.Username == username {
+			delete(sessions, token)
+			removed++
+		}
+	}
+	if removed == 0 {
+		return nil
+	}
+
+	return s.save(sessions)
+}
+
+// Sweep removes the expired sessions and returns how many were removed. The
+// session storage plugin is expected to expire keyed sessions on its own.
+func (s *SessionStore) Sweep() (int, error) {
+	if s.ks != nil {
+		return 0, nil
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	sessions, err := s.load()
+	if err != nil {
+		return 0, err
+	}
+
+	removed := sweep(sessions, time.Now())
+	if removed == 0 {
+		return 0, nil
+	}
+
+	return removed, s.save(sessions)
+}
+
+// sessionID returns an ID for a session token that doesn't reveal the token.
+func sessionID(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:8])
+}
+
+// load returns the sessions stored by token. Session data written by a
+// session manager that doesn't use keyed sessions is ignored and replaced on
+// the next write.
+func (s *SessionStore) load() (map[string]storedSession, error) {
+	b, err := s.ss.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	data := storedSessions{}
+	if len(b) > 0 {
+		err = json.Unmarshal(b, &data)
+		if err != nil || data.Format != sessionFormat {
+			s.log.Debug("session data is not stored by token so it's ignored")
+			data = storedSessions{}
+		}
+	}
+
+	if data.Sessions == nil {
+		data.Sessions = make(map[string]storedSession)
+	}
+
+	return data.Sessions, nil
+}
+
+// save writes the sessions stored by token.
+func (s *SessionStore) save(sessions map[string]storedSession) error {
+	b, err := json.Marshal(storedSessions{
+		Format:   sessionFormat,
+		Sessions: sessions,
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.ss.Save(b)
+}
+
+// sweep removes the sessions that are expired and returns how many were
+// removed.
+func sweep(sessions map[string]storedSession, now time.Time) int {
+	removed := 0
+	for token, session := range sessions {
+		if !session.Expires.After(now) {
+			delete(sessions, token)
+			removed++
+		}
+	}
+
+	return removed
+}
@@ -0,0 +1,208 @@
+package config_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/ambientkit/ambient"
+	"github.com/ambientkit/ambient/internal/config"
+	"github.com/ambientkit/ambient/pkg/keyring"
+	"github.com/ambientkit/ambient/pkg/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSessionStorer(t *testing.T) {
+	log := newLogger(t)
+
+	ring, err := keyring.New(keyring.Key{ID: "1", Secret: bytes.Repeat([]byte("a"), 32)})
+	assert.NoError(t, err)
+
+	// Session data written before encryption was enabled.
+	ms := mock.NewMemoryStore()
+	assert.NoError(t, ms.Save([]byte(`{"user":"admin"}`)))
+
+	ss := config.NewSessionStorer(log, ms, ring)
+	b, err := ss.Load()
+	assert.NoError(t, err)
+	assert.Equal(t, `{"user":"admin"}`, string(b))
+
+	// The plaintext should be encrypted on load.
+	raw, err := ms.Load()
+	assert.NoError(t, err)
+	assert.False(t, ring.NeedsRotation(raw))
+
+	assert.NoError(t, ss.SaveDecrypted())
+	raw, err = ms.Load()
+	assert.NoError(t, err)
+	assert.Equal(t, `{"user":"admin"}`, string(raw))
+
+	assert.NoError(t, ss.Save([]byte(`{"user":"other"}`)))
+	b, err = ss.Load()
+	assert.NoError(t, err)
+	assert.Equal(t, `{"user":"other"}`, string(b))
+}
+
+func TestSessionStore(t *testing.T) {
+	log := newLogger(t)
+
+	ring, err := keyring.New(keyring.Key{ID: "1", Secret: bytes.Repeat([]byte("a"), 32)})
+	assert.NoError(t, err)
+
+	ms := mock.NewMemoryStore()
+	ss := config.NewSessionStore(log, ms, ring)
+
+	future := time.Now().Add(time.Hour)
+	assert.NoError(t, ss.Commit("a", "admin", []byte("one"), future))
+	assert.NoError(t, ss.Commit("b", "admin", []byte("two"), future))
+	assert.NoError(t, ss.Commit("c", "other", []byte("three"), future))
+	assert.NoError(t, ss.Commit("d", "", []byte("guest"), future))
+
+	b, found, err := ss.Find("b")
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "two", string(b))
+
+	// The session data is encrypted.
+	raw, err := ms.Load()
+	assert.NoError(t, err)
+	assert.NotContains(t, string(raw), "admin")
+
+	sessions, err := ss.UserSessions("admin")
+	assert.NoError(t, err)
+	assert.Len(t, sessions, 2)
+	for _, session := range sessions {
+		assert.Equal(t, "admin", session.Username)
+		assert.NotContains(t, []string{"a", "b"}, session.ID)
+	}
+
+	// Revoke one user's sessions.
+	assert.NoError(t, ss.DeleteUserSessions("admin"))
+	sessions, err = ss.UserSessions("admin")
+	assert.NoError(t, err)
+	assert.Empty(t, sessions)
+	_, found, err = ss.Find("a")
+	assert.NoError(t, err)
+	assert.False(t, found)
+	_, found, err = ss.Find("c")
+	assert.NoError(t, err)
+	assert.True(t, found)
+
+	assert.NoError(t, ss.Delete("c"))
+	_, found, err = ss.Find("c")
+	assert.NoError(t, err)
+	assert.False(t, found)
+
+	// Expired sessions are not found and are removed by a sweep.
+	assert.NoError(t, ss.Commit("e", "admin", []byte("old"), time.Now().Add(-time.Minute)))
+	_, found, err = ss.Find("e")
+	assert.NoError(t, err)
+	assert.False(t, found)
+	sessions, err = ss.UserSessions("admin")
+	assert.NoError(t, err)
+	assert.Empty(t, sessions)
+
+	removed, err := ss.Sweep()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, removed)
+	_, found, err = ss.Find("d")
+	assert.NoError(t, err)
+	assert.True(t, found)
+}
+
+// countingStore is a memory store that counts the saves.
+type countingStore struct {
+	*mock.MemoryStore
+	saves int
+}
+
+func (s *countingStore) Save(b []byte) error {
+	s.saves++
+	return s.MemoryStore.Save(b)
+}
+
+func TestSessionStoreTouch(t *testing.T) {
+	log := newLogger(t)
+
+	cs := &countingStore{MemoryStore: mock.NewMemoryStore()}
+	ss := config.NewSessionStore(log, cs, nil)
+
+	expiry := time.Now().Add(time.Hour)
+	assert.NoError(t, ss.Commit("a", "admin", []byte("one"), expiry))
+	assert.Equal(t, 1, cs.saves)
+
+	// A session that didn't change is not written again.
+	assert.NoError(t, ss.Commit("a", "admin", []byte("one"), expiry.Add(time.Second)))
+	assert.Equal(t, 1, cs.saves)
+
+	assert.NoError(t, ss.Commit("a", "admin", []byte("two"), expiry))
+	assert.Equal(t, 2, cs.saves)
+	assert.NoError(t, ss.Commit("a", "admin", []byte("two"), expiry.Add(time.Hour)))
+	assert.Equal(t, 3, cs.saves)
+}
+
+// keyedSessions is a KeyedSessionStorer that can rewrite the sessions.
+type keyedSessions struct {
+	data map[string][]byte
+}
+
+func (s *keyedSessions) Save(b []byte) error       { return nil }
+func (s *keyedSessions) Load() ([]byte, error)     { return nil, nil }
+func (s *keyedSessions) Delete(token string) error { delete(s.data, token); return nil }
+
+func (s *keyedSessions) Commit(token string, username string, b []byte, expiry time.Time) error {
+	s.data[token] = b
+	return nil
+}
+
+func (s *keyedSessions) Find(token string) ([]byte, bool, error) {
+	b, found := s.data[token]
+	return b, found, nil
+}
+
+func (s *keyedSessions) UserSessions(username string) ([]ambient.UserSession, error) {
+	return nil, nil
+}
+
+func (s *keyedSessions) DeleteUserSessions(username string) error {
+	return nil
+}
+
+func (s *keyedSessions) Rewrite(fn func(b []byte) ([]byte, error)) error {
+	for token, b := range s.data {
+		b, err := fn(b)
+		if err != nil {
+			return err
+		}
+		s.data[token] = b
+	}
+	return nil
+}
+
+func TestSessionStoreRotateKeyed(t *testing.T) {
+	log := newLogger(t)
+
+	oldKey := keyring.Key{ID: "old", Secret: bytes.Repeat([]byte("a"), 32)}
+	newKey := keyring.Key{ID: "new", Secret: bytes.Repeat([]byte("b"), 32)}
+	oldRing, err := keyring.New(oldKey)
+	assert.NoError(t, err)
+
+	ks := &keyedSessions{data: make(map[string][]byte)}
+	ss := config.NewSessionStore(log, ks, oldRing)
+	assert.NoError(t, ss.Commit("a", "admin", []byte("one"), time.Now().Add(time.Hour)))
+
+	ring, err := keyring.New(newKey, oldKey)
+	assert.NoError(t, err)
+	ss = config.NewSessionStore(log, ks, ring)
+	assert.NoError(t, ss.RotateEncryption())
+	assert.False(t, ring.NeedsRotation(ks.data["a"]))
+
+	// The old key is no longer needed.
+	newRing, err := keyring.New(newKey)
+	assert.NoError(t, err)
+	ss = config.NewSessionStore(log, ks, newRing)
+	b, found, err := ss.Find("a")
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "one", string(b))
+}
@@ -1,111 +1,584 @@
 package config
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/ambientkit/ambient"
+	"github.com/ambientkit/ambient/pkg/amberror"
+	"github.com/ambientkit/ambient/pkg/checksum"
+	"github.com/ambientkit/ambient/pkg/codec"
 )
 
 //go:generate go run github.com/vburenin/ifacemaker -f *.go -s Storage -i Storage -p ambient -o ../../gen_storage.go -y "Storage provides app config functions." -c "Code generated by ifacemaker. DO NOT EDIT."
 
+const (
+	// collectionSite contains the site metadata under keySite.
+	collectionSite = "site"
+	keySite        = "site"
+	// collectionPosts contains each post by ID.
+	collectionPosts = "posts"
+	// collectionPlugins contains each plugin data by plugin name.
+	collectionPlugins = "plugins"
+)
+
 // Storage represents a writable and readable object.
 type Storage struct {
 	log        ambient.AppLogger
 	site       *ambient.Site
-	datastorer ambient.DataStorer
+	datastorer ambient.KeyedDataStorer
 	secure     ambient.StorageEncryption
+	snapshots  *ambient.SnapshotPolicy
+	codec      ambient.StorageCodec
+	compress   bool
+	checksum   bool
+
+	revisionLimit   int
+	backupLimit     int
+	revisions       map[string]ambient.PostRevision
+	deletedPosts    map[string]bool
+	lastSnapshot    time.Time
+	token           string
+	batchDepth      int
+	migrations      ambient.MigrationReport
+	migrationDryRun bool
+	flushInterval   time.Duration
+	deferDepth      int
+	pending         map[entry]bool
+	unwritten       *pendingChanges
+	base            ambient.Site
+	sums            map[entry][sha256.Size]byte
+	flushTimer      *time.Timer
+	recovery        ambient.RecoveryReport
+	repairing       bool
+	posts           postIndex
+
+	// m guards the site object and the storage state. The plugin system holds
+	// it while it reads or changes the site so each call sees a consistent
+	// view. Unexported methods expect it to be held by the caller.
+	m sync.RWMutex
+}
+
+// entry is a single item in a collection.
+type entry struct {
+	collection string
+	key        string
 }
 
 // NewStorage returns a writable and readable site object. Returns an error if the
 // object cannot be initially read.
-func NewStorage(log ambient.AppLogger, ds ambient.DataStorer, es ambient.StorageEncryption) (*Storage, error) {
+func NewStorage(log ambient.AppLogger, ds ambient.KeyedDataStorer, group ambient.StoragePluginGroup) (*Storage, error) {
 	s := &Storage{
 		log:        log,
 		site:       &ambient.Site{},
 		datastorer: ds,
-		secure:     es,
+		secure:     group.Encryption,
+		snapshots:  group.Snapshots,
+		codec:      group.Codec,
+		compress:   group.Compress,
+		checksum:   group.Checksum,
+
+		revisionLimit:   group.PostRevisionLimit,
+		backupLimit:     group.MigrationBackupLimit,
+		migrationDryRun: group.MigrationDryRun,
+		flushInterval:   group.FlushInterval,
 	}
 
-	err := s.Load()
+	err := s.reload(false)
 	if err != nil {
 		return nil, err
 	}
 
-	// Fill in the missing defaults.
-	s.site.Correct()
-
 	return s, nil
 }
 
-// Save writes the site object to the data storage and returns an error if it
-// cannot be written.
+// Save writes the items of the site object that changed to the data storage
+// and returns an error if they cannot be written.
 func (s *Storage) Save() error {
-	return s.save(true)
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	return s.save(true, true)
 }
 
-// SaveDecrypted writes the site object to the data storage always decrypted and
-// returns an error if it cannot be written.
+// SaveDecrypted writes the items of the site object that changed to the data
+// storage always decrypted and returns an error if they cannot be written.
 func (s *Storage) SaveDecrypted() error {
-	return s.save(false)
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	return s.save(false, true)
 }
 
 // save writes the site object to the data storage and returns an error if it
-// cannot be written.
-func (s *Storage) save(forceEncryption bool) error {
-	var b []byte
-	var err error
+// cannot be written. Items in storage that no longer exist in the site object
+// are removed. If onlyChanged is true, items that are already stored with the
+// same value and encryption are not written again and nothing is written if
+// no item changed.
+func (s *Storage) save(forceEncryption bool, onlyChanged bool) error {
+	return s.batch(func() error {
+		entries := make([]entry, 0, len(s.site.Posts)+len(s.site.PluginStorage))
+		for ID, post := range s.site.Posts {
+			e := entry{collection: collectionPosts, key: ID}
+			if !onlyChanged || !s.stored(e, post, forceEncryption) {
+				entries = append(entries, e)
+			}
+		}
+		for name, data := range s.site.PluginStorage {
+			e := entry{collection: collectionPlugins, key: name}
+			if !onlyChanged || !s.stored(e, data, forceEncryption) {
+				entries = append(entries, e)
+			}
+		}
 
-	// Save the updated timestamp.
-	s.site.Updated = time.Now()
+		// Find the items that were removed.
+		for _, collection := range []string{collectionPosts, collectionPlugins} {
+			keys, err := s.datastorer.Keys(collection)
+			if err != nil {
+				return err
+			}
+			for _, key := range keys {
+				if _, found := s.value(entry{collection: collection, key: key}); !found {
+					entries = append(entries, entry{collection: collection, key: key})
+				}
+			}
+		}
 
-	b, err = json.Marshal(s.site)
+		if onlyChanged && len(entries) == 0 && len(s.revisions) == 0 && len(s.deletedPosts) == 0 &&
+			s.stored(siteEntry, s.metadata(), forceEncryption) {
+			s.clearPending()
+			return nil
+		}
 
-	if err != nil {
-		return err
+		err := s.write(forceEncryption, entries...)
+		if err != nil {
+			return err
+		}
+
+		// The pending changes were written with the rest of the site.
+		s.clearPending()
+
+		return nil
+	})
+}
+
+// saveSite writes only the site metadata to the data storage.
+func (s *Storage) saveSite() error {
+	return s.commit(true)
+}
+
+// savePost writes a single post to the data storage. If the post no longer
+// exists in the site object, it is removed from the data storage.
+func (s *Storage) savePost(ID string) error {
+	return s.commit(true, entry{collection: collectionPosts, key: ID})
+}
+
+// savePlugin writes a single plugin data to the data storage. If the plugin
+// data no longer exists in the site object, it is removed from the data
+// storage.
+func (s *Storage) savePlugin(name string) error {
+	return s.commit(true, entry{collection: collectionPlugins, key: name})
+}
+
+// commit writes the site metadata along with each of the entries to the data
+// storage. If the writes are deferred, the entries are held until the next
+// flush instead.
+func (s *Storage) commit(forceEncryption bool, entries ...entry) error {
+	if forceEncryption && s.deferWrites() {
+		s.addPending(entries...)
+		return nil
 	}
 
-	// Encrypt if set.
-	if s.secure != nil && forceEncryption {
-		b, err = s.secure.Encrypt(b)
+	return s.write(forceEncryption, entries...)
+}
+
+// write writes the site metadata along with each of the entries to the data
+// storage. Entries that are not found in the site object are deleted. Returns
+// an amberror.ConflictError if the data storage was saved by another process
+// after the site object was loaded and amberror.ErrMigrationDryRun if the
+// migrations were loaded with a dry run. The changes of a write that
+// conflicts are kept so the next reload can tell if another process changed
+// the same items.
+//
+// The revision is checked before the entries are written, but the data storer
+// has no compare-and-swap so the check and the writes are not atomic. If
+// another process writes between them, it's not detected and the last write
+// wins for the items that both processes wrote.
+func (s *Storage) write(forceEncryption bool, entries ...entry) error {
+	if s.migrations.DryRun {
+		return amberror.ErrMigrationDryRun
+	}
+	s.unwritten = nil
+
+	err := s.batch(func() error {
+		// Ensure the data storage hasn't changed since it was loaded.
+		// The revision is not checked when the stored metadata can't be read
+		// and is being repaired.
+		revision, err := s.storedRevision()
 		if err != nil {
-			return fmt.Errorf("could not encrypt storage data: %v", err.Error())
+			if !s.repairing {
+				return err
+			}
+		} else if revision != s.site.Revision {
+			return &amberror.ConflictError{Expected: s.site.Revision, Actual: revision}
+		}
+
+		// Save the updated timestamp.
+		s.site.Updated = time.Now()
+
+		for _, e := range entries {
+			v, found := s.value(e)
+			if !found {
+				err := s.datastorer.Delete(e.collection, e.key)
+				if err != nil {
+					return err
+				}
+				continue
+			}
+
+			err := s.commitValue(e, v, forceEncryption)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = s.writeRevisions()
+		if err != nil {
+			return err
+		}
+
+		// Write the metadata last so the revision only changes once all of the
+		// entries are written.
+		s.site.Revision++
+		err = s.commitValue(entry{collection: collectionSite, key: keySite}, s.metadata(), forceEncryption)
+		if err != nil {
+			s.site.Revision--
+			return err
 		}
-	}
 
-	err = s.datastorer.Save(b)
+		return nil
+	})
 	if err != nil {
+		if errors.Is(err, amberror.ErrConflict) {
+			s.unwritten = s.changes(append(entries, siteEntry)...)
+		}
+
+		// Pending changes are written again with their revisions, but
+		// revisions of a change that failed to write must not be kept for the
+		// next write.
+		if s.pending == nil {
+			s.clearRevisions()
+		}
 		return err
 	}
 
+	s.setBaseEntries(entries...)
+	s.clearRevisions()
+	s.autoSnapshot()
+
 	return nil
 }
 
+// storedRevision returns the revision of the site metadata in the data
+// storage. Returns 0 if the metadata is not found.
+func (s *Storage) storedRevision() (uint64, error) {
+	meta := ambient.Site{}
+	_, err := s.findValue(entry{collection: collectionSite, key: keySite}, &meta, true)
+	if err != nil {
+		return 0, err
+	}
+
+	return meta.Revision, nil
+}
+
+// batch groups the writes in the function if the data storer supports it.
+// The change token is updated once the outermost batch is written.
+func (s *Storage) batch(fn func() error) error {
+	s.batchDepth++
+	var err error
+	if b, ok := s.datastorer.(batcher); ok {
+		err = b.Batch(fn)
+	} else {
+		err = fn()
+	}
+	s.batchDepth--
+
+	if err == nil && s.batchDepth == 0 {
+		s.updateToken()
+	}
+
+	return err
+}
+
+// metadata returns the site object without the posts and plugins.
+func (s *Storage) metadata() ambient.Site {
+	meta := *s.site
+	meta.Posts = nil
+	meta.PluginStorage = nil
+	return meta
+}
+
+// value returns the object from the site object that is stored under the
+// entry.
+func (s *Storage) value(e entry) (interface{}, bool) {
+	return entryValue(s.site, e)
+}
+
+// commitValue marshals, encrypts if set, and commits a value.
+func (s *Storage) commitValue(e entry, v interface{}, forceEncryption bool) error {
+	b, err := s.marshal(v, forceEncryption)
+	if err != nil {
+		return err
+	}
+
+	return s.datastorer.Commit(e.collection, e.key, b)
+}
+
+// stored returns true if the value in the data storage is the same as the
+// value and has the same encryption. Encrypted values are compared after they
+// are decrypted because each encryption is different.
+func (s *Storage) stored(e entry, v interface{}, forceEncryption bool) bool {
+	b, found, err := s.datastorer.Find(e.collection, e.key)
+	if err != nil || !found {
+		return false
+	}
+
+	if s.secure == nil || !forceEncryption {
+		expected, err := s.marshal(v, forceEncryption)
+		return err == nil && bytes.Equal(expected, b)
+	}
+
+	if !checksum.Sealed(b) {
+		return false
+	}
+	b, err = checksum.Open(b)
+	if err != nil {
+		return false
+	}
+	b, err = s.secure.Decrypt(b)
+	if err != nil {
+		return false
+	}
+
+	expected, err := codec.Encode(s.codec, v, s.compress)
+	return err == nil && bytes.Equal(expected, b)
+}
+
 // Load reads the site object from the data storage and returns an error if
 // it cannot be read.
 func (s *Storage) Load() error {
-	return s.load(false)
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	return s.reload(false)
 }
 
 // LoadDecrypted reads the site object from the data storage always decrypted
 // and returns an error if it cannot be read.
 func (s *Storage) LoadDecrypted() error {
-	return s.load(true)
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	return s.reload(true)
 }
 
-// Load reads the site object from the data storage and returns an error if
+// reload reads the site object from the data storage and then stores the
+// change token. Changes that are not flushed yet are kept. Returns an
+// amberror.ConflictError with the item if a write that conflicted or a change
+// that is not flushed yet changed an item that another process changed too.
+func (s *Storage) reload(allowDecrypted bool) error {
+	pending := s.pendingChanges()
+	unwritten, base, sums := s.unwritten, s.base, s.sums
+	s.unwritten = nil
+
+	// The held revisions belong to changes that are replaced by the reload
+	// unless they are pending and applied again.
+	s.clearRevisions()
+
+	err := s.load(allowDecrypted)
+	if err != nil && !allowDecrypted && isDataError(err) {
+		s.recovery = ambient.RecoveryReport{}
+		err = s.recover(err)
+	} else if err == nil {
+		s.recovery = ambient.RecoveryReport{}
+	}
+	if err != nil {
+		return err
+	}
+
+	s.setBase()
+	s.updateToken()
+
+	// The latest data is kept instead of the changes of this process.
+	err = s.conflict(unwritten, base, sums)
+	if err == nil {
+		err = s.applyPending(pending, base, sums)
+	}
+	if err != nil {
+		s.clearPending()
+		return err
+	}
+
+	return nil
+}
+
+// load reads the site object from the data storage and returns an error if
 // it cannot be read.
 func (s *Storage) load(allowDecrypted bool) error {
-	b, err := s.datastorer.Load()
+	if r, ok := s.datastorer.(reloader); ok {
+		err := r.Reload()
+		if err != nil {
+			return err
+		}
+	}
+
+	meta := &ambient.Site{}
+	found, err := s.findValue(entry{collection: collectionSite, key: keySite}, meta, allowDecrypted)
 	if err != nil {
 		return err
+	} else if !found {
+		return s.loadLegacy(allowDecrypted)
+	}
+
+	// Read the site as a document only if it needs to be migrated since
+	// documents lose the types that a binary codec keeps.
+	if meta.SchemaVersion != CurrentSchemaVersion() {
+		return s.loadMigrate(allowDecrypted)
+	}
+
+	// Fill in the missing defaults.
+	meta.Correct()
+
+	keys, err := s.datastorer.Keys(collectionPosts)
+	if err != nil {
+		return err
+	}
+	for _, ID := range keys {
+		post := ambient.Post{}
+		found, err := s.findValue(entry{collection: collectionPosts, key: ID}, &post, allowDecrypted)
+		if err != nil {
+			return err
+		} else if found {
+			meta.Posts[ID] = post
+		}
+	}
+
+	keys, err = s.datastorer.Keys(collectionPlugins)
+	if err != nil {
+		return err
+	}
+	for _, name := range keys {
+		data := ambient.PluginData{}
+		found, err := s.findValue(entry{collection: collectionPlugins, key: name}, &data, allowDecrypted)
+		if err != nil {
+			return err
+		} else if found {
+			meta.PluginStorage[name] = data
+		}
+	}
+
+	s.site = meta
+	s.migrations = ambient.MigrationReport{
+		From: meta.SchemaVersion,
+		To:   meta.SchemaVersion,
+	}
+
+	return nil
+}
+
+// loadMigrate reads the site object as a document, runs the migrations, and
+// then saves it.
+func (s *Storage) loadMigrate(allowDecrypted bool) error {
+	doc, found, err := s.findDocument(entry{collection: collectionSite, key: keySite}, allowDecrypted)
+	if err != nil || !found {
+		return err
 	}
 
-	if string(b) == "" {
+	// Read the posts and plugins as documents so they can be migrated.
+	for _, collection := range []string{collectionPosts, collectionPlugins} {
+		keys, err := s.datastorer.Keys(collection)
+		if err != nil {
+			return err
+		}
+
+		items := make(map[string]interface{})
+		for _, key := range keys {
+			v, found, err := s.findDocument(entry{collection: collection, key: key}, allowDecrypted)
+			if err != nil {
+				return err
+			} else if found {
+				items[key] = v
+			}
+		}
+		doc[collection] = items
+	}
+
+	return s.loadDocument(doc, false)
+}
+
+// findValue reads, decrypts if set, and unmarshals a value. Returns false if
+// the value is not found.
+func (s *Storage) findValue(e entry, v interface{}, allowDecrypted bool) (bool, error) {
+	b, found, err := s.datastorer.Find(e.collection, e.key)
+	if err != nil || !found {
+		return false, err
+	}
+
+	err = s.unmarshal(b, v, allowDecrypted)
+	if err != nil {
+		return false, fmt.Errorf("could not read storage data (%v/%v): %w", e.collection, e.key, err)
+	}
+
+	return true, nil
+}
+
+// findDocument reads, decrypts if set, and unmarshals a value as a
+// document. Returns false if the value is not found.
+func (s *Storage) findDocument(e entry, allowDecrypted bool) (map[string]interface{}, bool, error) {
+	b, found, err := s.datastorer.Find(e.collection, e.key)
+	if err != nil || !found {
+		return nil, false, err
+	}
+
+	var typed interface{}
+	switch e.collection {
+	case collectionPosts:
+		typed = &ambient.Post{}
+	case collectionPlugins:
+		typed = &ambient.PluginData{}
+	default:
+		typed = &ambient.Site{}
+	}
+
+	doc, err := s.unmarshalDocument(b, typed, allowDecrypted)
+	if err != nil {
+		return nil, false, fmt.Errorf("could not read storage data (%v/%v): %w", e.collection, e.key, err)
+	}
+
+	return doc, true, nil
+}
+
+// loadLegacy reads the site object that was stored as a single object before
+// keyed storage and then writes it back out as separate items.
+func (s *Storage) loadLegacy(allowDecrypted bool) error {
+	b, found, err := s.datastorer.Find(legacyCollection, legacyKey)
+	if err != nil {
+		return err
+	}
+
+	if !found || string(b) == "" {
 		s.log.Info("found new storage data file")
-		b = []byte("{}") // Set as an empty JSON file.
-	} else if s.secure != nil {
+		s.site = &ambient.Site{SchemaVersion: CurrentSchemaVersion()}
+		s.site.Correct()
+		return nil
+	}
+
+	if s.secure != nil {
 		// Decrypt if set.
 		decrypted, err := s.secure.Decrypt(b)
 		if err != nil {
@@ -114,18 +587,57 @@ func (s *Storage) load(allowDecrypted bool) error {
 				s.log.Info("found new storage data file, will encrypt on save")
 			} else {
 				if !allowDecrypted {
-					return fmt.Errorf("could not decrypt storage data: %v", err.Error())
+					return &amberror.StorageKeyError{Err: err}
 				}
-				decrypted = b
 			}
+			decrypted = b
 		}
 		b = decrypted
 	}
 
-	err = json.Unmarshal(b, s.site)
+	doc := make(map[string]interface{})
+	err = json.Unmarshal(b, &doc)
 	if err != nil {
-		return err
+		return &amberror.StorageFormatError{Err: err}
 	}
 
-	return nil
+	s.log.Info("converting storage data to collections")
+
+	return s.batch(func() error {
+		err := s.loadDocument(doc, true)
+		if err != nil {
+			return err
+		}
+
+		return s.datastorer.Delete(legacyCollection, legacyKey)
+	})
+}
+
+// StorageCollections returns the collections that the storage writes to a
+// data storer, including the plugin store of each plugin that has written to
+// one. Mirrors use it to compare and copy every item of a data storer.
+func StorageCollections(ks ambient.KeyedDataStorer) ([]string, error) {
+	collections := []string{
+		collectionBackups,
+		collectionCorrupt,
+		collectionPluginStores,
+		collectionPlugins,
+		collectionPosts,
+		collectionPreviewKey,
+		collectionPreviews,
+		collectionRevisions,
+		collectionSite,
+		collectionSnapshots,
+		legacyCollection,
+	}
+
+	names, err := ks.Keys(collectionPluginStores)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		collections = append(collections, pluginStoreCollection(name))
+	}
+
+	return collections, nil
 }
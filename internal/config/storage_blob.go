@@ -0,0 +1,358 @@
+package config
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"sort"
+	"sync"
+
+	"github.com/ambientkit/ambient"
+	"github.com/ambientkit/ambient/pkg/amberror"
+)
+
+const (
+	// blobFormat identifies a document written by the blob storer.
+	blobFormat = "ambient.collections"
+
+	// legacyCollection and legacyKey hold the data found in a blob that was
+	// written before keyed storage existed. It contains the entire site object.
+	legacyCollection = "legacy"
+	legacyKey        = "site"
+)
+
+// blobPrefix starts every document written by the blob storer.
+var blobPrefix = []byte(`{"format":"` + blobFormat + `"`)
+
+// reloader is implemented by storers that cache data and need to be told to
+// read from the underlying store again.
+type reloader interface {
+	Reload() error
+}
+
+// batcher is implemented by storers that can group many writes into a single
+// write to the underlying store.
+type batcher interface {
+	Batch(fn func() error) error
+}
+
+// BlobStorer adapts a DataStorer that reads and writes a single object into a
+// KeyedDataStorer. All of the collections are stored in one document so every
+// write rewrites the entire object.
+//
+// The document replaces the site object that was stored before keyed storage
+// and has this format:
+//
+//	{"format":"ambient.collections","collections":{"posts":{"1":{...}},...}}
+//
+// Each value is stored as raw JSON when it is readable and as a base64 string
+// when it's encrypted or binary. The site metadata is in the "site"
+// collection under the "site" key and the posts and plugin data are in the
+// "posts" and "plugins" collections by ID and plugin name. Tools that read
+// the data storage directly need to read the values from the collections.
+//
+// A site object in the old format is moved into the collections the first
+// time it's loaded. The change can't be undone by an older version, so keep
+// a copy of the data storage before upgrading if a downgrade may be needed.
+type BlobStorer struct {
+	ds ambient.DataStorer
+
+	m           sync.Mutex
+	collections map[string]map[string][]byte
+	batching    bool
+	dirty       bool
+	reset       bool
+}
+
+// blobDocument is the object written to the DataStorer.
+type blobDocument struct {
+	Format      string                          `json:"format"`
+	Collections map[string]map[string]blobValue `json:"collections"`
+}
+
+// blobValue is stored as raw JSON when possible so the document stays readable
+// and is stored as a base64 string otherwise (like encrypted data).
+type blobValue []byte
+
+// MarshalJSON returns the value as raw JSON or as a base64 string.
+func (v blobValue) MarshalJSON() ([]byte, error) {
+	trimmed := bytes.TrimSpace(v)
+	if len(trimmed) > 0 && trimmed[0] != '"' && json.Valid(trimmed) {
+		return trimmed, nil
+	}
+
+	return json.Marshal(base64.StdEncoding.EncodeToString(v))
+}
+
+// UnmarshalJSON reads the value from raw JSON or from a base64 string.
+func (v *blobValue) UnmarshalJSON(b []byte) error {
+	if len(b) > 0 && b[0] == '"' {
+		var s string
+		err := json.Unmarshal(b, &s)
+		if err != nil {
+			return err
+		}
+
+		decoded, err := base64.StdEncoding.DecodeString(s)
+		if err != nil {
+			return err
+		}
+
+		*v = decoded
+		return nil
+	}
+
+	*v = append((*v)[0:0], b...)
+	return nil
+}
+
+// NewBlobStorer returns a KeyedDataStorer that stores all collections in a
+// DataStorer.
+func NewBlobStorer(ds ambient.DataStorer) *BlobStorer {
+	return &BlobStorer{
+		ds: ds,
+	}
+}
+
+// Reload reads the document from the DataStorer again.
+func (s *BlobStorer) Reload() error {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	return s.read()
+}
+
+// read loads the document from the DataStorer. If the object was written
+// before keyed storage, it is placed in the legacy collection.
+func (s *BlobStorer) read() error {
+	// Keep the empty document after a reset until it's written.
+	if s.reset {
+		return nil
+	}
+
+	b, err := s.ds.Load()
+	if err != nil {
+		return err
+	}
+
+	s.collections = make(map[string]map[string][]byte)
+
+	if len(bytes.TrimSpace(b)) == 0 {
+		return nil
+	}
+
+	doc := blobDocument{}
+	err = json.Unmarshal(b, &doc)
+	if err != nil && bytes.HasPrefix(bytes.TrimSpace(b), blobPrefix) {
+		// The document was written by the blob storer, but is truncated or
+		// changed.
+		s.collections = nil
+		return &amberror.StorageCorruptError{Err: err}
+	} else if err != nil || doc.Format != blobFormat {
+		s.collections[legacyCollection] = map[string][]byte{
+			legacyKey: b,
+		}
+		return nil
+	}
+
+	for collection, items := range doc.Collections {
+		m := make(map[string][]byte)
+		for key, value := range items {
+			m[key] = value
+		}
+		s.collections[collection] = m
+	}
+
+	return nil
+}
+
+// ensureLoaded reads the document if it hasn't been read yet.
+func (s *BlobStorer) ensureLoaded() error {
+	if s.collections != nil {
+		return nil
+	}
+
+	return s.read()
+}
+
+// ensureLatest reads the document before a change unless a batch is in
+// progress (the batch reads it first).
+func (s *BlobStorer) ensureLatest() error {
+	if s.batching {
+		return s.ensureLoaded()
+	}
+
+	return s.read()
+}
+
+// write saves the document to the DataStorer unless a batch is in progress.
+func (s *BlobStorer) write() error {
+	if s.batching {
+		s.dirty = true
+		return nil
+	}
+
+	doc := blobDocument{
+		Format:      blobFormat,
+		Collections: make(map[string]map[string]blobValue),
+	}
+	for collection, items := range s.collections {
+		if len(items) == 0 {
+			continue
+		}
+
+		m := make(map[string]blobValue)
+		for key, value := range items {
+			m[key] = value
+		}
+		doc.Collections[collection] = m
+	}
+
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return err
+	}
+
+	err = s.ds.Save(b)
+	if err != nil {
+		return err
+	}
+
+	s.reset = false
+
+	return nil
+}
+
+// Reset discards the document when it can't be read so it can be written
+// again. The document is replaced on the next write.
+func (s *BlobStorer) Reset() error {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	s.collections = make(map[string]map[string][]byte)
+	s.reset = true
+
+	return nil
+}
+
+// Batch reads the latest document, runs the function, and writes the document
+// once at the end instead of on every change.
+func (s *BlobStorer) Batch(fn func() error) error {
+	s.m.Lock()
+	if s.batching {
+		// Already in a batch so the outer batch will write.
+		s.m.Unlock()
+		return fn()
+	}
+
+	// Read the document first so changes by another process are not lost.
+	err := s.read()
+	if err != nil {
+		s.m.Unlock()
+		return err
+	}
+
+	s.batching = true
+	s.dirty = false
+	s.m.Unlock()
+
+	fnErr := fn()
+
+	s.m.Lock()
+	defer s.m.Unlock()
+	s.batching = false
+	if !s.dirty {
+		return fnErr
+	}
+	s.dirty = false
+
+	err = s.write()
+	if fnErr != nil {
+		return fnErr
+	}
+
+	return err
+}
+
+// Commit adds the data to the document and saves it.
+func (s *BlobStorer) Commit(collection string, key string, b []byte) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	err := s.ensureLatest()
+	if err != nil {
+		return err
+	}
+
+	items, ok := s.collections[collection]
+	if !ok {
+		items = make(map[string][]byte)
+		s.collections[collection] = items
+	}
+
+	value := make([]byte, len(b))
+	copy(value, b)
+	items[key] = value
+
+	return s.write()
+}
+
+// Find returns the data for a key from the document.
+func (s *BlobStorer) Find(collection string, key string) ([]byte, bool, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	err := s.ensureLoaded()
+	if err != nil {
+		return nil, false, err
+	}
+
+	value, found := s.collections[collection][key]
+	if !found {
+		return nil, false, nil
+	}
+
+	b := make([]byte, len(value))
+	copy(b, value)
+
+	return b, true, nil
+}
+
+// Delete removes the key from the document and saves it.
+func (s *BlobStorer) Delete(collection string, key string) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	err := s.ensureLatest()
+	if err != nil {
+		return err
+	}
+
+	if _, found := s.collections[collection][key]; !found {
+		return nil
+	}
+
+	delete(s.collections[collection], key)
+
+	return s.write()
+}
+
+// Keys returns the sorted list of keys in a collection.
+func (s *BlobStorer) Keys(collection string) ([]string, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	err := s.ensureLoaded()
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(s.collections[collection]))
+	for key := range s.collections[collection] {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys, nil
+}
@@ -0,0 +1,114 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ambientkit/ambient"
+	"github.com/ambientkit/ambient/pkg/amberror"
+	"github.com/ambientkit/ambient/pkg/checksum"
+	"github.com/ambientkit/ambient/pkg/codec"
+)
+
+// marshal converts a value to bytes with the codec, encrypts it if set, and
+// adds a checksum.
+func (s *Storage) marshal(v interface{}, forceEncryption bool) ([]byte, error) {
+	return s.marshalCodec(s.codec, v, forceEncryption)
+}
+
+// marshalCodec converts a value to bytes with a specific codec, encrypts it
+// if set, and adds a checksum. Readable JSON and data that is saved decrypted
+// are written without a checksum so they can be edited unless the checksum is
+// turned on for the storage.
+func (s *Storage) marshalCodec(c ambient.StorageCodec, v interface{}, forceEncryption bool) ([]byte, error) {
+	b, err := codec.Encode(c, v, s.compress)
+	if err != nil {
+		return nil, err
+	}
+
+	// Encrypt if set.
+	encrypted := s.secure != nil && forceEncryption
+	if encrypted {
+		b, err = s.secure.Encrypt(b)
+		if err != nil {
+			return nil, fmt.Errorf("could not encrypt storage data: %v", err.Error())
+		}
+	}
+
+	// Only encrypted or binary data has a checksum unless it's turned on.
+	if forceEncryption && (encrypted || s.checksum || !json.Valid(b)) {
+		b = checksum.Seal(b)
+	}
+
+	return b, nil
+}
+
+// decrypt verifies the checksum and returns the decrypted bytes if set. If
+// decryption fails and allowDecrypted is true, the bytes are returned as is.
+func (s *Storage) decrypt(b []byte, allowDecrypted bool) ([]byte, error) {
+	b, err := checksum.Open(b)
+	if err != nil {
+		return nil, &amberror.StorageCorruptError{Err: err}
+	}
+
+	if s.secure == nil {
+		return b, nil
+	}
+
+	decrypted, err := s.secure.Decrypt(b)
+	if err != nil {
+		if !allowDecrypted {
+			return nil, &amberror.StorageKeyError{Err: err}
+		}
+		return b, nil
+	}
+
+	return decrypted, nil
+}
+
+// unmarshal decrypts the bytes if set and converts them to a value with the
+// codec that wrote them.
+func (s *Storage) unmarshal(b []byte, v interface{}, allowDecrypted bool) error {
+	b, err := s.decrypt(b, allowDecrypted)
+	if err != nil {
+		return err
+	}
+
+	err = codec.Decode(b, v, s.codec)
+	if err != nil {
+		return &amberror.StorageFormatError{Err: err}
+	}
+
+	return nil
+}
+
+// unmarshalDocument decrypts the bytes if set and converts them to a generic
+// document. JSON is read directly so fields that no longer exist are kept for
+// the migrations. Other codecs are read into the typed value first.
+func (s *Storage) unmarshalDocument(b []byte, typed interface{}, allowDecrypted bool) (map[string]interface{}, error) {
+	b, err := s.decrypt(b, allowDecrypted)
+	if err != nil {
+		return nil, err
+	}
+
+	c, data, err := codec.Detect(b, s.codec)
+	if err != nil {
+		return nil, &amberror.StorageFormatError{Err: err}
+	}
+
+	if codec.IsJSON(c) {
+		doc := make(map[string]interface{})
+		err = json.Unmarshal(data, &doc)
+		if err != nil {
+			return nil, &amberror.StorageFormatError{Err: err}
+		}
+		return doc, nil
+	}
+
+	err = c.Unmarshal(data, typed)
+	if err != nil {
+		return nil, &amberror.StorageFormatError{Err: err}
+	}
+
+	return toDocument(typed)
+}
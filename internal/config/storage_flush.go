@@ -0,0 +1,195 @@
+package config
+
+import (
+	"crypto/sha256"
+	"errors"
+	"time"
+
+	"github.com/ambientkit/ambient"
+	"github.com/ambientkit/ambient/pkg/amberror"
+)
+
+// siteEntry marks the site metadata as changed when the writes are deferred.
+var siteEntry = entry{collection: collectionSite, key: keySite}
+
+// pendingChanges is a copy of the changes that are not flushed yet.
+type pendingChanges struct {
+	entries      map[entry]bool
+	site         ambient.Site
+	values       map[entry]interface{}
+	revisions    map[string]ambient.PostRevision
+	deletedPosts map[string]bool
+}
+
+// deferWrites returns true if the changes should be held in memory instead of
+// written right away.
+func (s *Storage) deferWrites() bool {
+	return s.deferDepth > 0 || s.flushInterval > 0
+}
+
+// addPending holds the entries until the next flush. The site metadata is
+// marked as changed if no entries are passed in. In write-behind mode, the
+// flush is scheduled for the end of the flush interval.
+func (s *Storage) addPending(entries ...entry) {
+	if s.pending == nil {
+		s.pending = make(map[entry]bool)
+	}
+
+	if len(entries) == 0 {
+		s.pending[siteEntry] = true
+	}
+	for _, e := range entries {
+		s.pending[e] = true
+	}
+
+	if s.flushInterval > 0 && s.flushTimer == nil {
+		s.flushTimer = time.AfterFunc(s.flushInterval, s.timedFlush)
+	}
+}
+
+// clearPending removes the pending changes after they are written.
+func (s *Storage) clearPending() {
+	s.pending = nil
+
+	if s.flushTimer != nil {
+		s.flushTimer.Stop()
+		s.flushTimer = nil
+	}
+}
+
+// pendingChanges returns a copy of the pending changes so they can be applied
+// again after a reload. Returns nil if there are no pending changes.
+func (s *Storage) pendingChanges() *pendingChanges {
+	if s.pending == nil {
+		return nil
+	}
+
+	return s.changes()
+}
+
+// changes returns a copy of the site metadata and the values of the entries
+// and the pending entries.
+func (s *Storage) changes(entries ...entry) *pendingChanges {
+	pc := &pendingChanges{
+		entries:      make(map[entry]bool),
+		site:         s.metadata(),
+		values:       make(map[entry]interface{}),
+		revisions:    s.revisions,
+		deletedPosts: s.deletedPosts,
+	}
+	for _, e := range entries {
+		pc.entries[e] = true
+	}
+	for e := range s.pending {
+		pc.entries[e] = true
+	}
+	for e := range pc.entries {
+		if v, found := s.value(e); found {
+			pc.values[e] = v
+		}
+	}
+
+	return pc
+}
+
+// applyPending applies the pending changes to the site object after a reload.
+// Only the entries and the metadata fields that changed from the base are
+// applied so the changes of another process to the rest are kept. Returns an
+// amberror.ConflictError if another process changed one of the same items.
+func (s *Storage) applyPending(pc *pendingChanges, base ambient.Site, sums map[entry][sha256.Size]byte) error {
+	if pc == nil {
+		return nil
+	}
+
+	err := s.conflict(pc, base, sums)
+	if err != nil {
+		return err
+	}
+
+	for e := range pc.entries {
+		if e != siteEntry {
+			if valueSum(pc.values[e]) != sums[e] {
+				// Deleted items are not in the values so they are removed.
+				setValue(s.site, e, pc.values[e])
+			}
+			continue
+		}
+
+		meta, _, err := mergeMetadata(base, pc.site, s.metadata())
+		if err != nil {
+			return err
+		}
+		meta.Revision = s.site.Revision
+		meta.SchemaVersion = s.site.SchemaVersion
+		meta.Posts = s.site.Posts
+		meta.PluginStorage = s.site.PluginStorage
+		s.site = &meta
+	}
+
+	s.pending = pc.entries
+	s.revisions = pc.revisions
+	s.deletedPosts = pc.deletedPosts
+
+	return nil
+}
+
+// Flush writes the changes that are held in memory.
+func (s *Storage) Flush() error {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	return s.flush()
+}
+
+// flush writes the pending changes together. If the data storage was saved
+// by another process, the storage is reloaded and the changes are written on
+// top of the latest data. If the other process changed one of the same items,
+// the latest data is kept and an amberror.ConflictError is returned.
+func (s *Storage) flush() error {
+	if s.pending == nil {
+		return nil
+	}
+
+	entries := make([]entry, 0, len(s.pending))
+	for e := range s.pending {
+		if e != siteEntry {
+			entries = append(entries, e)
+		}
+	}
+	sortEntries(entries)
+
+	err := s.write(true, entries...)
+	for i := 0; i < maxConflictRetries && errors.Is(err, amberror.ErrConflict); i++ {
+		s.log.Warn("reloading storage after conflict: %v", err.Error())
+		err = s.reload(false)
+		if err != nil {
+			return err
+		}
+
+		err = s.write(true, entries...)
+	}
+	if err != nil {
+		return err
+	}
+
+	s.clearPending()
+
+	return nil
+}
+
+// timedFlush writes the pending changes at the end of the flush interval. If
+// the write fails, it's tried again on the next interval.
+func (s *Storage) timedFlush() {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	s.flushTimer = nil
+
+	err := s.flush()
+	if err != nil {
+		s.log.Error("could not flush storage changes: %v", err.Error())
+		if s.pending != nil {
+			s.flushTimer = time.AfterFunc(s.flushInterval, s.timedFlush)
+		}
+	}
+}
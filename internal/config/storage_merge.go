@@ -0,0 +1,180 @@
+package config
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"reflect"
+	"sort"
+
+	"github.com/ambientkit/ambient"
+	"github.com/ambientkit/ambient/pkg/amberror"
+)
+
+// unmergedFields are top level fields of the site metadata that are not
+// compared after a conflict because they change on every save or are stored
+// as separate items.
+var unmergedFields = map[string]bool{
+	"updated":       true,
+	"revision":      true,
+	"schemaversion": true,
+	"posts":         true,
+	"plugins":       true,
+}
+
+// setBase stores the site object as it is in the data storage so the changes
+// of this process can be told apart from the changes of another process
+// after a conflict.
+func (s *Storage) setBase() {
+	s.base = s.metadata()
+	s.sums = make(map[entry][sha256.Size]byte)
+	for ID, post := range s.site.Posts {
+		s.sums[entry{collection: collectionPosts, key: ID}] = valueSum(post)
+	}
+	for name, data := range s.site.PluginStorage {
+		s.sums[entry{collection: collectionPlugins, key: name}] = valueSum(data)
+	}
+}
+
+// setBaseEntries updates the stored site object for the metadata and the
+// entries once they are written.
+func (s *Storage) setBaseEntries(entries ...entry) {
+	if s.sums == nil {
+		s.sums = make(map[entry][sha256.Size]byte)
+	}
+
+	s.base = s.metadata()
+	for _, e := range entries {
+		if v, found := s.value(e); found {
+			s.sums[e] = valueSum(v)
+		} else {
+			delete(s.sums, e)
+		}
+	}
+}
+
+// valueSum returns the checksum of the value as JSON since it writes maps in
+// a stable order unlike some codecs. Returns an empty checksum for nil so a
+// removed item can be compared too.
+func valueSum(v interface{}) [sha256.Size]byte {
+	if v == nil {
+		return [sha256.Size]byte{}
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return [sha256.Size]byte{}
+	}
+
+	return sha256.Sum256(b)
+}
+
+// conflict returns an amberror.ConflictError for the first item that this
+// process changed and another process changed to a different value since
+// the base was stored. The site object must hold the latest data.
+func (s *Storage) conflict(pc *pendingChanges, base ambient.Site, sums map[entry][sha256.Size]byte) error {
+	if pc == nil {
+		return nil
+	}
+
+	entries := make([]entry, 0, len(pc.entries))
+	for e := range pc.entries {
+		entries = append(entries, e)
+	}
+	sortEntries(entries)
+
+	for _, e := range entries {
+		if e == siteEntry {
+			_, field, err := mergeMetadata(base, pc.site, s.metadata())
+			if err != nil {
+				return err
+			} else if len(field) > 0 {
+				return &amberror.ConflictError{Expected: base.Revision, Actual: s.site.Revision, Item: collectionSite + "/" + field}
+			}
+			continue
+		}
+
+		var theirs interface{}
+		if v, found := s.value(e); found {
+			theirs = v
+		}
+
+		before, ours, latest := sums[e], valueSum(pc.values[e]), valueSum(theirs)
+		if ours != before && latest != before && ours != latest {
+			return &amberror.ConflictError{Expected: base.Revision, Actual: s.site.Revision, Item: e.collection + "/" + e.key}
+		}
+	}
+
+	return nil
+}
+
+// mergeMetadata returns the latest site metadata with the fields that this
+// process changed from the base. If another process changed one of the same
+// fields to a different value, the name of the field is returned instead.
+func mergeMetadata(base ambient.Site, ours ambient.Site, theirs ambient.Site) (ambient.Site, string, error) {
+	baseDoc, err := toDocument(base)
+	if err != nil {
+		return theirs, "", err
+	}
+	ourDoc, err := toDocument(ours)
+	if err != nil {
+		return theirs, "", err
+	}
+	theirDoc, err := toDocument(theirs)
+	if err != nil {
+		return theirs, "", err
+	}
+
+	// Fields with omitempty may only be in one of the documents.
+	fields := make([]string, 0, len(ourDoc))
+	for k := range ourDoc {
+		fields = append(fields, k)
+	}
+	for k := range baseDoc {
+		if _, found := ourDoc[k]; !found {
+			fields = append(fields, k)
+		}
+	}
+	sort.Strings(fields)
+
+	changed := false
+	for _, k := range fields {
+		if unmergedFields[k] || reflect.DeepEqual(ourDoc[k], baseDoc[k]) {
+			continue
+		}
+		if !reflect.DeepEqual(theirDoc[k], baseDoc[k]) && !reflect.DeepEqual(theirDoc[k], ourDoc[k]) {
+			return theirs, k, nil
+		}
+
+		changed = true
+		if v, found := ourDoc[k]; found {
+			theirDoc[k] = v
+		} else {
+			delete(theirDoc, k)
+		}
+	}
+	if !changed {
+		return theirs, "", nil
+	}
+
+	b, err := json.Marshal(theirDoc)
+	if err != nil {
+		return theirs, "", err
+	}
+	merged := ambient.Site{}
+	err = json.Unmarshal(b, &merged)
+	if err != nil {
+		return theirs, "", err
+	}
+
+	return merged, "", nil
+}
+
+// sortEntries sorts the entries by collection and then key.
+func sortEntries(entries []entry) {
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].collection != entries[j].collection {
+			return entries[i].collection < entries[j].collection
+		}
+		return entries[i].key < entries[j].key
+	})
+}
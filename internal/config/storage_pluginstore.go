@@ -0,0 +1,150 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/ambientkit/ambient/pkg/amberror"
+)
+
+const (
+	// pluginStorePrefix starts the collection name for each plugin store.
+	pluginStorePrefix = "pluginstore."
+
+	// collectionPluginStores contains the name of each plugin that has written
+	// to its plugin store so the stores can be found without the plugin.
+	collectionPluginStores = "pluginstores"
+)
+
+// pluginStoreCollection returns the collection that contains the plugin
+// store for a plugin.
+func pluginStoreCollection(pluginName string) string {
+	return pluginStorePrefix + pluginName
+}
+
+// PluginStoreGet returns a value from the plugin store. Returns
+// amberror.ErrNotFound if the key is not found.
+func (s *Storage) PluginStoreGet(pluginName string, key string) ([]byte, error) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	b, found, err := s.datastorer.Find(pluginStoreCollection(pluginName), key)
+	if err != nil {
+		return nil, err
+	} else if !found {
+		return nil, amberror.ErrNotFound
+	}
+
+	b, err = s.decrypt(b, false)
+	if err != nil {
+		return nil, fmt.Errorf("could not read plugin store (%v/%v): %v", pluginName, key, err.Error())
+	}
+
+	return b, nil
+}
+
+// PluginStorePut writes a value to the plugin store.
+func (s *Storage) PluginStorePut(pluginName string, key string, value []byte) error {
+	if len(key) == 0 {
+		return amberror.ErrPluginStoreKey
+	}
+
+	b := value
+	if s.secure != nil {
+		var err error
+		b, err = s.secure.Encrypt(value)
+		if err != nil {
+			return fmt.Errorf("could not encrypt plugin store data: %v", err.Error())
+		}
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	return s.batch(func() error {
+		_, found, err := s.datastorer.Find(collectionPluginStores, pluginName)
+		if err != nil {
+			return err
+		} else if !found {
+			err = s.datastorer.Commit(collectionPluginStores, pluginName, []byte("{}"))
+			if err != nil {
+				return err
+			}
+		}
+
+		return s.datastorer.Commit(pluginStoreCollection(pluginName), key, b)
+	})
+}
+
+// PluginStoreDelete removes a value from the plugin store.
+func (s *Storage) PluginStoreDelete(pluginName string, key string) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	return s.batch(func() error {
+		return s.datastorer.Delete(pluginStoreCollection(pluginName), key)
+	})
+}
+
+// PluginStoreList returns the sorted keys in the plugin store.
+func (s *Storage) PluginStoreList(pluginName string) ([]string, error) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	return s.datastorer.Keys(pluginStoreCollection(pluginName))
+}
+
+// PluginStoreClear removes all of the values from the plugin store.
+func (s *Storage) PluginStoreClear(pluginName string) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	return s.pluginStoreClear(pluginName)
+}
+
+// pluginStoreClear removes all of the values from the plugin store.
+func (s *Storage) pluginStoreClear(pluginName string) error {
+	return s.batch(func() error {
+		collection := pluginStoreCollection(pluginName)
+		keys, err := s.datastorer.Keys(collection)
+		if err != nil {
+			return err
+		}
+
+		for _, key := range keys {
+			err = s.datastorer.Delete(collection, key)
+			if err != nil {
+				return err
+			}
+		}
+
+		return s.datastorer.Delete(collectionPluginStores, pluginName)
+	})
+}
+
+// pluginStoreNames returns the sorted names of the plugins with a plugin
+// store, including plugins that are no longer registered. Plugins with site
+// data are included since their stores may have been written before the
+// names were stored.
+func (s *Storage) pluginStoreNames() ([]string, error) {
+	keys, err := s.datastorer.Keys(collectionPluginStores)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[string]bool, len(keys)+len(s.site.PluginStorage))
+	for _, name := range keys {
+		names[name] = true
+	}
+	for name := range s.site.PluginStorage {
+		names[name] = true
+	}
+
+	arr := make([]string, 0, len(names))
+	for name := range names {
+		arr = append(arr, name)
+	}
+	sort.Strings(arr)
+
+	return arr, nil
+}
@@ -0,0 +1,323 @@
+package config
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ambientkit/ambient"
+	"github.com/ambientkit/ambient/pkg/amberror"
+)
+
+const (
+	// collectionPreviews contains each post preview by post ID and preview
+	// ID. A preview token is only valid while its preview is stored.
+	collectionPreviews = "previews"
+
+	// collectionPreviewKey contains the key that signs the preview tokens.
+	collectionPreviewKey = "previewkey"
+	keyPreviewKey        = "key"
+
+	// defaultPreviewExpiry is how long a preview token is valid if not set.
+	defaultPreviewExpiry = 7 * 24 * time.Hour
+)
+
+// previewKey is the key that signs the preview tokens.
+type previewKey struct {
+	Secret  []byte    `json:"secret"`
+	Created time.Time `json:"created"`
+}
+
+// previewClaims is the signed part of a preview token.
+type previewClaims struct {
+	ID      string `json:"i"`
+	PostID  string `json:"p"`
+	Expires int64  `json:"e"`
+}
+
+// previewEntry returns the entry for a post preview.
+func previewEntry(postID string, previewID string) entry {
+	return entry{collection: collectionPreviews, key: postID + "/" + previewID}
+}
+
+// randomID returns a random hex string from the number of bytes.
+func randomID(n int) (string, error) {
+	b := make([]byte, n)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+// previewSecret returns the key that signs the preview tokens. If create is
+// true, the key is created if it doesn't exist yet, else amberror.ErrNotFound
+// is returned.
+func (s *Storage) previewSecret(create bool) ([]byte, error) {
+	e := entry{collection: collectionPreviewKey, key: keyPreviewKey}
+
+	key := previewKey{}
+	found, err := s.findValue(e, &key, false)
+	if err != nil {
+		return nil, err
+	} else if found {
+		return key.Secret, nil
+	} else if !create {
+		return nil, amberror.ErrNotFound
+	}
+
+	key.Secret = make([]byte, 32)
+	_, err = rand.Read(key.Secret)
+	if err != nil {
+		return nil, err
+	}
+	key.Created = time.Now().UTC()
+
+	err = s.batch(func() error {
+		return s.commitValue(e, key, true)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return key.Secret, nil
+}
+
+// signPreview returns the token for the claims.
+func signPreview(secret []byte, claims previewClaims) (string, error) {
+	b, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	payload := base64.RawURLEncoding.EncodeToString(b)
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(payload))
+
+	return payload + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil)), nil
+}
+
+// verifyPreview returns the claims from the token if it's signed with the
+// key.
+func verifyPreview(secret []byte, token string) (previewClaims, error) {
+	arr := strings.Split(token, ".")
+	if len(arr) != 2 {
+		return previewClaims{}, amberror.ErrInvalidToken
+	}
+
+	sig, err := base64.RawURLEncoding.DecodeString(arr[1])
+	if err != nil {
+		return previewClaims{}, amberror.ErrInvalidToken
+	}
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(arr[0]))
+	if !hmac.Equal(sig, mac.Sum(nil)) {
+		return previewClaims{}, amberror.ErrInvalidToken
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(arr[0])
+	if err != nil {
+		return previewClaims{}, amberror.ErrInvalidToken
+	}
+
+	claims := previewClaims{}
+	err = json.Unmarshal(b, &claims)
+	if err != nil {
+		return previewClaims{}, amberror.ErrInvalidToken
+	}
+
+	return claims, nil
+}
+
+// CreatePostPreview returns a new preview with a token that is valid until it
+// expires or is revoked. The expired previews are removed. Returns
+// amberror.ErrNotFound if the post is not found and
+// amberror.ErrEncryptionDisabled if storage encryption is not set since the
+// key that signs the tokens would be stored in plaintext.
+func (s *Storage) CreatePostPreview(postID string, expires time.Duration, author string) (ambient.PostPreview, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if s.secure == nil {
+		return ambient.PostPreview{}, amberror.ErrEncryptionDisabled
+	}
+
+	if _, found := s.site.Posts[postID]; !found {
+		return ambient.PostPreview{}, amberror.ErrNotFound
+	}
+
+	if expires <= 0 {
+		expires = defaultPreviewExpiry
+	}
+
+	secret, err := s.previewSecret(true)
+	if err != nil {
+		return ambient.PostPreview{}, err
+	}
+
+	ID, err := randomID(16)
+	if err != nil {
+		return ambient.PostPreview{}, err
+	}
+
+	now := time.Now().UTC()
+	preview := ambient.PostPreview{
+		ID:      ID,
+		PostID:  postID,
+		Author:  author,
+		Created: now,
+		Expires: now.Add(expires),
+	}
+
+	err = s.batch(func() error {
+		err := s.removeExpiredPreviews(now)
+		if err != nil {
+			return err
+		}
+
+		return s.commitValue(previewEntry(postID, ID), preview, true)
+	})
+	if err != nil {
+		return ambient.PostPreview{}, err
+	}
+
+	preview.Token, err = signPreview(secret, previewClaims{
+		ID:      ID,
+		PostID:  postID,
+		Expires: preview.Expires.Unix(),
+	})
+	if err != nil {
+		return ambient.PostPreview{}, err
+	}
+
+	return preview, nil
+}
+
+// removeExpiredPreviews deletes the previews that expired before the time.
+// The caller should run it in a batch.
+func (s *Storage) removeExpiredPreviews(now time.Time) error {
+	keys, err := s.datastorer.Keys(collectionPreviews)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		preview := ambient.PostPreview{}
+		found, err := s.findValue(entry{collection: collectionPreviews, key: key}, &preview, false)
+		if err != nil || !found || now.Before(preview.Expires) {
+			continue
+		}
+
+		err = s.datastorer.Delete(collectionPreviews, key)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PostPreviews returns the previews of a post that have not expired with the
+// newest first.
+func (s *Storage) PostPreviews(postID string) ([]ambient.PostPreview, error) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	keys, err := s.datastorer.Keys(collectionPreviews)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	prefix := postID + "/"
+	arr := make([]ambient.PostPreview, 0)
+	for _, key := range keys {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+
+		preview := ambient.PostPreview{}
+		found, err := s.findValue(entry{collection: collectionPreviews, key: key}, &preview, false)
+		if err != nil {
+			return nil, err
+		} else if found && now.Before(preview.Expires) {
+			arr = append(arr, preview)
+		}
+	}
+	sort.Slice(arr, func(i, j int) bool {
+		return arr[i].Created.After(arr[j].Created)
+	})
+
+	return arr, nil
+}
+
+// RevokePostPreview deletes a preview so its token is no longer valid.
+// Returns amberror.ErrNotFound if the preview is not found.
+func (s *Storage) RevokePostPreview(postID string, previewID string) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	e := previewEntry(postID, previewID)
+	_, found, err := s.datastorer.Find(e.collection, e.key)
+	if err != nil {
+		return err
+	} else if !found {
+		return amberror.ErrNotFound
+	}
+
+	return s.batch(func() error {
+		return s.datastorer.Delete(e.collection, e.key)
+	})
+}
+
+// PreviewPost returns the post for a preview token even if it's not
+// published. Returns amberror.ErrInvalidToken if the token is not signed with
+// the key, has expired, or was revoked, or if storage encryption is not set.
+func (s *Storage) PreviewPost(token string) (ambient.PostWithID, error) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	// The key can't be trusted if it's not encrypted.
+	if s.secure == nil {
+		return ambient.PostWithID{}, amberror.ErrInvalidToken
+	}
+
+	secret, err := s.previewSecret(false)
+	if err == amberror.ErrNotFound {
+		return ambient.PostWithID{}, amberror.ErrInvalidToken
+	} else if err != nil {
+		return ambient.PostWithID{}, err
+	}
+
+	claims, err := verifyPreview(secret, token)
+	if err != nil {
+		return ambient.PostWithID{}, err
+	}
+
+	if !time.Now().Before(time.Unix(claims.Expires, 0)) {
+		return ambient.PostWithID{}, amberror.ErrInvalidToken
+	}
+
+	preview := ambient.PostPreview{}
+	found, err := s.findValue(previewEntry(claims.PostID, claims.ID), &preview, false)
+	if err != nil {
+		return ambient.PostWithID{}, err
+	} else if !found {
+		return ambient.PostWithID{}, amberror.ErrInvalidToken
+	}
+
+	post, found := s.site.Posts[claims.PostID]
+	if !found {
+		return ambient.PostWithID{}, amberror.ErrNotFound
+	}
+
+	return ambient.PostWithID{Post: post, ID: claims.PostID}, nil
+}
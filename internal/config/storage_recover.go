@@ -0,0 +1,358 @@
+package config
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ambientkit/ambient"
+	"github.com/ambientkit/ambient/pkg/amberror"
+)
+
+// collectionCorrupt contains the items that could not be read or recovered
+// so they can be inspected later.
+const collectionCorrupt = "corrupt"
+
+// resetter is implemented by storers that keep all of the collections in one
+// document and need to start over when the document can't be read.
+type resetter interface {
+	Reset() error
+}
+
+// recoveryCopy is a copy of the site that can be loaded when the stored site
+// can't be read.
+type recoveryCopy struct {
+	source   string
+	ID       string
+	revision uint64
+}
+
+// isDataError returns true if the error is caused by storage data that is
+// corrupt or can't be decoded. Data that can't be decrypted is not recovered
+// since the key is more likely to be wrong than the data.
+func isDataError(err error) bool {
+	return errors.Is(err, amberror.ErrStorageCorrupt) ||
+		errors.Is(err, amberror.ErrStorageFormat)
+}
+
+// RecoveryReport returns the fallback from the last load. It's empty if the
+// stored site was read without a fallback.
+func (s *Storage) RecoveryReport() ambient.RecoveryReport {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	return s.recovery
+}
+
+// recover loads the newest copy of the site that can be read after the
+// stored site could not be read. The stored items that can still be read are
+// kept. The site is then written back so the bad data is replaced. Returns
+// the original error if there is no copy that can be read.
+func (s *Storage) recover(cause error) error {
+	s.log.Error("could not read storage, looking for a copy to recover: %v", cause.Error())
+
+	report := ambient.RecoveryReport{
+		Error: cause.Error(),
+		Time:  time.Now().UTC(),
+	}
+
+	var site *ambient.Site
+	for _, c := range s.recoveryCopies() {
+		var err error
+		site, err = s.readCopy(c)
+		if err != nil {
+			report.Skipped = append(report.Skipped, c.source+"/"+c.ID)
+			s.log.Warn("could not read storage copy (%v/%v): %v", c.source, c.ID, err.Error())
+			continue
+		}
+
+		report.Source = c.source
+		report.ID = c.ID
+		report.Revision = site.Revision
+		break
+	}
+	if len(report.Source) == 0 {
+		s.log.Error("could not find a storage copy to recover")
+		return cause
+	}
+
+	// Start over if the storage can't be read at all, else keep the items
+	// that can still be read.
+	reset := false
+	if _, err := s.datastorer.Keys(collectionSite); err != nil {
+		r, ok := s.datastorer.(resetter)
+		if !ok {
+			return cause
+		}
+
+		err = r.Reset()
+		if err != nil {
+			return err
+		}
+		reset = true
+		site.Revision = 0
+	} else {
+		s.keepReadable(site, &report)
+	}
+
+	s.site = site
+	s.recovery = report
+	s.migrations = ambient.MigrationReport{
+		From: site.SchemaVersion,
+		To:   site.SchemaVersion,
+	}
+	s.logRecovery(report)
+
+	// The recovered site is used even if it can't be written.
+	err := s.repair(reset, report)
+	if err != nil {
+		s.log.Error("could not write recovered storage: %v", err.Error())
+	}
+
+	return nil
+}
+
+// recoveryCopies returns the snapshots and backups with the newest first.
+func (s *Storage) recoveryCopies() []recoveryCopy {
+	arr := make([]recoveryCopy, 0)
+
+	if s.snapshots != nil && s.snapshots.Store != nil {
+		keys, err := s.snapshots.Store.Keys(collectionSnapshots)
+		if err != nil {
+			s.log.Warn("could not read storage snapshots: %v", err.Error())
+		}
+		for _, ID := range keys {
+			snap, err := parseSnapshotID(ID)
+			if err == nil {
+				arr = append(arr, recoveryCopy{source: collectionSnapshots, ID: ID, revision: snap.Revision})
+			}
+		}
+	}
+
+	// Backups are taken before migrations so they are older than any
+	// snapshot.
+	keys, err := s.datastorer.Keys(collectionBackups)
+	if err == nil {
+		for _, key := range keys {
+			arr = append(arr, recoveryCopy{source: collectionBackups, ID: key})
+		}
+	}
+
+	sort.SliceStable(arr, func(i, j int) bool {
+		if arr[i].revision != arr[j].revision {
+			return arr[i].revision > arr[j].revision
+		}
+		return arr[i].ID > arr[j].ID
+	})
+
+	return arr
+}
+
+// readCopy returns the site stored in a snapshot or backup.
+func (s *Storage) readCopy(c recoveryCopy) (*ambient.Site, error) {
+	if c.source == collectionSnapshots {
+		return s.snapshotSite(c.ID)
+	}
+
+	b, found, err := s.datastorer.Find(c.source, c.ID)
+	if err != nil {
+		return nil, err
+	} else if !found {
+		return nil, amberror.ErrNotFound
+	}
+
+	doc, err := s.unmarshalDocument(b, &ambient.Site{}, false)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = migrateDocument(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return siteFromDocument(doc)
+}
+
+// keepReadable replaces the items in the copy with the stored items that can
+// still be read since they are newer. Items that were deleted since the copy
+// are removed. The rest are listed in the report as recovered from the copy or
+// lost if the copy doesn't have them.
+func (s *Storage) keepReadable(site *ambient.Site, report *ambient.RecoveryReport) {
+	meta := ambient.Site{}
+	found, err := s.findValue(siteEntry, &meta, false)
+	if err != nil || !found {
+		report.Recovered = append(report.Recovered, siteEntry.collection+"/"+siteEntry.key)
+	} else if meta.SchemaVersion != site.SchemaVersion {
+		// The stored items can't be read without a migration so the copy is
+		// used as is.
+		return
+	} else {
+		meta.Posts = site.Posts
+		meta.PluginStorage = site.PluginStorage
+		*site = meta
+	}
+
+	for _, collection := range []string{collectionPosts, collectionPlugins} {
+		keys, err := s.datastorer.Keys(collection)
+		if err != nil {
+			continue
+		}
+
+		stored := make(map[string]bool)
+		for _, key := range keys {
+			e := entry{collection: collection, key: key}
+
+			var v interface{}
+			switch collection {
+			case collectionPosts:
+				post := ambient.Post{}
+				found, err = s.findValue(e, &post, false)
+				v = post
+			case collectionPlugins:
+				data := ambient.PluginData{}
+				found, err = s.findValue(e, &data, false)
+				v = data
+			}
+
+			if err == nil {
+				if found {
+					stored[key] = true
+					setValue(site, e, v)
+				}
+				continue
+			}
+
+			stored[key] = true
+			if _, found := entryValue(site, e); found {
+				report.Recovered = append(report.Recovered, collection+"/"+key)
+			} else {
+				report.Lost = append(report.Lost, collection+"/"+key)
+			}
+		}
+
+		// Remove the items that were deleted after the copy was taken.
+		for _, key := range keysOf(site, collection) {
+			if !stored[key] {
+				setValue(site, entry{collection: collection, key: key}, nil)
+			}
+		}
+	}
+}
+
+// repair writes the recovered site. The items that could not be recovered are
+// moved to the corrupt collection so they don't fail the next load.
+func (s *Storage) repair(reset bool, report ambient.RecoveryReport) error {
+	s.repairing = true
+	defer func() {
+		s.repairing = false
+	}()
+
+	if reset {
+		return s.save(true, false)
+	}
+
+	return s.batch(func() error {
+		for _, item := range report.Lost {
+			e := parseEntry(item)
+			b, found, err := s.datastorer.Find(e.collection, e.key)
+			if err != nil {
+				return err
+			} else if found {
+				err = s.datastorer.Commit(collectionCorrupt, item, b)
+				if err != nil {
+					return err
+				}
+			}
+
+			err = s.datastorer.Delete(e.collection, e.key)
+			if err != nil {
+				return err
+			}
+		}
+
+		entries := make([]entry, 0, len(report.Recovered))
+		for _, item := range report.Recovered {
+			if e := parseEntry(item); e != siteEntry {
+				entries = append(entries, e)
+			}
+		}
+
+		return s.write(true, entries...)
+	})
+}
+
+// logRecovery writes the recovery report to the log.
+func (s *Storage) logRecovery(report ambient.RecoveryReport) {
+	s.log.Warn("storage recovered from %v (%v) at revision %v after error: %v", report.Source, report.ID, report.Revision, report.Error)
+	for _, item := range report.Skipped {
+		s.log.Warn("storage recovery skipped unreadable copy: %v", item)
+	}
+	for _, item := range report.Recovered {
+		s.log.Warn("storage recovery replaced item from copy: %v", item)
+	}
+	for _, item := range report.Lost {
+		s.log.Error("storage recovery could not recover item, moved to %v collection: %v", collectionCorrupt, item)
+	}
+}
+
+// parseEntry returns the entry from a collection/key string.
+func parseEntry(item string) entry {
+	i := strings.Index(item, "/")
+	if i < 0 {
+		return entry{key: item}
+	}
+
+	return entry{collection: item[:i], key: item[i+1:]}
+}
+
+// entryValue returns the item from the site object.
+func entryValue(site *ambient.Site, e entry) (interface{}, bool) {
+	switch e.collection {
+	case collectionPosts:
+		v, found := site.Posts[e.key]
+		return v, found
+	case collectionPlugins:
+		v, found := site.PluginStorage[e.key]
+		return v, found
+	}
+
+	return nil, false
+}
+
+// setValue sets the item in the site object or removes it if the value is
+// nil.
+func setValue(site *ambient.Site, e entry, v interface{}) {
+	switch e.collection {
+	case collectionPosts:
+		if v == nil {
+			delete(site.Posts, e.key)
+		} else {
+			site.Posts[e.key] = v.(ambient.Post)
+		}
+	case collectionPlugins:
+		if v == nil {
+			delete(site.PluginStorage, e.key)
+		} else {
+			site.PluginStorage[e.key] = v.(ambient.PluginData)
+		}
+	}
+}
+
+// keysOf returns the keys of a collection in the site object.
+func keysOf(site *ambient.Site, collection string) []string {
+	keys := make([]string, 0)
+	switch collection {
+	case collectionPosts:
+		for key := range site.Posts {
+			keys = append(keys, key)
+		}
+	case collectionPlugins:
+		for key := range site.PluginStorage {
+			keys = append(keys, key)
+		}
+	}
+
+	return keys
+}
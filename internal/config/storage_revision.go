@@ -0,0 +1,191 @@
+package config
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ambientkit/ambient"
+	"github.com/ambientkit/ambient/pkg/amberror"
+)
+
+const (
+	// collectionRevisions contains each post revision by post ID and revision
+	// ID.
+	collectionRevisions = "revisions"
+
+	// defaultRevisionLimit is the number of revisions to keep for each post if
+	// not set.
+	defaultRevisionLimit = 20
+)
+
+// revisionKey returns the key for a post revision.
+func revisionKey(postID string, revisionID string) string {
+	return postID + "/" + revisionID
+}
+
+// revisionLimitOrDefault returns the number of revisions to keep for each
+// post.
+func (s *Storage) revisionLimitOrDefault() int {
+	if s.revisionLimit > 0 {
+		return s.revisionLimit
+	}
+
+	return defaultRevisionLimit
+}
+
+// addRevision holds a revision of the post until the post is written. Only
+// the last revision of a post is kept if the post is saved more than once
+// before it's written.
+func (s *Storage) addRevision(postID string, post ambient.Post, author string) {
+	rev := ambient.PostRevision{
+		PostID:  postID,
+		Created: time.Now().UTC(),
+		Author:  author,
+		Post:    post,
+	}
+	rev.ID = rev.Created.Format(snapshotTimeFormat)
+
+	if s.revisions == nil {
+		s.revisions = make(map[string]ambient.PostRevision)
+	}
+	s.revisions[postID] = rev
+}
+
+// deleteRevisions drops the revision held for the post and removes the
+// stored revisions of the post when the post is written.
+func (s *Storage) deleteRevisions(postID string) {
+	delete(s.revisions, postID)
+
+	if s.deletedPosts == nil {
+		s.deletedPosts = make(map[string]bool)
+	}
+	s.deletedPosts[postID] = true
+}
+
+// clearRevisions drops the revisions and the revision deletes that are held
+// for the next write.
+func (s *Storage) clearRevisions() {
+	s.revisions = nil
+	s.deletedPosts = nil
+}
+
+// writeRevisions removes the revisions of deleted posts, stores the revisions
+// that are held, and removes the oldest revisions over the limit. The caller
+// should run it in a batch.
+func (s *Storage) writeRevisions() error {
+	deleted := make([]string, 0, len(s.deletedPosts))
+	for postID := range s.deletedPosts {
+		deleted = append(deleted, postID)
+	}
+	sort.Strings(deleted)
+
+	for _, postID := range deleted {
+		IDs, err := s.revisionIDs(postID)
+		if err != nil {
+			return err
+		}
+		for _, ID := range IDs {
+			err = s.datastorer.Delete(collectionRevisions, revisionKey(postID, ID))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	postIDs := make([]string, 0, len(s.revisions))
+	for postID := range s.revisions {
+		postIDs = append(postIDs, postID)
+	}
+	sort.Strings(postIDs)
+
+	for _, postID := range postIDs {
+		rev := s.revisions[postID]
+		b, err := s.marshal(rev, true)
+		if err != nil {
+			return err
+		}
+
+		err = s.datastorer.Commit(collectionRevisions, revisionKey(postID, rev.ID), b)
+		if err != nil {
+			return err
+		}
+
+		// Remove the oldest revisions.
+		IDs, err := s.revisionIDs(postID)
+		if err != nil {
+			return err
+		}
+		for i := s.revisionLimitOrDefault(); i < len(IDs); i++ {
+			err = s.datastorer.Delete(collectionRevisions, revisionKey(postID, IDs[i]))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// revisionIDs returns the revision IDs for a post with the newest first.
+func (s *Storage) revisionIDs(postID string) ([]string, error) {
+	keys, err := s.datastorer.Keys(collectionRevisions)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := postID + "/"
+	IDs := make([]string, 0)
+	for _, key := range keys {
+		if strings.HasPrefix(key, prefix) && !strings.Contains(key[len(prefix):], "/") {
+			IDs = append(IDs, key[len(prefix):])
+		}
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(IDs)))
+
+	return IDs, nil
+}
+
+// PostRevisions returns the revisions of a post with the newest first.
+func (s *Storage) PostRevisions(postID string) ([]ambient.PostRevision, error) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	IDs, err := s.revisionIDs(postID)
+	if err != nil {
+		return nil, err
+	}
+
+	arr := make([]ambient.PostRevision, 0, len(IDs))
+	for _, ID := range IDs {
+		rev, err := s.postRevision(postID, ID)
+		if err != nil {
+			return nil, err
+		}
+		arr = append(arr, rev)
+	}
+
+	return arr, nil
+}
+
+// PostRevision returns a revision of a post. Returns amberror.ErrNotFound if
+// the revision is not found.
+func (s *Storage) PostRevision(postID string, revisionID string) (ambient.PostRevision, error) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	return s.postRevision(postID, revisionID)
+}
+
+// postRevision returns a revision of a post.
+func (s *Storage) postRevision(postID string, revisionID string) (ambient.PostRevision, error) {
+	rev := ambient.PostRevision{}
+	found, err := s.findValue(entry{collection: collectionRevisions, key: revisionKey(postID, revisionID)}, &rev, false)
+	if err != nil {
+		return ambient.PostRevision{}, err
+	} else if !found {
+		return ambient.PostRevision{}, amberror.ErrNotFound
+	}
+
+	return rev, nil
+}
@@ -0,0 +1,114 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/ambientkit/ambient"
+	"github.com/ambientkit/ambient/pkg/amberror"
+	"github.com/ambientkit/ambient/pkg/checksum"
+)
+
+// RotateEncryption re-encrypts the site object, the backups, the post
+// revisions, the plugin stores, and the snapshots. The storage encryption
+// should decrypt with the old key and encrypt with the new key, like a
+// keyring.
+func (s *Storage) RotateEncryption() error {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	return s.rotateEncryption()
+}
+
+// rotateEncryption re-encrypts all of the storage data.
+func (s *Storage) rotateEncryption() error {
+	if s.secure == nil {
+		return amberror.ErrEncryptionDisabled
+	}
+
+	err := s.save(true, false)
+	if err != nil {
+		return err
+	}
+
+	err = s.batch(func() error {
+		err := s.reencrypt(s.datastorer, collectionBackups)
+		if err != nil {
+			return err
+		}
+
+		for _, collection := range []string{collectionRevisions, collectionPreviews, collectionPreviewKey} {
+			err = s.reencrypt(s.datastorer, collection)
+			if err != nil {
+				return err
+			}
+		}
+
+		names, err := s.pluginStoreNames()
+		if err != nil {
+			return err
+		}
+		for _, name := range names {
+			err = s.reencrypt(s.datastorer, pluginStoreCollection(name))
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if s.snapshots != nil && s.snapshots.Store != nil {
+		err = s.batch(func() error {
+			return s.reencrypt(s.snapshots.Store, collectionSnapshots)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	s.log.Info("storage encryption rotated")
+
+	return nil
+}
+
+// reencrypt decrypts and encrypts each of the items in a collection.
+func (s *Storage) reencrypt(ds ambient.KeyedDataStorer, collection string) error {
+	keys, err := ds.Keys(collection)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		b, found, err := ds.Find(collection, key)
+		if err != nil {
+			return err
+		} else if !found {
+			continue
+		}
+
+		sealed := checksum.Sealed(b)
+		b, err = s.decrypt(b, false)
+		if err != nil {
+			return fmt.Errorf("could not rotate storage data (%v/%v): %w", collection, key, err)
+		}
+
+		b, err = s.secure.Encrypt(b)
+		if err != nil {
+			return fmt.Errorf("could not encrypt storage data: %v", err.Error())
+		}
+
+		if sealed {
+			b = checksum.Seal(b)
+		}
+
+		err = ds.Commit(collection, key, b)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,253 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ambientkit/ambient"
+	"github.com/ambientkit/ambient/pkg/amberror"
+)
+
+const (
+	// collectionSnapshots contains each snapshot by ID.
+	collectionSnapshots = "snapshots"
+
+	// defaultSnapshotLimit is the number of snapshots to keep if not set.
+	defaultSnapshotLimit = 10
+
+	// defaultSnapshotInterval is the minimum time between automatic snapshots
+	// if not set.
+	defaultSnapshotInterval = time.Hour
+
+	// snapshotTimeFormat is used in the snapshot ID so IDs sort by time.
+	snapshotTimeFormat = "20060102T150405.000000000Z"
+)
+
+// snapshotLimit returns the number of snapshots to keep.
+func (s *Storage) snapshotLimit() int {
+	if s.snapshots.Limit > 0 {
+		return s.snapshots.Limit
+	}
+
+	return defaultSnapshotLimit
+}
+
+// snapshotInterval returns the minimum time between automatic snapshots.
+func (s *Storage) snapshotInterval() time.Duration {
+	if s.snapshots.Interval > 0 {
+		return s.snapshots.Interval
+	} else if s.snapshots.Interval < 0 {
+		return 0
+	}
+
+	return defaultSnapshotInterval
+}
+
+// autoSnapshot takes a snapshot after a save if snapshots are enabled and the
+// interval has passed since the last snapshot. Errors are logged instead of
+// returned since the save already succeeded.
+func (s *Storage) autoSnapshot() {
+	if s.snapshots == nil || s.snapshots.Store == nil {
+		return
+	}
+
+	if !s.lastSnapshot.IsZero() && time.Since(s.lastSnapshot) < s.snapshotInterval() {
+		return
+	}
+
+	_, err := s.createSnapshot()
+	if err != nil {
+		s.log.Error("could not create storage snapshot: %v", err.Error())
+	}
+}
+
+// CreateSnapshot stores a copy of the current site object and removes the
+// oldest snapshots over the limit.
+func (s *Storage) CreateSnapshot() (ambient.Snapshot, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	return s.createSnapshot()
+}
+
+// createSnapshot stores a copy of the current site object.
+func (s *Storage) createSnapshot() (ambient.Snapshot, error) {
+	if s.snapshots == nil || s.snapshots.Store == nil {
+		return ambient.Snapshot{}, amberror.ErrSnapshotsDisabled
+	}
+
+	snap := ambient.Snapshot{
+		Created:  time.Now().UTC(),
+		Revision: s.site.Revision,
+	}
+	snap.ID = fmt.Sprintf("%v-%v", snap.Created.Format(snapshotTimeFormat), snap.Revision)
+
+	b, err := s.marshal(s.site, true)
+	if err != nil {
+		return ambient.Snapshot{}, err
+	}
+
+	// Use a batch in case the snapshot store is the data storage.
+	err = s.batch(func() error {
+		err := s.snapshots.Store.Commit(collectionSnapshots, snap.ID, b)
+		if err != nil {
+			return err
+		}
+
+		s.lastSnapshot = snap.Created
+
+		// Remove the oldest snapshots.
+		keys, err := s.snapshots.Store.Keys(collectionSnapshots)
+		if err != nil {
+			return err
+		}
+		sort.Strings(keys)
+		for i := 0; i < len(keys)-s.snapshotLimit(); i++ {
+			err = s.snapshots.Store.Delete(collectionSnapshots, keys[i])
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return ambient.Snapshot{}, err
+	}
+
+	return snap, nil
+}
+
+// Snapshots returns the list of snapshots with the newest first.
+func (s *Storage) Snapshots() ([]ambient.Snapshot, error) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	if s.snapshots == nil || s.snapshots.Store == nil {
+		return nil, amberror.ErrSnapshotsDisabled
+	}
+
+	keys, err := s.snapshots.Store.Keys(collectionSnapshots)
+	if err != nil {
+		return nil, err
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(keys)))
+
+	arr := make([]ambient.Snapshot, 0, len(keys))
+	for _, ID := range keys {
+		snap, err := parseSnapshotID(ID)
+		if err != nil {
+			s.log.Warn("skipping snapshot with invalid ID (%v): %v", ID, err.Error())
+			continue
+		}
+		arr = append(arr, snap)
+	}
+
+	return arr, nil
+}
+
+// parseSnapshotID returns the snapshot information from the ID.
+func parseSnapshotID(ID string) (ambient.Snapshot, error) {
+	i := strings.LastIndex(ID, "-")
+	if i < 0 {
+		return ambient.Snapshot{}, fmt.Errorf("missing revision")
+	}
+
+	created, err := time.Parse(snapshotTimeFormat, ID[:i])
+	if err != nil {
+		return ambient.Snapshot{}, err
+	}
+
+	revision, err := strconv.ParseUint(ID[i+1:], 10, 64)
+	if err != nil {
+		return ambient.Snapshot{}, err
+	}
+
+	return ambient.Snapshot{
+		ID:       ID,
+		Created:  created,
+		Revision: revision,
+	}, nil
+}
+
+// snapshotSite returns the site object stored in a snapshot.
+func (s *Storage) snapshotSite(ID string) (*ambient.Site, error) {
+	if s.snapshots == nil || s.snapshots.Store == nil {
+		return nil, amberror.ErrSnapshotsDisabled
+	}
+
+	b, found, err := s.snapshots.Store.Find(collectionSnapshots, ID)
+	if err != nil {
+		return nil, err
+	} else if !found {
+		return nil, amberror.ErrNotFound
+	}
+
+	doc, err := s.unmarshalDocument(b, &ambient.Site{}, false)
+	if err != nil {
+		return nil, fmt.Errorf("could not read snapshot (%v): %w", ID, err)
+	}
+
+	// Snapshots may be from an older schema version.
+	_, err = migrateDocument(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return siteFromDocument(doc)
+}
+
+// SnapshotDiff returns the changes that restoring the snapshot would make to
+// the current site object.
+func (s *Storage) SnapshotDiff(ID string) ([]ambient.SiteChange, error) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	site, err := s.snapshotSite(ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return diffSites(s.site, site)
+}
+
+// RestoreSnapshot replaces the site object with the snapshot and saves it. A
+// snapshot of the current site object is taken first so the restore can be
+// undone.
+func (s *Storage) RestoreSnapshot(ID string) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	return s.restoreSnapshot(ID)
+}
+
+// restoreSnapshot replaces the site object with the snapshot and saves it.
+func (s *Storage) restoreSnapshot(ID string) error {
+	site, err := s.snapshotSite(ID)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.createSnapshot()
+	if err != nil {
+		return err
+	}
+
+	// Keep the loaded revision so the save is still checked for conflicts.
+	site.Revision = s.site.Revision
+	previous := s.site
+	s.site = site
+
+	err = s.save(true, false)
+	if err != nil {
+		s.site = previous
+		return err
+	}
+
+	s.log.Info("restored storage snapshot: %v", ID)
+
+	return nil
+}
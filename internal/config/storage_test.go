@@ -0,0 +1,800 @@
+package config_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/ambientkit/ambient"
+	"github.com/ambientkit/ambient/internal/config"
+	"github.com/ambientkit/ambient/pkg/amberror"
+	"github.com/ambientkit/ambient/pkg/checksum"
+	"github.com/ambientkit/ambient/pkg/codec"
+	"github.com/ambientkit/ambient/pkg/keyring"
+	"github.com/ambientkit/ambient/pkg/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+// keyedStore is a KeyedDataStorer that records the commits.
+type keyedStore struct {
+	data    map[string]map[string][]byte
+	commits []string
+	err     error // Returned by Commit and Delete if set.
+}
+
+func newKeyedStore() *keyedStore {
+	return &keyedStore{
+		data: make(map[string]map[string][]byte),
+	}
+}
+
+func (s *keyedStore) Commit(collection string, key string, b []byte) error {
+	if s.err != nil {
+		return s.err
+	}
+	if s.data[collection] == nil {
+		s.data[collection] = make(map[string][]byte)
+	}
+	s.data[collection][key] = b
+	s.commits = append(s.commits, collection+"/"+key)
+	return nil
+}
+
+func (s *keyedStore) Find(collection string, key string) ([]byte, bool, error) {
+	b, found := s.data[collection][key]
+	return b, found, nil
+}
+
+func (s *keyedStore) Delete(collection string, key string) error {
+	if s.err != nil {
+		return s.err
+	}
+	delete(s.data[collection], key)
+	return nil
+}
+
+func (s *keyedStore) Keys(collection string) ([]string, error) {
+	keys := make([]string, 0)
+	for k := range s.data[collection] {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+func newLogger(t testing.TB) ambient.AppLogger {
+	log, err := mock.NewLoggerPlugin(nil).Logger("test", "1.0", nil)
+	assert.NoError(t, err)
+	return log
+}
+
+func TestStorageLegacyBlob(t *testing.T) {
+	log := newLogger(t)
+
+	ms := mock.NewMemoryStore()
+	err := ms.Save([]byte(`{"title":"Old","posts":{"1":{"title":"Post"}},"plugins":{"plugin":{"enabled":true}}}`))
+	assert.NoError(t, err)
+
+	bs := config.NewBlobStorer(ms)
+	storage, err := config.NewStorage(log, bs, ambient.StoragePluginGroup{})
+	assert.NoError(t, err)
+
+	ps, err := config.NewPluginSystem(log, storage, &ambient.PluginLoader{})
+	assert.NoError(t, err)
+	assert.Equal(t, "Old", ps.Title())
+	assert.True(t, ps.Enabled("plugin"))
+
+	post, err := ps.PostByID("1")
+	assert.NoError(t, err)
+	assert.Equal(t, "Post", post.Title)
+
+	// The legacy object should be split into collections.
+	_, found, err := bs.Find("posts", "1")
+	assert.NoError(t, err)
+	assert.True(t, found)
+	keys, err := bs.Keys("legacy")
+	assert.NoError(t, err)
+	assert.Empty(t, keys)
+
+	// The data should survive a reload from the object.
+	storage, err = config.NewStorage(log, config.NewBlobStorer(ms), ambient.StoragePluginGroup{})
+	assert.NoError(t, err)
+	ps, err = config.NewPluginSystem(log, storage, &ambient.PluginLoader{})
+	assert.NoError(t, err)
+	assert.Equal(t, "Old", ps.Title())
+	assert.Len(t, ps.PostsAndPages(false), 1)
+}
+
+func TestStorageKeyedSaves(t *testing.T) {
+	log := newLogger(t)
+
+	ks := newKeyedStore()
+	storage, err := config.NewStorage(log, ks, ambient.StoragePluginGroup{})
+	assert.NoError(t, err)
+
+	ps, err := config.NewPluginSystem(log, storage, &ambient.PluginLoader{})
+	assert.NoError(t, err)
+
+	ks.commits = nil
+	assert.NoError(t, ps.SavePost("1", ambient.Post{Title: "Post"}))
+	revisions, err := ps.PostRevisions("1")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"posts/1", "revisions/1/" + revisions[0].ID, "site/site"}, ks.commits)
+
+	ks.commits = nil
+	assert.NoError(t, ps.SetTitle("Title"))
+	assert.Equal(t, []string{"site/site"}, ks.commits)
+
+	assert.NoError(t, ps.DeletePostByID("1"))
+	_, found, err := ks.Find("posts", "1")
+	assert.NoError(t, err)
+	assert.False(t, found)
+
+	// The store can't be watched for changes.
+	_, err = storage.Watch(func() {})
+	assert.True(t, errors.Is(err, amberror.ErrWatchNotSupported))
+}
+
+func TestStorageSaveChanged(t *testing.T) {
+	log := newLogger(t)
+
+	ring, err := keyring.New(keyring.Key{ID: "key", Secret: bytes.Repeat([]byte("a"), 32)})
+	assert.NoError(t, err)
+
+	ks := newKeyedStore()
+	storage, err := config.NewStorage(log, ks, ambient.StoragePluginGroup{Encryption: ring})
+	assert.NoError(t, err)
+	ps, err := config.NewPluginSystem(log, storage, &ambient.PluginLoader{})
+	assert.NoError(t, err)
+	assert.NoError(t, ps.SavePost("1", ambient.Post{Title: "One"}))
+	assert.NoError(t, ps.SavePost("2", ambient.Post{Title: "Two"}))
+
+	// Nothing is written if nothing changed.
+	ks.commits = nil
+	assert.NoError(t, storage.LoadDecrypted())
+	assert.NoError(t, storage.Save())
+	assert.Empty(t, ks.commits)
+
+	// Items that were saved decrypted are encrypted again.
+	assert.NoError(t, storage.SaveDecrypted())
+	ks.commits = nil
+	assert.NoError(t, storage.Save())
+	assert.ElementsMatch(t, []string{"posts/1", "posts/2", "site/site"}, ks.commits)
+
+	// Only the item that was edited by hand is written.
+	ks.data["posts"]["2"] = []byte(`{"title":"Edited"}`)
+	assert.NoError(t, storage.LoadDecrypted())
+	ks.commits = nil
+	assert.NoError(t, storage.Save())
+	assert.Equal(t, []string{"posts/2", "site/site"}, ks.commits)
+
+	ks.commits = nil
+	assert.NoError(t, storage.Save())
+	assert.Empty(t, ks.commits)
+}
+
+func TestStorageBatch(t *testing.T) {
+	log := newLogger(t)
+
+	ks := newKeyedStore()
+	storage, err := config.NewStorage(log, ks, ambient.StoragePluginGroup{})
+	assert.NoError(t, err)
+
+	ps, err := config.NewPluginSystem(log, storage, &ambient.PluginLoader{
+		Plugins: []ambient.Plugin{mock.NewPlugin("mockplugin", "1.0.0")},
+	})
+	assert.NoError(t, err)
+
+	// The changes are written together once.
+	ks.commits = nil
+	assert.NoError(t, ps.Batch(func() error {
+		assert.NoError(t, ps.SetSetting("mockplugin", "a", 1))
+		assert.NoError(t, ps.SetSetting("mockplugin", "b", 2))
+		assert.NoError(t, ps.SetEnabled("mockplugin", true))
+		assert.NoError(t, ps.SavePost("1", ambient.Post{Title: "Post"}))
+		assert.NoError(t, ps.SetTitle("Title"))
+		assert.Empty(t, ks.commits)
+		return nil
+	}))
+	revisions, err := ps.PostRevisions("1")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"plugins/mockplugin", "posts/1", "revisions/1/" + revisions[0].ID, "site/site"}, ks.commits)
+
+	// The function error is returned and the changes made before it are
+	// still written since there is no rollback.
+	ks.commits = nil
+	errUpdate := errors.New("update failed")
+	assert.Equal(t, errUpdate, ps.Batch(func() error {
+		assert.NoError(t, ps.DeletePostByID("1"))
+		return errUpdate
+	}))
+	assert.Equal(t, []string{"site/site"}, ks.commits)
+	_, found, err := ks.Find("posts", "1")
+	assert.NoError(t, err)
+	assert.False(t, found)
+
+	// The changes can be read from another instance.
+	other, err := config.NewStorage(log, ks, ambient.StoragePluginGroup{})
+	assert.NoError(t, err)
+	psOther, err := config.NewPluginSystem(log, other, &ambient.PluginLoader{})
+	assert.NoError(t, err)
+	assert.Equal(t, "Title", psOther.Title())
+	assert.True(t, psOther.Enabled("mockplugin"))
+	v, err := psOther.Setting("mockplugin", "b")
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2, v)
+	_, err = psOther.PostByID("1")
+	assert.True(t, errors.Is(err, amberror.ErrNotFound))
+}
+
+func TestStorageWriteBehind(t *testing.T) {
+	log := newLogger(t)
+
+	// Two instances share the same data storage.
+	ms := mock.NewMemoryStore()
+	storageA, err := config.NewStorage(log, config.NewBlobStorer(ms), ambient.StoragePluginGroup{
+		FlushInterval: time.Hour,
+	})
+	assert.NoError(t, err)
+	psA, err := config.NewPluginSystem(log, storageA, &ambient.PluginLoader{})
+	assert.NoError(t, err)
+	storageB, err := config.NewStorage(log, config.NewBlobStorer(ms), ambient.StoragePluginGroup{})
+	assert.NoError(t, err)
+	psB, err := config.NewPluginSystem(log, storageB, &ambient.PluginLoader{})
+	assert.NoError(t, err)
+
+	// The changes are held in memory.
+	assert.NoError(t, psA.SetTitle("Title"))
+	assert.NoError(t, psA.SavePost("1", ambient.Post{Title: "Post"}))
+	assert.Equal(t, "Title", psA.Title())
+	assert.NoError(t, psB.Load())
+	assert.Equal(t, "", psB.Title())
+
+	// Pending changes are kept when the storage is changed by another process.
+	assert.NoError(t, psB.SavePost("2", ambient.Post{Title: "Other"}))
+	assert.NoError(t, psA.Load())
+	assert.Equal(t, "Title", psA.Title())
+	assert.Len(t, psA.PostsAndPages(false), 2)
+
+	assert.NoError(t, psA.Flush())
+	assert.NoError(t, psB.Load())
+	assert.Equal(t, "Title", psB.Title())
+	assert.Len(t, psB.PostsAndPages(false), 2)
+
+	// Only the fields and items changed by the instance are applied again.
+	assert.NoError(t, psA.SetTitle("New"))
+	assert.NoError(t, psA.SavePost("1", ambient.Post{Title: "Changed"}))
+	assert.NoError(t, psB.SetURL("example.com"))
+	assert.NoError(t, psB.SavePost("2", ambient.Post{Title: "Other changed"}))
+	assert.NoError(t, psA.Flush())
+	assert.NoError(t, psB.Load())
+	assert.Equal(t, "New", psB.Title())
+	assert.Equal(t, "example.com", psB.URL())
+	post, err := psB.PostByID("1")
+	assert.NoError(t, err)
+	assert.Equal(t, "Changed", post.Title)
+	post, err = psB.PostByID("2")
+	assert.NoError(t, err)
+	assert.Equal(t, "Other changed", post.Title)
+
+	// A pending change to an item that another instance changed is dropped.
+	assert.NoError(t, psA.SavePost("1", ambient.Post{Title: "A"}))
+	assert.NoError(t, psB.SavePost("1", ambient.Post{Title: "B"}))
+	err = psA.Flush()
+	conflict := &amberror.ConflictError{}
+	assert.True(t, errors.As(err, &conflict))
+	assert.Equal(t, "posts/1", conflict.Item)
+	post, err = psA.PostByID("1")
+	assert.NoError(t, err)
+	assert.Equal(t, "B", post.Title)
+	assert.NoError(t, psA.Flush())
+
+	// The changes are written at the end of the flush interval.
+	storageC, err := config.NewStorage(log, config.NewBlobStorer(ms), ambient.StoragePluginGroup{
+		FlushInterval: 10 * time.Millisecond,
+	})
+	assert.NoError(t, err)
+	psC, err := config.NewPluginSystem(log, storageC, &ambient.PluginLoader{})
+	assert.NoError(t, err)
+	assert.NoError(t, psC.SetTitle("Later"))
+	assert.Eventually(t, func() bool {
+		assert.NoError(t, psB.Load())
+		return psB.Title() == "Later"
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestStorageConflict(t *testing.T) {
+	log := newLogger(t)
+
+	// Two instances share the same data storage.
+	ms := mock.NewMemoryStore()
+	storageA, err := config.NewStorage(log, config.NewBlobStorer(ms), ambient.StoragePluginGroup{})
+	assert.NoError(t, err)
+	storageB, err := config.NewStorage(log, config.NewBlobStorer(ms), ambient.StoragePluginGroup{})
+	assert.NoError(t, err)
+
+	psA, err := config.NewPluginSystem(log, storageA, &ambient.PluginLoader{
+		Plugins: []ambient.Plugin{mock.NewPlugin("mockplugin", "1.0.0")},
+	})
+	assert.NoError(t, err)
+	psB, err := config.NewPluginSystem(log, storageB, &ambient.PluginLoader{})
+	assert.NoError(t, err)
+
+	assert.NoError(t, psA.SetEnabled("mockplugin", true))
+
+	// A full save from a stale instance should fail.
+	err = storageB.Save()
+	conflict := &amberror.ConflictError{}
+	assert.True(t, errors.As(err, &conflict))
+	assert.True(t, errors.Is(err, amberror.ErrConflict))
+
+	// A change from a stale instance should reload and be applied again.
+	assert.NoError(t, psB.SavePost("1", ambient.Post{Title: "Post"}))
+	assert.True(t, psB.Enabled("mockplugin"))
+
+	assert.NoError(t, psA.Load())
+	assert.True(t, psA.Enabled("mockplugin"))
+	_, err = psA.PostByID("1")
+	assert.NoError(t, err)
+
+	// A change to the same item as another instance should not replace it.
+	assert.NoError(t, psA.SavePost("1", ambient.Post{Title: "A"}))
+	err = psB.SavePost("1", ambient.Post{Title: "B"})
+	assert.True(t, errors.As(err, &conflict))
+	assert.Equal(t, "posts/1", conflict.Item)
+	post, err := psB.PostByID("1")
+	assert.NoError(t, err)
+	assert.Equal(t, "A", post.Title)
+
+	// The same goes for a field of the site metadata.
+	assert.NoError(t, psA.SetTitle("A"))
+	err = psB.SetTitle("B")
+	assert.True(t, errors.As(err, &conflict))
+	assert.Equal(t, "site/title", conflict.Item)
+	assert.Equal(t, "A", psB.Title())
+}
+
+func TestStorageSnapshots(t *testing.T) {
+	log := newLogger(t)
+
+	snapshots := newKeyedStore()
+	storage, err := config.NewStorage(log, newKeyedStore(), ambient.StoragePluginGroup{
+		Snapshots: &ambient.SnapshotPolicy{
+			Store:    snapshots,
+			Limit:    2,
+			Interval: -1,
+		},
+	})
+	assert.NoError(t, err)
+
+	ps, err := config.NewPluginSystem(log, storage, &ambient.PluginLoader{})
+	assert.NoError(t, err)
+
+	assert.NoError(t, ps.SetTitle("First"))
+	assert.NoError(t, ps.SetTitle("Second"))
+	assert.NoError(t, ps.SavePost("1", ambient.Post{Title: "Post"}))
+
+	// Only the newest snapshots are kept.
+	arr, err := ps.Snapshots()
+	assert.NoError(t, err)
+	assert.Len(t, arr, 2)
+	assert.True(t, arr[0].Revision > arr[1].Revision)
+
+	// The snapshot before the post was added.
+	first := arr[1]
+	changes, err := ps.SnapshotDiff(first.ID)
+	assert.NoError(t, err)
+	assert.Len(t, changes, 1)
+	assert.Equal(t, "posts", changes[0].Path)
+	assert.Nil(t, changes[0].To)
+
+	assert.NoError(t, ps.RestoreSnapshot(first.ID))
+	assert.Equal(t, "Second", ps.Title())
+	_, err = ps.PostByID("1")
+	assert.Error(t, err)
+
+	// The restore can be undone with the snapshot taken before it.
+	arr, err = ps.Snapshots()
+	assert.NoError(t, err)
+	assert.NoError(t, ps.RestoreSnapshot(arr[1].ID))
+	_, err = ps.PostByID("1")
+	assert.NoError(t, err)
+
+	_, err = ps.SnapshotDiff("missing-1")
+	assert.True(t, errors.Is(err, amberror.ErrNotFound))
+}
+
+func TestStorageSnapshotInterval(t *testing.T) {
+	log := newLogger(t)
+
+	storage, err := config.NewStorage(log, newKeyedStore(), ambient.StoragePluginGroup{
+		Snapshots: &ambient.SnapshotPolicy{Store: newKeyedStore()},
+	})
+	assert.NoError(t, err)
+	ps, err := config.NewPluginSystem(log, storage, &ambient.PluginLoader{})
+	assert.NoError(t, err)
+
+	// Only the first save takes a snapshot within the default interval.
+	assert.NoError(t, ps.SetTitle("First"))
+	assert.NoError(t, ps.SetTitle("Second"))
+	arr, err := ps.Snapshots()
+	assert.NoError(t, err)
+	assert.Len(t, arr, 1)
+
+	// Snapshots are an error if they are not enabled.
+	storage, err = config.NewStorage(log, newKeyedStore(), ambient.StoragePluginGroup{})
+	assert.NoError(t, err)
+	_, err = storage.CreateSnapshot()
+	assert.True(t, errors.Is(err, amberror.ErrSnapshotsDisabled))
+}
+
+func TestStorageMigrations(t *testing.T) {
+	log := newLogger(t)
+
+	ks := newKeyedStore()
+	assert.NoError(t, ks.Commit("site", "site", []byte(`{"title":"Old"}`)))
+	assert.NoError(t, ks.Commit("posts", "1", []byte(`{"title":"Post"}`)))
+
+	// A dry run should report the migrations without saving them.
+	storage, err := config.NewStorage(log, ks, ambient.StoragePluginGroup{MigrationDryRun: true})
+	assert.NoError(t, err)
+	report := storage.MigrationReport()
+	assert.True(t, report.DryRun)
+	assert.Len(t, report.Steps, config.CurrentSchemaVersion())
+	assert.Empty(t, report.Backup)
+
+	// The site can be read, but not written.
+	ps, err := config.NewPluginSystem(log, storage, &ambient.PluginLoader{
+		Plugins: []ambient.Plugin{mock.NewPlugin("mockplugin", "1.0.0")},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "Old", ps.Title())
+	_, err = ps.PluginData("mockplugin")
+	assert.NoError(t, err)
+	assert.True(t, errors.Is(ps.SetTitle("New"), amberror.ErrMigrationDryRun))
+	b, _, _ := ks.Find("site", "site")
+	assert.Equal(t, `{"title":"Old"}`, string(b))
+
+	// Only the newest backups are kept.
+	assert.NoError(t, ks.Commit("backups", "schema-0-20200101T000000.000000000Z", []byte(`{}`)))
+	assert.NoError(t, ks.Commit("backups", "schema-0-20210101T000000.000000000Z", []byte(`{}`)))
+
+	storage, err = config.NewStorage(log, ks, ambient.StoragePluginGroup{MigrationBackupLimit: 2})
+	assert.NoError(t, err)
+
+	report = storage.MigrationReport()
+	assert.Equal(t, 0, report.From)
+	assert.Equal(t, config.CurrentSchemaVersion(), report.To)
+	assert.Len(t, report.Steps, config.CurrentSchemaVersion())
+	assert.NotEmpty(t, report.Changes)
+
+	// The original document should be backed up.
+	b, found, err := ks.Find("backups", report.Backup)
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Contains(t, string(b), `"title":"Old"`)
+	keys, err := ks.Keys("backups")
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{report.Backup, "schema-0-20210101T000000.000000000Z"}, keys)
+
+	// The migrated site should not be migrated again.
+	storage, err = config.NewStorage(log, ks, ambient.StoragePluginGroup{})
+	assert.NoError(t, err)
+	assert.Empty(t, storage.MigrationReport().Steps)
+
+	ps, err = config.NewPluginSystem(log, storage, &ambient.PluginLoader{})
+	assert.NoError(t, err)
+	assert.Equal(t, "Old", ps.Title())
+	_, err = ps.PostByID("1")
+	assert.NoError(t, err)
+}
+
+func TestStorageCodec(t *testing.T) {
+	log := newLogger(t)
+
+	// Start with JSON written by an older version.
+	ks := newKeyedStore()
+	assert.NoError(t, ks.Commit("site", "site", []byte(`{"title":"Old","schemaversion":1}`)))
+	assert.NoError(t, ks.Commit("plugins", "mockplugin", []byte(`{"enabled":true,"settings":{"count":1}}`)))
+
+	group := ambient.StoragePluginGroup{
+		Codec:    codec.Gob{},
+		Compress: true,
+	}
+	storage, err := config.NewStorage(log, ks, group)
+	assert.NoError(t, err)
+	assert.NoError(t, storage.Save())
+
+	b, _, _ := ks.Find("site", "site")
+	assert.False(t, json.Valid(b))
+
+	ps, err := config.NewPluginSystem(log, storage, &ambient.PluginLoader{})
+	assert.NoError(t, err)
+	assert.NoError(t, ps.SetSetting("mockplugin", "count", 2))
+
+	// The setting should keep the int type after a reload.
+	storage, err = config.NewStorage(log, ks, group)
+	assert.NoError(t, err)
+	ps, err = config.NewPluginSystem(log, storage, &ambient.PluginLoader{})
+	assert.NoError(t, err)
+	assert.Equal(t, "Old", ps.Title())
+	v, err := ps.Setting("mockplugin", "count")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, v)
+
+	// The default codec should still read the data from the header.
+	storage, err = config.NewStorage(log, ks, ambient.StoragePluginGroup{})
+	assert.NoError(t, err)
+	ps, err = config.NewPluginSystem(log, storage, &ambient.PluginLoader{})
+	assert.NoError(t, err)
+	assert.Equal(t, "Old", ps.Title())
+}
+
+func TestStorageRotateEncryption(t *testing.T) {
+	log := newLogger(t)
+
+	oldKey := keyring.Key{ID: "old", Secret: bytes.Repeat([]byte("a"), 32)}
+	newKey := keyring.Key{ID: "new", Secret: bytes.Repeat([]byte("b"), 32)}
+
+	oldRing, err := keyring.New(oldKey)
+	assert.NoError(t, err)
+
+	ks := newKeyedStore()
+	storage, err := config.NewStorage(log, ks, ambient.StoragePluginGroup{Encryption: oldRing})
+	assert.NoError(t, err)
+	ps, err := config.NewPluginSystem(log, storage, &ambient.PluginLoader{})
+	assert.NoError(t, err)
+	assert.NoError(t, ps.SavePost("1", ambient.Post{Title: "Post"}))
+
+	// The plugin store of a plugin that isn't registered is rotated too.
+	assert.NoError(t, storage.PluginStorePut("removed", "token", []byte("secret")))
+
+	ring, err := keyring.New(newKey, oldKey)
+	assert.NoError(t, err)
+	storage, err = config.NewStorage(log, ks, ambient.StoragePluginGroup{Encryption: ring})
+	assert.NoError(t, err)
+	assert.NoError(t, storage.RotateEncryption())
+
+	for _, collection := range []string{"site", "posts", "pluginstore.removed"} {
+		keys, _ := ks.Keys(collection)
+		assert.NotEmpty(t, keys, collection)
+		for _, key := range keys {
+			b, _, _ := ks.Find(collection, key)
+			b, err = checksum.Open(b)
+			assert.NoError(t, err)
+			assert.False(t, ring.NeedsRotation(b), collection+"/"+key)
+		}
+	}
+
+	// The old key is no longer needed.
+	newRing, err := keyring.New(newKey)
+	assert.NoError(t, err)
+	storage, err = config.NewStorage(log, ks, ambient.StoragePluginGroup{Encryption: newRing})
+	assert.NoError(t, err)
+	ps, err = config.NewPluginSystem(log, storage, &ambient.PluginLoader{})
+	assert.NoError(t, err)
+	_, err = ps.PostByID("1")
+	assert.NoError(t, err)
+	b, err := storage.PluginStoreGet("removed", "token")
+	assert.NoError(t, err)
+	assert.Equal(t, "secret", string(b))
+
+	storage, err = config.NewStorage(log, newKeyedStore(), ambient.StoragePluginGroup{})
+	assert.NoError(t, err)
+	assert.True(t, errors.Is(storage.RotateEncryption(), amberror.ErrEncryptionDisabled))
+}
+
+func TestStorageDataErrors(t *testing.T) {
+	log := newLogger(t)
+
+	meta := checksum.Seal([]byte(`{"title":"Site","schemaversion":1}`))
+
+	// Data that doesn't match the checksum is corrupt.
+	ks := newKeyedStore()
+	assert.NoError(t, ks.Commit("site", "site", meta[:len(meta)-1]))
+	_, err := config.NewStorage(log, ks, ambient.StoragePluginGroup{})
+	corrupt := &amberror.StorageCorruptError{}
+	assert.True(t, errors.As(err, &corrupt))
+	assert.True(t, errors.Is(err, amberror.ErrStorageCorrupt))
+	assert.True(t, errors.Is(err, checksum.ErrMismatch))
+
+	// Data that can't be decoded is in the wrong format.
+	ks = newKeyedStore()
+	assert.NoError(t, ks.Commit("site", "site", meta))
+	assert.NoError(t, ks.Commit("posts", "1", checksum.Seal([]byte(`{"title":`))))
+	_, err = config.NewStorage(log, ks, ambient.StoragePluginGroup{})
+	assert.True(t, errors.Is(err, amberror.ErrStorageFormat))
+
+	// Data that can't be decrypted has the wrong key.
+	ring, err := keyring.New(keyring.Key{ID: "a", Secret: bytes.Repeat([]byte("a"), 32)})
+	assert.NoError(t, err)
+	ks = newKeyedStore()
+	storage, err := config.NewStorage(log, ks, ambient.StoragePluginGroup{Encryption: ring})
+	assert.NoError(t, err)
+	assert.NoError(t, storage.Save())
+
+	wrongRing, err := keyring.New(keyring.Key{ID: "b", Secret: bytes.Repeat([]byte("b"), 32)})
+	assert.NoError(t, err)
+	_, err = config.NewStorage(log, ks, ambient.StoragePluginGroup{Encryption: wrongRing})
+	assert.True(t, errors.Is(err, amberror.ErrStorageKey))
+	assert.True(t, errors.Is(err, keyring.ErrUnknownKey))
+}
+
+func TestStorageWrongKeyNotRecovered(t *testing.T) {
+	log := newLogger(t)
+
+	ring, err := keyring.New(keyring.Key{ID: "a", Secret: bytes.Repeat([]byte("a"), 32)})
+	assert.NoError(t, err)
+
+	ks := newKeyedStore()
+	group := ambient.StoragePluginGroup{
+		Encryption: ring,
+		Snapshots:  &ambient.SnapshotPolicy{Store: newKeyedStore()},
+	}
+	storage, err := config.NewStorage(log, ks, group)
+	assert.NoError(t, err)
+	ps, err := config.NewPluginSystem(log, storage, &ambient.PluginLoader{})
+	assert.NoError(t, err)
+	assert.NoError(t, ps.SavePost("1", ambient.Post{Title: "Post"}))
+	_, err = ps.CreateSnapshot()
+	assert.NoError(t, err)
+	before, _, _ := ks.Find("posts", "1")
+
+	// The error is returned without replacing the stored data with a copy.
+	wrongRing, err := keyring.New(keyring.Key{ID: "b", Secret: bytes.Repeat([]byte("b"), 32)})
+	assert.NoError(t, err)
+	group.Encryption = wrongRing
+	_, err = config.NewStorage(log, ks, group)
+	assert.True(t, errors.Is(err, amberror.ErrStorageKey))
+	after, _, _ := ks.Find("posts", "1")
+	assert.Equal(t, before, after)
+	_, found, err := ks.Find("corrupt", "posts/1")
+	assert.NoError(t, err)
+	assert.False(t, found)
+}
+
+func TestStorageReadableJSON(t *testing.T) {
+	log := newLogger(t)
+
+	ms := mock.NewMemoryStore()
+	storage, err := config.NewStorage(log, config.NewBlobStorer(ms), ambient.StoragePluginGroup{})
+	assert.NoError(t, err)
+	ps, err := config.NewPluginSystem(log, storage, &ambient.PluginLoader{})
+	assert.NoError(t, err)
+	assert.NoError(t, ps.SavePost("1", ambient.Post{Title: "Post"}))
+	assert.NoError(t, ps.SetTitle("Title"))
+
+	// An unencrypted save is stored as JSON that can be edited by hand.
+	b, err := ms.Load()
+	assert.NoError(t, err)
+	doc := struct {
+		Collections map[string]map[string]map[string]interface{} `json:"collections"`
+	}{}
+	assert.NoError(t, json.Unmarshal(b, &doc))
+	assert.Equal(t, "Post", doc.Collections["posts"]["1"]["title"])
+	assert.Equal(t, "Title", doc.Collections["site"]["site"]["title"])
+}
+
+func TestStorageChecksum(t *testing.T) {
+	log := newLogger(t)
+
+	ks := newKeyedStore()
+	group := ambient.StoragePluginGroup{Checksum: true}
+	storage, err := config.NewStorage(log, ks, group)
+	assert.NoError(t, err)
+	ps, err := config.NewPluginSystem(log, storage, &ambient.PluginLoader{})
+	assert.NoError(t, err)
+	assert.NoError(t, ps.SavePost("1", ambient.Post{Title: "Post"}))
+
+	// The JSON is still readable after the checksum.
+	b, _, _ := ks.Find("posts", "1")
+	assert.True(t, checksum.Sealed(b))
+	data, err := checksum.Open(b)
+	assert.NoError(t, err)
+	assert.True(t, json.Valid(data))
+
+	// Truncated JSON is corrupt instead of in the wrong format.
+	assert.NoError(t, ks.Commit("posts", "1", b[:len(b)-2]))
+	_, err = config.NewStorage(log, ks, group)
+	assert.True(t, errors.Is(err, amberror.ErrStorageCorrupt))
+}
+
+func TestStorageRecovery(t *testing.T) {
+	log := newLogger(t)
+
+	ks := newKeyedStore()
+	group := ambient.StoragePluginGroup{
+		Snapshots: &ambient.SnapshotPolicy{
+			Store:    newKeyedStore(),
+			Interval: time.Hour,
+		},
+	}
+	storage, err := config.NewStorage(log, ks, group)
+	assert.NoError(t, err)
+	ps, err := config.NewPluginSystem(log, storage, &ambient.PluginLoader{})
+	assert.NoError(t, err)
+
+	assert.NoError(t, ps.SavePost("1", ambient.Post{Title: "Post"}))
+	assert.NoError(t, ps.SavePost("2", ambient.Post{Title: "Other"}))
+	snap, err := ps.CreateSnapshot()
+	assert.NoError(t, err)
+	assert.NoError(t, ps.SavePost("3", ambient.Post{Title: "New"}))
+	assert.NoError(t, ps.SetTitle("Title"))
+
+	// Corrupt a post in the snapshot and a post that is newer.
+	for _, ID := range []string{"1", "3"} {
+		b, _, _ := ks.Find("posts", ID)
+		b[len(b)-1] ^= 0xff
+		assert.NoError(t, ks.Commit("posts", ID, b))
+	}
+
+	storage, err = config.NewStorage(log, ks, group)
+	assert.NoError(t, err)
+	report := storage.RecoveryReport()
+	assert.Equal(t, "snapshots", report.Source)
+	assert.Equal(t, snap.ID, report.ID)
+	assert.Equal(t, []string{"posts/1"}, report.Recovered)
+	assert.Equal(t, []string{"posts/3"}, report.Lost)
+	assert.Contains(t, report.Error, amberror.ErrStorageFormat.Error())
+
+	// The items that can be read are kept.
+	ps, err = config.NewPluginSystem(log, storage, &ambient.PluginLoader{})
+	assert.NoError(t, err)
+	assert.Equal(t, "Title", ps.Title())
+	post, err := ps.PostByID("1")
+	assert.NoError(t, err)
+	assert.Equal(t, "Post", post.Title)
+	_, err = ps.PostByID("2")
+	assert.NoError(t, err)
+	_, err = ps.PostByID("3")
+	assert.True(t, errors.Is(err, amberror.ErrNotFound))
+	_, found, err := ks.Find("corrupt", "posts/3")
+	assert.NoError(t, err)
+	assert.True(t, found)
+
+	// The storage is repaired.
+	storage, err = config.NewStorage(log, ks, group)
+	assert.NoError(t, err)
+	assert.Empty(t, storage.RecoveryReport().Source)
+}
+
+func TestStorageRecoveryTruncatedBlob(t *testing.T) {
+	log := newLogger(t)
+
+	ms := mock.NewMemoryStore()
+	group := ambient.StoragePluginGroup{
+		Snapshots: &ambient.SnapshotPolicy{
+			Store: newKeyedStore(),
+		},
+	}
+	storage, err := config.NewStorage(log, config.NewBlobStorer(ms), group)
+	assert.NoError(t, err)
+	ps, err := config.NewPluginSystem(log, storage, &ambient.PluginLoader{})
+	assert.NoError(t, err)
+	assert.NoError(t, ps.SavePost("1", ambient.Post{Title: "Post"}))
+
+	b, err := ms.Load()
+	assert.NoError(t, err)
+	assert.NoError(t, ms.Save(b[:len(b)/2]))
+
+	// Without a copy, the error is returned.
+	_, err = config.NewStorage(log, config.NewBlobStorer(ms), ambient.StoragePluginGroup{})
+	assert.True(t, errors.Is(err, amberror.ErrStorageCorrupt))
+
+	storage, err = config.NewStorage(log, config.NewBlobStorer(ms), group)
+	assert.NoError(t, err)
+	assert.Equal(t, "snapshots", storage.RecoveryReport().Source)
+
+	// The document is written again.
+	b, err = ms.Load()
+	assert.NoError(t, err)
+	assert.True(t, json.Valid(b))
+	ps, err = config.NewPluginSystem(log, storage, &ambient.PluginLoader{})
+	assert.NoError(t, err)
+	post, err := ps.PostByID("1")
+	assert.NoError(t, err)
+	assert.Equal(t, "Post", post.Title)
+}
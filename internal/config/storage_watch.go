@@ -0,0 +1,102 @@
+package config
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/ambientkit/ambient"
+	"github.com/ambientkit/ambient/pkg/amberror"
+)
+
+// ChangeToken returns a token that changes when the underlying DataStorer is
+// written. If the DataStorer doesn't provide a token, a hash of the data is
+// used.
+func (s *BlobStorer) ChangeToken() (string, error) {
+	if t, ok := s.ds.(ambient.ChangeTokenStorer); ok {
+		token, err := t.ChangeToken()
+		if !errors.Is(err, amberror.ErrChangeTokenNotSupported) {
+			return token, err
+		}
+	}
+
+	b, err := s.ds.Load()
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(b)
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// Watch calls the changed function after each change to the underlying
+// DataStorer if it supports watching.
+func (s *BlobStorer) Watch(changed func()) (func(), error) {
+	if w, ok := s.ds.(ambient.WatchableStorer); ok {
+		return w.Watch(changed)
+	}
+
+	return nil, amberror.ErrWatchNotSupported
+}
+
+// changeToken returns a token that changes when the data storage is written.
+// If the data storer doesn't provide a token, the revision is used.
+func (s *Storage) changeToken() (string, error) {
+	if t, ok := s.datastorer.(ambient.ChangeTokenStorer); ok {
+		token, err := t.ChangeToken()
+		if !errors.Is(err, amberror.ErrChangeTokenNotSupported) {
+			return token, err
+		}
+	}
+
+	revision, err := s.storedRevision()
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprint(revision), nil
+}
+
+// updateToken stores the change token after a load or save so only changes
+// by another process are detected.
+func (s *Storage) updateToken() {
+	token, err := s.changeToken()
+	if err != nil {
+		s.log.Warn("could not read storage change token: %v", err.Error())
+		return
+	}
+
+	s.token = token
+}
+
+// Changed returns true if the data storage was written by another process
+// since the last load or save.
+func (s *Storage) Changed() (bool, error) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	return s.changed()
+}
+
+// changed returns true if the change token is different from the last load
+// or save.
+func (s *Storage) changed() (bool, error) {
+	token, err := s.changeToken()
+	if err != nil {
+		return false, err
+	}
+
+	return token != s.token, nil
+}
+
+// Watch calls the changed function when the data storage changes if it
+// supports watching. Returns amberror.ErrWatchNotSupported if it doesn't.
+func (s *Storage) Watch(changed func()) (func(), error) {
+	if w, ok := s.datastorer.(ambient.WatchableStorer); ok {
+		return w.Watch(changed)
+	}
+
+	return nil, amberror.ErrWatchNotSupported
+}
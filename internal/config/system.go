@@ -1,6 +1,7 @@
 package config
 
 import (
+	"errors"
 	"fmt"
 	"sort"
 
@@ -10,7 +11,10 @@ import (
 
 //go:generate go run github.com/vburenin/ifacemaker -f *.go -s PluginSystem -i PluginSystem -p ambient -o ../../gen_pluginsystem.go -y "PluginSystem provides config functions." -c "Code generated by ifacemaker. DO NOT EDIT."
 
-// PluginSystem represents loaded plugins.
+// PluginSystem represents loaded plugins. The plugins, routes, and site are
+// guarded by the storage lock so the plugin system and the storage always
+// share a consistent view. The plugin data maps are copied before they are
+// changed so the values returned to callers are never modified.
 type PluginSystem struct {
 	log     ambient.AppLogger
 	storage *Storage
@@ -34,6 +38,11 @@ type PluginSystem struct {
 	// routes will be added to the router before a user enables a plugin. It's
 	// useful for the plugin manager so people don't enable plugins blindly.
 	routes map[string][]ambient.Route
+	// pluginTypes contains the content types registered by each plugin. They
+	// are only used while the plugin is enabled.
+	pluginTypes map[string][]ambient.ContentType
+	// events sends the changes to the site to the subscribers.
+	events *eventBus
 }
 
 // NewPluginSystem returns a plugin system.
@@ -49,10 +58,12 @@ func NewPluginSystem(log ambient.AppLogger, storage *Storage, loader *ambient.Pl
 		plugins:            make(map[string]ambient.Plugin),
 		grpcPlugins:        make(map[string]bool),
 		routes:             make(map[string][]ambient.Route),
+		pluginTypes:        make(map[string][]ambient.ContentType),
+		events:             newEventBus(log),
 	}
 
-	// shouldSave is for efficiency so there is not saving on every plugin.
-	shouldSave := false
+	// changed is for efficiency so only the plugins that changed are saved.
+	changed := make([]ambient.Plugin, 0)
 
 	// Load the middleware.
 	for _, p := range loader.Middleware {
@@ -68,11 +79,11 @@ func NewPluginSystem(log ambient.AppLogger, storage *Storage, loader *ambient.Pl
 		}
 
 		// Else, load the standard plugin.
-		save, err := ps.loadPlugin(p, true, false)
+		_, save, err := ps.loadPlugin(p, true, false)
 		if err != nil {
 			return nil, err
 		} else if save {
-			shouldSave = true
+			changed = append(changed, p)
 		}
 	}
 
@@ -90,17 +101,32 @@ func NewPluginSystem(log ambient.AppLogger, storage *Storage, loader *ambient.Pl
 		}
 
 		// Else, load the standard plugin.
-		save, err := ps.loadPlugin(p, false, false)
+		_, save, err := ps.loadPlugin(p, false, false)
 		if err != nil {
 			return nil, err
 		} else if save {
-			shouldSave = true
+			changed = append(changed, p)
 		}
 	}
 
-	if shouldSave {
-		err := storage.Save()
-		if err != nil {
+	if len(changed) > 0 {
+		storage.m.Lock()
+		defer storage.m.Unlock()
+
+		err := ps.update(func() error {
+			return storage.batch(func() error {
+				for _, plugin := range changed {
+					ps.initPluginData(plugin.PluginName(), plugin.PluginVersion())
+					err := storage.savePlugin(plugin.PluginName())
+					if err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+		})
+		// The plugin data is only kept in memory on a migration dry run.
+		if err != nil && !errors.Is(err, amberror.ErrMigrationDryRun) {
 			return nil, err
 		}
 	}
@@ -120,11 +146,17 @@ func (p *PluginSystem) LoaderMiddleware() []ambient.MiddlewarePlugin {
 	return p.loader.Middleware
 }
 
-// Plugins returns the map of plugins. This returns a map that is passed by
-// reference and all the values are pointers so any changes to it will
-// be reflected in the plugin system.
+// Plugins returns a copy of the map of plugins. The values are pointers so
+// changes to the plugins will be reflected in the plugin system.
 func (p *PluginSystem) Plugins() map[string]ambient.Plugin {
-	return p.plugins
+	p.storage.m.RLock()
+	defer p.storage.m.RUnlock()
+
+	m := make(map[string]ambient.Plugin, len(p.plugins))
+	for k, v := range p.plugins {
+		m[k] = v
+	}
+	return m
 }
 
 // SessionManager returns the session manager.
@@ -149,11 +181,19 @@ func (p *PluginSystem) StorageManager() ambient.Storage {
 
 // LoadPlugin loads a single plugin into the plugin system and saves the config.
 func (p *PluginSystem) LoadPlugin(plugin ambient.Plugin, middleware bool, grpcPlugin bool) (err error) {
-	shouldSave, err := p.loadPlugin(plugin, middleware, grpcPlugin)
+	p.storage.m.Lock()
+	previous, shouldSave, err := p.loadPlugin(plugin, middleware, grpcPlugin)
+	p.storage.m.Unlock()
 	if err != nil {
 		return err
 	}
 
+	// Disable the plugin that was replaced outside of the lock since the
+	// plugin may call back into the plugin system.
+	if previous != nil {
+		previous.Disable()
+	}
+
 	// TODO: Add these so they are in the loader. These may be other work that
 	// needs to happen as well. They have to be in the loader for the
 	// grpcsystem to be able to revive them.
@@ -164,19 +204,26 @@ func (p *PluginSystem) LoadPlugin(plugin ambient.Plugin, middleware bool, grpcPl
 	// }
 
 	if shouldSave {
-		err = p.storage.Save()
+		p.storage.m.Lock()
+		defer p.storage.m.Unlock()
+
+		err = p.update(func() error {
+			p.initPluginData(plugin.PluginName(), plugin.PluginVersion())
+			return p.storage.savePlugin(plugin.PluginName())
+		})
 	}
 
 	return err
 }
 
-// loadPlugin adds a plugin to the plugin system and returns whether the config
-// should be saved.
-func (p *PluginSystem) loadPlugin(plugin ambient.Plugin, middleware bool, grpcPlugin bool) (shouldSave bool, err error) {
+// loadPlugin adds a plugin to the plugin system and returns the plugin it
+// replaced, if any, so it can be disabled and whether the config should be
+// saved.
+func (p *PluginSystem) loadPlugin(plugin ambient.Plugin, middleware bool, grpcPlugin bool) (previous ambient.Plugin, shouldSave bool, err error) {
 	// Validate plugin name and version.
 	err = ambient.Validate(plugin)
 	if err != nil {
-		return false, err
+		return nil, false, err
 	}
 	name := plugin.PluginName()
 	version := plugin.PluginVersion()
@@ -184,19 +231,17 @@ func (p *PluginSystem) loadPlugin(plugin ambient.Plugin, middleware bool, grpcPl
 	isGRPC, found := p.grpcPlugins[plugin.PluginName()]
 	if found {
 		if grpcPlugin != isGRPC {
-			return false, fmt.Errorf("cannot load the same plugin of two different types (gRPC/non-gRPC): %v", plugin.PluginName())
+			return nil, false, fmt.Errorf("cannot load the same plugin of two different types (gRPC/non-gRPC): %v", plugin.PluginName())
 		}
 	}
 
 	// Determine if an old plugin is found already loaded.
-	oldPlugin, exists := p.plugins[name]
-	if exists {
-		oldPlugin.Disable()
-	}
+	previous, exists := p.plugins[name]
 
 	// Store the plugin.
 	p.plugins[name] = plugin
 	p.grpcPlugins[plugin.PluginName()] = grpcPlugin
+	p.pluginTypes[name] = plugin.ContentTypes()
 	if !exists {
 		p.pluginNames = append(p.pluginNames, plugin.PluginName())
 	}
@@ -207,11 +252,17 @@ func (p *PluginSystem) loadPlugin(plugin ambient.Plugin, middleware bool, grpcPl
 		}
 	}
 
+	return previous, p.initPluginData(name, version), nil
+}
+
+// initPluginData adds the plugin to the app config or updates the version and
+// returns whether the config should be saved.
+func (p *PluginSystem) initPluginData(name string, version string) (shouldSave bool) {
 	// Determine if plugin if found in app config.
 	pluginData, ok := p.storage.site.PluginStorage[name]
 	if !ok {
 		p.storage.site.PluginStorage[name] = newPluginData(version)
-		return true, nil
+		return true
 	}
 
 	// Detect plugin version change.
@@ -219,10 +270,28 @@ func (p *PluginSystem) loadPlugin(plugin ambient.Plugin, middleware bool, grpcPl
 		p.log.Info("detected plugin (%v) version change from (%v) to: %v", name, pluginData.Version, version)
 		pluginData.Version = version
 		p.storage.site.PluginStorage[name] = pluginData
-		return true, nil
+		return true
+	}
+
+	return false
+}
+
+// copyPluginData returns a copy of the plugin data with new grant and setting
+// maps so it can be changed without changing the values held by readers.
+func copyPluginData(data ambient.PluginData) ambient.PluginData {
+	grants := make(ambient.PluginGrants, len(data.Grants))
+	for k, v := range data.Grants {
+		grants[k] = v
+	}
+	data.Grants = grants
+
+	settings := make(ambient.PluginSettings, len(data.Settings))
+	for k, v := range data.Settings {
+		settings[k] = v
 	}
+	data.Settings = settings
 
-	return false, nil
+	return data
 }
 
 // newPluginData returns new PluginData.
@@ -235,9 +304,42 @@ func newPluginData(version string) ambient.PluginData {
 	}
 }
 
+// maxConflictRetries is the number of times a change is applied again after
+// the storage was changed by another process.
+const maxConflictRetries = 3
+
+// update runs the change which should modify the site object and then save it.
+// If the storage was saved by another process after it was loaded, the storage
+// is reloaded and the change is run again against the latest data so changes
+// to other items are not lost. If the other process changed one of the same
+// items, the reload returns an amberror.ConflictError with the item and the
+// change is not run again. The storage lock must be held.
+func (p *PluginSystem) update(change func() error) error {
+	err := change()
+	for i := 0; i < maxConflictRetries && errors.Is(err, amberror.ErrConflict); i++ {
+		p.log.Warn("reloading storage after conflict: %v", err.Error())
+		err = p.storage.reload(false)
+		if err != nil {
+			return err
+		}
+		p.events.publish(ambient.Event{Type: ambient.EventSiteReloaded})
+
+		err = change()
+	}
+
+	return err
+}
+
 // Load will load the storage and return an error if one occurs.
 func (p *PluginSystem) Load() error {
-	return p.storage.Load()
+	err := p.storage.Load()
+	if err != nil {
+		return err
+	}
+
+	p.events.publish(ambient.Event{Type: ambient.EventSiteReloaded})
+
+	return nil
 }
 
 // Save will save the storage and return an error if one occurs.
@@ -248,29 +350,51 @@ func (p *PluginSystem) Save() error {
 // InitializePlugin will initialize the plugin in the storage and will return
 // an error if one occurs.
 func (p *PluginSystem) InitializePlugin(pluginName string, pluginVersion string) error {
-	_, ok := p.storage.site.PluginStorage[pluginName]
-	if !ok {
-		p.storage.site.PluginStorage[pluginName] = newPluginData(pluginVersion)
-		return p.storage.Save()
-	}
+	p.storage.m.Lock()
+	defer p.storage.m.Unlock()
+
+	return p.update(func() error {
+		_, ok := p.storage.site.PluginStorage[pluginName]
+		if !ok {
+			p.storage.site.PluginStorage[pluginName] = newPluginData(pluginVersion)
+			return p.storage.savePlugin(pluginName)
+		}
 
-	return nil
+		return nil
+	})
 }
 
 // RemovePlugin will delete the plugin from the storage and will return
 // an error if one occurs.
 func (p *PluginSystem) RemovePlugin(pluginName string) error {
-	_, ok := p.storage.site.PluginStorage[pluginName]
-	if ok {
-		delete(p.storage.site.PluginStorage, pluginName)
-		return p.storage.Save()
+	p.storage.m.Lock()
+	err := p.update(func() error {
+		_, ok := p.storage.site.PluginStorage[pluginName]
+		if ok {
+			delete(p.storage.site.PluginStorage, pluginName)
+			err := p.storage.savePlugin(pluginName)
+			if err != nil {
+				return err
+			}
+		}
+
+		return p.storage.pluginStoreClear(pluginName)
+	})
+	p.storage.m.Unlock()
+	if err != nil {
+		return err
 	}
 
+	p.events.drop(pluginName)
+
 	return nil
 }
 
 // Names returns a list of plugin names.
 func (p *PluginSystem) Names() []string {
+	p.storage.m.RLock()
+	defer p.storage.m.RUnlock()
+
 	// Make a copy to prevent order changing via sorting.
 	out := make([]string, len(p.pluginNames))
 	copy(out, p.pluginNames)
@@ -279,6 +403,9 @@ func (p *PluginSystem) Names() []string {
 
 // MiddlewareNames returns a list of middleware plugin names.
 func (p *PluginSystem) MiddlewareNames() []string {
+	p.storage.m.RLock()
+	defer p.storage.m.RUnlock()
+
 	// Make a copy to prevent order changing via sorting.
 	out := make([]string, len(p.middlewareNames))
 	copy(out, p.middlewareNames)
@@ -287,6 +414,9 @@ func (p *PluginSystem) MiddlewareNames() []string {
 
 // IsMiddleware returns if the plugin is middleware.
 func (p *PluginSystem) IsMiddleware(name string) bool {
+	p.storage.m.RLock()
+	defer p.storage.m.RUnlock()
+
 	if b, ok := p.middlewareNamesMap[name]; ok {
 		return b
 	}
@@ -320,6 +450,9 @@ func (p *PluginSystem) Trusted(pluginName string) bool {
 
 // Routes returns a list of plugin routes.
 func (p *PluginSystem) Routes(pluginName string) []ambient.Route {
+	p.storage.m.RLock()
+	defer p.storage.m.RUnlock()
+
 	routes, found := p.routes[pluginName]
 	if !found {
 		return make([]ambient.Route, 0)
@@ -330,6 +463,9 @@ func (p *PluginSystem) Routes(pluginName string) []ambient.Route {
 
 // PluginsData returns the plugin data map.
 func (p *PluginSystem) PluginsData() map[string]ambient.PluginData {
+	p.storage.m.RLock()
+	defer p.storage.m.RUnlock()
+
 	// Create a new map so it doesn't copy by reference.
 	m := make(map[string]ambient.PluginData)
 	for k, v := range p.storage.site.PluginStorage {
@@ -340,6 +476,9 @@ func (p *PluginSystem) PluginsData() map[string]ambient.PluginData {
 
 // Plugin returns a plugin by name.
 func (p *PluginSystem) Plugin(name string) (ambient.Plugin, error) {
+	p.storage.m.RLock()
+	defer p.storage.m.RUnlock()
+
 	plugin, ok := p.plugins[name]
 	if !ok {
 		return nil, amberror.ErrPluginNotFound
@@ -350,6 +489,9 @@ func (p *PluginSystem) Plugin(name string) (ambient.Plugin, error) {
 
 // PluginData returns a plugin data by name.
 func (p *PluginSystem) PluginData(name string) (ambient.PluginData, error) {
+	p.storage.m.RLock()
+	defer p.storage.m.RUnlock()
+
 	plugin, ok := p.storage.site.PluginStorage[name]
 	if !ok {
 		return ambient.PluginData{}, amberror.ErrPluginNotFound
@@ -361,6 +503,9 @@ func (p *PluginSystem) PluginData(name string) (ambient.PluginData, error) {
 // Enabled returns if the plugin is enabled or not. If it cannot be found, it
 // will still return false.
 func (p *PluginSystem) Enabled(name string) bool {
+	p.storage.m.RLock()
+	defer p.storage.m.RUnlock()
+
 	data, ok := p.storage.site.PluginStorage[name]
 	if !ok {
 		p.log.Debug("could not find plugin: %v", name)
@@ -372,16 +517,33 @@ func (p *PluginSystem) Enabled(name string) bool {
 
 // SetEnabled sets a plugin as enabled or not.
 func (p *PluginSystem) SetEnabled(pluginName string, enabled bool) error {
-	data, ok := p.storage.site.PluginStorage[pluginName]
-	if !ok {
-		p.log.Debug("could not find plugin: %v", pluginName)
-		return amberror.ErrNotFound
+	e := ambient.Event{Type: ambient.EventPluginDisabled, Plugin: pluginName}
+	if enabled {
+		e.Type = ambient.EventPluginEnabled
 	}
 
-	data.Enabled = enabled
-	p.storage.site.PluginStorage[pluginName] = data
+	err := p.updateEvent(e, func() error {
+		data, ok := p.storage.site.PluginStorage[pluginName]
+		if !ok {
+			p.log.Debug("could not find plugin: %v", pluginName)
+			return amberror.ErrNotFound
+		}
+
+		data.Enabled = enabled
+		p.storage.site.PluginStorage[pluginName] = data
 
-	return p.storage.Save()
+		return p.storage.savePlugin(pluginName)
+	})
+	if err != nil {
+		return err
+	}
+
+	// A disabled plugin no longer receives the events.
+	if !enabled {
+		p.events.drop(pluginName)
+	}
+
+	return nil
 }
 
 // GrantRequests returns a list of grant requests.
@@ -415,6 +577,9 @@ func (p *PluginSystem) Authorized(pluginName string, grant ambient.Grant) bool {
 
 // Granted returns whether a plugin is explicitly granted for a plugin.
 func (p *PluginSystem) Granted(pluginName string, grant ambient.Grant) bool {
+	p.storage.m.RLock()
+	defer p.storage.m.RUnlock()
+
 	data, ok := p.storage.site.PluginStorage[pluginName]
 	if !ok {
 		p.log.Debug("could not find plugin: %v", pluginName)
@@ -432,48 +597,76 @@ func (p *PluginSystem) Granted(pluginName string, grant ambient.Grant) bool {
 
 // SetGrant sets a plugin grant.
 func (p *PluginSystem) SetGrant(pluginName string, grant ambient.Grant) error {
-	data, ok := p.storage.site.PluginStorage[pluginName]
-	if !ok {
-		p.log.Debug("could not find plugin: %v", pluginName)
-		return amberror.ErrNotFound
-	}
+	p.storage.m.Lock()
+	defer p.storage.m.Unlock()
+
+	return p.update(func() error {
+		data, ok := p.storage.site.PluginStorage[pluginName]
+		if !ok {
+			p.log.Debug("could not find plugin: %v", pluginName)
+			return amberror.ErrNotFound
+		}
 
-	data.Grants[grant] = true
-	p.storage.site.PluginStorage[pluginName] = data
+		data = copyPluginData(data)
+		data.Grants[grant] = true
+		p.storage.site.PluginStorage[pluginName] = data
 
-	return p.storage.Save()
+		return p.storage.savePlugin(pluginName)
+	})
 }
 
 // RemoveGrant removes a plugin grant.
 func (p *PluginSystem) RemoveGrant(pluginName string, grant ambient.Grant) error {
-	data, ok := p.storage.site.PluginStorage[pluginName]
-	if !ok {
-		p.log.Debug("could not find plugin: %v", pluginName)
-		return amberror.ErrNotFound
+	p.storage.m.Lock()
+	err := p.update(func() error {
+		data, ok := p.storage.site.PluginStorage[pluginName]
+		if !ok {
+			p.log.Debug("could not find plugin: %v", pluginName)
+			return amberror.ErrNotFound
+		}
+
+		data = copyPluginData(data)
+		delete(data.Grants, grant)
+		p.storage.site.PluginStorage[pluginName] = data
+
+		return p.storage.savePlugin(pluginName)
+	})
+	p.storage.m.Unlock()
+	if err != nil {
+		return err
 	}
 
-	delete(data.Grants, grant)
-	p.storage.site.PluginStorage[pluginName] = data
+	// The plugin can no longer read the events.
+	if grant == ambient.GrantSiteEventRead {
+		p.events.drop(pluginName)
+	}
 
-	return p.storage.Save()
+	return nil
 }
 
 // SetSetting sets a plugin setting.
 func (p *PluginSystem) SetSetting(pluginName string, settingName string, value interface{}) error {
-	data, ok := p.storage.site.PluginStorage[pluginName]
-	if !ok {
-		p.log.Debug("could not find plugin: %v", pluginName)
-		return amberror.ErrNotFound
-	}
+	e := ambient.Event{Type: ambient.EventSettingChanged, Plugin: pluginName, Name: settingName}
+	return p.updateEvent(e, func() error {
+		data, ok := p.storage.site.PluginStorage[pluginName]
+		if !ok {
+			p.log.Debug("could not find plugin: %v", pluginName)
+			return amberror.ErrNotFound
+		}
 
-	data.Settings[settingName] = value
-	p.storage.site.PluginStorage[pluginName] = data
+		data = copyPluginData(data)
+		data.Settings[settingName] = value
+		p.storage.site.PluginStorage[pluginName] = data
 
-	return p.storage.Save()
+		return p.storage.savePlugin(pluginName)
+	})
 }
 
 // Setting returns a setting value.
 func (p *PluginSystem) Setting(pluginName string, settingName string) (interface{}, error) {
+	p.storage.m.RLock()
+	defer p.storage.m.RUnlock()
+
 	data, ok := p.storage.site.PluginStorage[pluginName]
 	if !ok {
 		p.log.Debug("could not find plugin: %v", pluginName)
@@ -508,5 +701,18 @@ func (p *PluginSystem) SettingDefault(pluginName string, settingName string) (in
 
 // SetRoute saves a route.
 func (p *PluginSystem) SetRoute(pluginName string, route []ambient.Route) {
+	p.storage.m.Lock()
+	defer p.storage.m.Unlock()
+
 	p.routes[pluginName] = route
 }
+
+// RotateEncryption re-encrypts the storage with the current encryption key.
+func (p *PluginSystem) RotateEncryption() error {
+	p.storage.m.Lock()
+	defer p.storage.m.Unlock()
+
+	return p.update(func() error {
+		return p.storage.rotateEncryption()
+	})
+}
@@ -0,0 +1,36 @@
+package config
+
+// Batch runs the function and writes the changes it makes together once it
+// returns instead of after each change. It's a batch, not a transaction:
+// there is no rollback, so changes made before an error are still written.
+// Changes made by other callers while the function runs are written in the
+// same batch. In write-behind mode, the changes are written on the next
+// flush.
+func (p *PluginSystem) Batch(fn func() error) (err error) {
+	p.storage.m.Lock()
+	p.storage.deferDepth++
+	p.storage.m.Unlock()
+
+	defer func() {
+		p.storage.m.Lock()
+		defer p.storage.m.Unlock()
+
+		p.storage.deferDepth--
+		if p.storage.deferWrites() {
+			// Another update is still running or the writes are behind.
+			return
+		}
+
+		ferr := p.storage.flush()
+		if err == nil {
+			err = ferr
+		}
+	}()
+
+	return fn()
+}
+
+// Flush writes the changes that are held in memory.
+func (p *PluginSystem) Flush() error {
+	return p.storage.Flush()
+}
@@ -0,0 +1,140 @@
+package config
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ambientkit/ambient"
+	"github.com/ambientkit/ambient/pkg/amberror"
+)
+
+// validMenuItems returns an error if an item doesn't link to exactly one post
+// or URL, or if an item links to a post that isn't saved. The storage lock
+// must be held.
+func (p *PluginSystem) validMenuItems(items []ambient.MenuItem) error {
+	for _, item := range items {
+		if (len(item.PostID) == 0) == (len(item.URL) == 0) {
+			return amberror.ErrInvalidMenu
+		} else if len(item.PostID) > 0 {
+			if _, found := p.storage.site.Posts[item.PostID]; !found {
+				return amberror.ErrInvalidMenu
+			}
+		} else if len(item.Title) == 0 {
+			return amberror.ErrInvalidMenu
+		}
+
+		err := p.validMenuItems(item.Children)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Menus returns the menus sorted by name.
+func (p *PluginSystem) Menus() []ambient.Menu {
+	p.storage.m.RLock()
+	defer p.storage.m.RUnlock()
+
+	arr := make([]ambient.Menu, 0, len(p.storage.site.Menus))
+	for _, menu := range p.storage.site.Menus {
+		arr = append(arr, menu)
+	}
+	sort.Slice(arr, func(i, j int) bool {
+		return arr[i].Name < arr[j].Name
+	})
+
+	return arr
+}
+
+// Menu returns the menu by name.
+func (p *PluginSystem) Menu(name string) (ambient.Menu, error) {
+	p.storage.m.RLock()
+	defer p.storage.m.RUnlock()
+
+	menu, found := p.storage.site.Menus[name]
+	if !found {
+		return ambient.Menu{}, amberror.ErrNotFound
+	}
+
+	return menu, nil
+}
+
+// SaveMenu saves a menu by its name. Returns amberror.ErrInvalidMenu if the
+// menu doesn't have a name or an item doesn't link to exactly one saved post
+// or URL.
+func (p *PluginSystem) SaveMenu(menu ambient.Menu) error {
+	if len(menu.Name) == 0 {
+		return amberror.ErrInvalidMenu
+	}
+
+	e := ambient.Event{Type: ambient.EventMenuChanged, Name: menu.Name}
+	return p.updateEvent(e, func() error {
+		err := p.validMenuItems(menu.Items)
+		if err != nil {
+			return err
+		}
+
+		if p.storage.site.Menus == nil {
+			p.storage.site.Menus = make(map[string]ambient.Menu)
+		}
+		p.storage.site.Menus[menu.Name] = menu
+		return p.storage.saveSite()
+	})
+}
+
+// DeleteMenu deletes a menu by name.
+func (p *PluginSystem) DeleteMenu(name string) error {
+	e := ambient.Event{Type: ambient.EventMenuChanged, Name: name}
+	return p.updateEvent(e, func() error {
+		if _, found := p.storage.site.Menus[name]; !found {
+			return amberror.ErrNotFound
+		}
+
+		delete(p.storage.site.Menus, name)
+		return p.storage.saveSite()
+	})
+}
+
+// MenuLinks returns the links of a menu so it can be rendered. Links to posts
+// that are missing or not published are left out along with their children.
+// Returns an empty list if the menu is not found.
+func (p *PluginSystem) MenuLinks(name string) []ambient.MenuLink {
+	p.storage.m.RLock()
+	defer p.storage.m.RUnlock()
+
+	return p.menuLinks(p.storage.site.Menus[name].Items, time.Now())
+}
+
+// menuLinks returns the links for the menu items. The storage lock must be
+// held.
+func (p *PluginSystem) menuLinks(items []ambient.MenuItem, now time.Time) []ambient.MenuLink {
+	arr := make([]ambient.MenuLink, 0, len(items))
+	for _, item := range items {
+		link := ambient.MenuLink{
+			Title: item.Title,
+			URL:   item.URL,
+		}
+
+		if len(item.PostID) > 0 {
+			post, found := p.storage.site.Posts[item.PostID]
+			if !found || !post.IsPublished(now) {
+				continue
+			}
+
+			link.URL = "/" + strings.TrimPrefix(post.URL, "/")
+			if len(link.Title) == 0 {
+				link.Title = post.Title
+			}
+		} else {
+			link.External = strings.Contains(link.URL, "://") || strings.HasPrefix(link.URL, "//")
+		}
+
+		link.Children = p.menuLinks(item.Children, now)
+		arr = append(arr, link)
+	}
+
+	return arr
+}
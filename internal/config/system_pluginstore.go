@@ -0,0 +1,21 @@
+package config
+
+// PluginStoreGet returns a value from the private store of a plugin.
+func (p *PluginSystem) PluginStoreGet(pluginName string, key string) ([]byte, error) {
+	return p.storage.PluginStoreGet(pluginName, key)
+}
+
+// PluginStorePut writes a value to the private store of a plugin.
+func (p *PluginSystem) PluginStorePut(pluginName string, key string, value []byte) error {
+	return p.storage.PluginStorePut(pluginName, key, value)
+}
+
+// PluginStoreDelete removes a value from the private store of a plugin.
+func (p *PluginSystem) PluginStoreDelete(pluginName string, key string) error {
+	return p.storage.PluginStoreDelete(pluginName, key)
+}
+
+// PluginStoreList returns the keys in the private store of a plugin.
+func (p *PluginSystem) PluginStoreList(pluginName string) ([]string, error) {
+	return p.storage.PluginStoreList(pluginName)
+}
@@ -0,0 +1,31 @@
+package config
+
+import (
+	"time"
+
+	"github.com/ambientkit/ambient"
+)
+
+// CreatePostPreview returns a new preview of a post with a token that is
+// valid until it expires or is revoked. The token expires after a week if
+// the duration is 0. Storage encryption must be set.
+func (p *PluginSystem) CreatePostPreview(ID string, expires time.Duration, author string) (ambient.PostPreview, error) {
+	return p.storage.CreatePostPreview(ID, expires, author)
+}
+
+// PostPreviews returns the previews of a post that have not expired with the
+// newest first.
+func (p *PluginSystem) PostPreviews(ID string) ([]ambient.PostPreview, error) {
+	return p.storage.PostPreviews(ID)
+}
+
+// RevokePostPreview revokes the token of a post preview.
+func (p *PluginSystem) RevokePostPreview(ID string, previewID string) error {
+	return p.storage.RevokePostPreview(ID, previewID)
+}
+
+// PreviewPost returns the post for a preview token even if it's not
+// published.
+func (p *PluginSystem) PreviewPost(token string) (ambient.PostWithID, error) {
+	return p.storage.PreviewPost(token)
+}
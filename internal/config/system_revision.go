@@ -0,0 +1,139 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ambientkit/ambient"
+	"github.com/ambientkit/ambient/pkg/amberror"
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// SavePostAs saves a post and keeps a revision with the username as the
+// author. If the post doesn't have an author, the author is kept from the
+// saved post or set to the username for a new post. The custom fields are
+// validated against the content type of the post and the parent must be a
+// saved post that isn't a child of the post.
+func (p *PluginSystem) SavePostAs(ID string, post ambient.Post, author string) error {
+	e := ambient.Event{Type: ambient.EventPostSaved, ID: ID}
+	return p.updateEvent(e, func() error {
+		post, err := p.checkPost(ID, post)
+		if err != nil {
+			return err
+		}
+
+		if len(post.Author) == 0 {
+			if old, found := p.storage.site.Posts[ID]; found {
+				post.Author = old.Author
+			} else {
+				post.Author = author
+			}
+		}
+
+		p.storage.site.Posts[ID] = post
+		p.storage.posts.put(p.storage.site, ID, post)
+		p.storage.addRevision(ID, post, author)
+		return p.storage.savePost(ID)
+	})
+}
+
+// PostRevisions returns the revisions of a post with the newest first.
+func (p *PluginSystem) PostRevisions(ID string) ([]ambient.PostRevision, error) {
+	return p.storage.PostRevisions(ID)
+}
+
+// PostRevision returns a revision of a post.
+func (p *PluginSystem) PostRevision(ID string, revisionID string) (ambient.PostRevision, error) {
+	return p.storage.PostRevision(ID, revisionID)
+}
+
+// RestorePostRevision saves the post from a revision. The post is validated
+// like SavePostAs and the restore is kept as a new revision so it can be
+// undone.
+func (p *PluginSystem) RestorePostRevision(ID string, revisionID string, author string) error {
+	e := ambient.Event{Type: ambient.EventPostSaved, ID: ID}
+	return p.updateEvent(e, func() error {
+		rev, err := p.storage.postRevision(ID, revisionID)
+		if err != nil {
+			return err
+		}
+
+		// The content types and pages may have changed since the revision.
+		post, err := p.checkPost(ID, rev.Post)
+		if err != nil {
+			return err
+		}
+
+		p.storage.site.Posts[ID] = post
+		p.storage.posts.put(p.storage.site, ID, post)
+		p.storage.addRevision(ID, post, author)
+		return p.storage.savePost(ID)
+	})
+}
+
+// checkPost returns the post with the custom fields validated against its
+// content type and an error if the parent is not valid. The storage lock must
+// be held.
+func (p *PluginSystem) checkPost(ID string, post ambient.Post) (ambient.Post, error) {
+	post, err := p.validatePost(post)
+	if err != nil {
+		return post, err
+	}
+
+	return post, p.validateParent(ID, post)
+}
+
+// PostRevisionDiff returns a unified diff of the title, tags, custom fields,
+// and content from a revision to the current post.
+func (p *PluginSystem) PostRevisionDiff(ID string, revisionID string) (string, error) {
+	p.storage.m.RLock()
+	defer p.storage.m.RUnlock()
+
+	rev, err := p.storage.postRevision(ID, revisionID)
+	if err != nil {
+		return "", err
+	}
+
+	post, ok := p.storage.site.Posts[ID]
+	if !ok {
+		return "", amberror.ErrNotFound
+	}
+
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(revisionText(rev.Post)),
+		B:        difflib.SplitLines(revisionText(post)),
+		FromFile: revisionID,
+		ToFile:   "current",
+		Context:  3,
+	})
+}
+
+// revisionText returns the parts of a post that are compared between
+// revisions. The title, tags, and custom fields are on their own lines
+// before the content.
+func revisionText(post ambient.Post) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "title: %v\n", post.Title)
+	fmt.Fprintf(&sb, "tags: %v\n", post.Tags.String())
+
+	names := make([]string, 0, len(post.Fields))
+	for name := range post.Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		b, err := json.Marshal(post.Fields[name])
+		if err != nil {
+			b = []byte(fmt.Sprint(post.Fields[name]))
+		}
+		fmt.Fprintf(&sb, "field %v: %s\n", name, b)
+	}
+
+	sb.WriteString("\n")
+	sb.WriteString(post.Content)
+
+	return sb.String()
+}
@@ -0,0 +1,63 @@
+package config
+
+import (
+	"sort"
+	"time"
+
+	"github.com/ambientkit/ambient"
+)
+
+// PublishScheduledPosts sends an event for each published post that reached
+// its publish or unpublish time since the last check. The posts are not
+// changed since Post.IsPublished uses the schedule. The time of the check is
+// stored so the events are not sent again after a restart. The first check on
+// a site only stores the time so the events are not sent for old posts.
+func (p *PluginSystem) PublishScheduledPosts(now time.Time) error {
+	var events []ambient.Event
+
+	p.storage.m.Lock()
+	err := p.update(func() error {
+		events = make([]ambient.Event, 0)
+		last := p.storage.site.Scheduled
+		if last.IsZero() {
+			p.storage.site.Scheduled = now
+			return p.storage.saveSite()
+		}
+
+		due := func(t time.Time) bool {
+			return !t.IsZero() && t.After(last) && !now.Before(t)
+		}
+
+		for ID, post := range p.storage.site.Posts {
+			if !post.Published {
+				continue
+			}
+			if due(post.PublishAt) {
+				events = append(events, ambient.Event{Type: ambient.EventPostPublished, ID: ID})
+			}
+			if due(post.UnpublishAt) {
+				events = append(events, ambient.Event{Type: ambient.EventPostUnpublished, ID: ID})
+			}
+		}
+		if len(events) == 0 {
+			return nil
+		}
+
+		p.storage.site.Scheduled = now
+		return p.storage.saveSite()
+	})
+	p.storage.m.Unlock()
+	if err != nil {
+		return err
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].ID < events[j].ID
+	})
+	for _, e := range events {
+		p.log.Info("scheduled post %v: %v", e.Type, e.ID)
+		p.events.publish(e)
+	}
+
+	return nil
+}
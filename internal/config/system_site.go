@@ -9,91 +9,155 @@ import (
 
 // SetTitle sets the title.
 func (p *PluginSystem) SetTitle(title string) error {
-	p.storage.site.Title = title
-	return p.storage.Save()
+	e := ambient.Event{Type: ambient.EventSiteChanged, Name: "title"}
+	return p.updateEvent(e, func() error {
+		p.storage.site.Title = title
+		return p.storage.saveSite()
+	})
 }
 
 // Title returns the title.
 func (p *PluginSystem) Title() string {
+	p.storage.m.RLock()
+	defer p.storage.m.RUnlock()
+
 	return p.storage.site.Title
 }
 
 // SetScheme sets the site scheme.
 func (p *PluginSystem) SetScheme(scheme string) error {
-	p.storage.site.Scheme = scheme
-	return p.storage.Save()
+	e := ambient.Event{Type: ambient.EventSiteChanged, Name: "scheme"}
+	return p.updateEvent(e, func() error {
+		p.storage.site.Scheme = scheme
+		return p.storage.saveSite()
+	})
 }
 
 // Scheme returns the site scheme.
 func (p *PluginSystem) Scheme() string {
+	p.storage.m.RLock()
+	defer p.storage.m.RUnlock()
+
 	return p.storage.site.Scheme
 }
 
 // SetURL sets the site URL.
 func (p *PluginSystem) SetURL(URL string) error {
-	p.storage.site.URL = URL
-	return p.storage.Save()
+	e := ambient.Event{Type: ambient.EventSiteChanged, Name: "url"}
+	return p.updateEvent(e, func() error {
+		p.storage.site.URL = URL
+		return p.storage.saveSite()
+	})
 }
 
 // URL returns the URL without the scheme at the beginning.
 func (p *PluginSystem) URL() string {
+	p.storage.m.RLock()
+	defer p.storage.m.RUnlock()
+
 	return p.storage.site.URL
 }
 
 // FullURL returns the URL with the scheme at the beginning.
 func (p *PluginSystem) FullURL() string {
+	p.storage.m.RLock()
+	defer p.storage.m.RUnlock()
+
 	return p.storage.site.SiteURL()
 }
 
 // Updated returns the home last updated timestamp.
 func (p *PluginSystem) Updated() time.Time {
+	p.storage.m.RLock()
+	defer p.storage.m.RUnlock()
+
 	return p.storage.site.Updated
 }
 
 // Tags returns the list of tags.
 func (p *PluginSystem) Tags(onlyPublished bool) ambient.TagList {
-	return p.storage.site.Tags(onlyPublished)
+	p.storage.m.RLock()
+	defer p.storage.m.RUnlock()
+
+	return p.storage.posts.tagList(p.storage.site, onlyPublished)
 }
 
 // SetContent sets the home page content.
 func (p *PluginSystem) SetContent(content string) error {
-	p.storage.site.Content = content
-	return p.storage.Save()
+	e := ambient.Event{Type: ambient.EventSiteChanged, Name: "content"}
+	return p.updateEvent(e, func() error {
+		p.storage.site.Content = content
+		return p.storage.saveSite()
+	})
 }
 
 // Content returns the site home page content.
 func (p *PluginSystem) Content() string {
+	p.storage.m.RLock()
+	defer p.storage.m.RUnlock()
+
 	return p.storage.site.Content
 }
 
 // SavePost saves a post.
 func (p *PluginSystem) SavePost(ID string, post ambient.Post) error {
-	p.storage.site.Posts[ID] = post
-	return p.storage.Save()
+	return p.SavePostAs(ID, post, "")
 }
 
 // PostsAndPages returns the list of posts and pages.
 func (p *PluginSystem) PostsAndPages(onlyPublished bool) ambient.PostWithIDList {
-	return p.storage.site.PostsAndPages(onlyPublished)
+	p.storage.m.RLock()
+	defer p.storage.m.RUnlock()
+
+	return p.storage.posts.postsAndPages(p.storage.site, onlyPublished)
 }
 
 // PublishedPosts returns the list of published posts.
 func (p *PluginSystem) PublishedPosts() []ambient.Post {
-	return p.storage.site.PublishedPosts()
+	p.storage.m.RLock()
+	defer p.storage.m.RUnlock()
+
+	return p.storage.posts.publishedPosts(p.storage.site, false)
 }
 
 // PublishedPages returns the list of published pages.
 func (p *PluginSystem) PublishedPages() []ambient.Post {
-	return p.storage.site.PublishedPages()
+	p.storage.m.RLock()
+	defer p.storage.m.RUnlock()
+
+	return p.storage.posts.publishedPosts(p.storage.site, true)
 }
 
 // PostBySlug returns the post by slug.
 func (p *PluginSystem) PostBySlug(slug string) ambient.PostWithID {
-	return p.storage.site.PostBySlug(slug)
+	p.storage.m.RLock()
+	defer p.storage.m.RUnlock()
+
+	return p.storage.posts.postBySlug(p.storage.site, slug)
+}
+
+// SearchPosts returns a page of the posts that match the query with the best
+// match first. A limit of 0 returns all of the matches.
+func (p *PluginSystem) SearchPosts(query string, onlyPublished bool, limit int, offset int) ambient.PostSearchResults {
+	p.storage.m.RLock()
+	defer p.storage.m.RUnlock()
+
+	return p.storage.posts.searchPosts(p.storage.site, query, onlyPublished, limit, offset)
+}
+
+// QueryPosts returns a page of the posts that match the query.
+func (p *PluginSystem) QueryPosts(q ambient.PostQuery) (ambient.PostQueryResult, error) {
+	p.storage.m.RLock()
+	defer p.storage.m.RUnlock()
+
+	return p.storage.posts.queryPosts(p.storage.site, q)
 }
 
 // PostByID returns the post by ID.
 func (p *PluginSystem) PostByID(ID string) (ambient.Post, error) {
+	p.storage.m.RLock()
+	defer p.storage.m.RUnlock()
+
 	post, ok := p.storage.site.Posts[ID]
 	if !ok {
 		return ambient.Post{}, amberror.ErrNotFound
@@ -104,6 +168,11 @@ func (p *PluginSystem) PostByID(ID string) (ambient.Post, error) {
 
 // DeletePostByID deletes a post.
 func (p *PluginSystem) DeletePostByID(ID string) error {
-	delete(p.storage.site.Posts, ID)
-	return p.storage.Save()
+	e := ambient.Event{Type: ambient.EventPostDeleted, ID: ID}
+	return p.updateEvent(e, func() error {
+		delete(p.storage.site.Posts, ID)
+		p.storage.posts.delete(p.storage.site, ID)
+		p.storage.deleteRevisions(ID)
+		return p.storage.savePost(ID)
+	})
 }
@@ -0,0 +1,28 @@
+package config
+
+import (
+	"github.com/ambientkit/ambient"
+)
+
+// Snapshots returns the list of site snapshots with the newest first.
+func (p *PluginSystem) Snapshots() ([]ambient.Snapshot, error) {
+	return p.storage.Snapshots()
+}
+
+// CreateSnapshot stores a copy of the current site.
+func (p *PluginSystem) CreateSnapshot() (ambient.Snapshot, error) {
+	return p.storage.CreateSnapshot()
+}
+
+// SnapshotDiff returns the changes that restoring a snapshot would make.
+func (p *PluginSystem) SnapshotDiff(ID string) ([]ambient.SiteChange, error) {
+	return p.storage.SnapshotDiff(ID)
+}
+
+// RestoreSnapshot replaces the site with a snapshot.
+func (p *PluginSystem) RestoreSnapshot(ID string) error {
+	e := ambient.Event{Type: ambient.EventSiteReloaded}
+	return p.updateEvent(e, func() error {
+		return p.storage.restoreSnapshot(ID)
+	})
+}
@@ -0,0 +1,470 @@
+package config_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ambientkit/ambient"
+	"github.com/ambientkit/ambient/internal/config"
+	"github.com/ambientkit/ambient/pkg/amberror"
+	"github.com/ambientkit/ambient/pkg/keyring"
+	"github.com/ambientkit/ambient/pkg/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPluginSystemEvents(t *testing.T) {
+	log := newLogger(t)
+
+	storage, err := config.NewStorage(log, newKeyedStore(), ambient.StoragePluginGroup{})
+	assert.NoError(t, err)
+	ps, err := config.NewPluginSystem(log, storage, &ambient.PluginLoader{
+		Plugins: []ambient.Plugin{mock.NewPlugin("mockplugin", "1.0.0")},
+	})
+	assert.NoError(t, err)
+
+	events, unsubscribe := ps.Subscribe()
+
+	assert.NoError(t, ps.SavePost("1", ambient.Post{Title: "Post"}))
+	assert.NoError(t, ps.SetTitle("Title"))
+	assert.NoError(t, ps.SetSetting("mockplugin", "name", "value"))
+	assert.NoError(t, ps.SetEnabled("mockplugin", true))
+	assert.NoError(t, ps.DeletePostByID("1"))
+	assert.NoError(t, ps.Load())
+
+	expected := []ambient.Event{
+		{Type: ambient.EventPostSaved, ID: "1"},
+		{Type: ambient.EventSiteChanged, Name: "title"},
+		{Type: ambient.EventSettingChanged, Plugin: "mockplugin", Name: "name"},
+		{Type: ambient.EventPluginEnabled, Plugin: "mockplugin"},
+		{Type: ambient.EventPostDeleted, ID: "1"},
+		{Type: ambient.EventSiteReloaded},
+	}
+	for _, want := range expected {
+		e := <-events
+		assert.False(t, e.Time.IsZero())
+		e.Time = want.Time
+		assert.Equal(t, want, e)
+	}
+
+	// A failed change should not send an event.
+	assert.Error(t, ps.SetEnabled("missing", true))
+
+	unsubscribe()
+	_, ok := <-events
+	assert.False(t, ok)
+}
+
+func TestPluginSystemEventsDropped(t *testing.T) {
+	log := newLogger(t)
+
+	storage, err := config.NewStorage(log, newKeyedStore(), ambient.StoragePluginGroup{})
+	assert.NoError(t, err)
+	ps, err := config.NewPluginSystem(log, storage, &ambient.PluginLoader{
+		Plugins: []ambient.Plugin{mock.NewPlugin("mockplugin", "1.0.0")},
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, ps.SetEnabled("mockplugin", true))
+
+	closed := func(events <-chan ambient.Event) bool {
+		for range events {
+		}
+		return true
+	}
+
+	all, unsubscribe := ps.Subscribe()
+	defer unsubscribe()
+
+	// Removing the grant closes the subscription.
+	events, unsubscribePlugin := ps.SubscribePlugin("mockplugin")
+	assert.NoError(t, ps.RemoveGrant("mockplugin", ambient.GrantSiteEventRead))
+	assert.True(t, closed(events))
+	unsubscribePlugin()
+
+	// Disabling the plugin closes the subscription.
+	events, _ = ps.SubscribePlugin("mockplugin")
+	assert.NoError(t, ps.SetEnabled("mockplugin", false))
+	assert.True(t, closed(events))
+
+	// Other subscriptions still receive the events.
+	assert.NoError(t, ps.SetTitle("Title"))
+	e := <-all
+	assert.Equal(t, ambient.EventPluginDisabled, e.Type)
+	e = <-all
+	assert.Equal(t, ambient.EventSiteChanged, e.Type)
+}
+
+func TestPluginSystemReloadIfChanged(t *testing.T) {
+	log := newLogger(t)
+
+	// Two instances share the same data storage.
+	ms := mock.NewMemoryStore()
+	storageA, err := config.NewStorage(log, config.NewBlobStorer(ms), ambient.StoragePluginGroup{})
+	assert.NoError(t, err)
+	psA, err := config.NewPluginSystem(log, storageA, &ambient.PluginLoader{})
+	assert.NoError(t, err)
+	storageB, err := config.NewStorage(log, config.NewBlobStorer(ms), ambient.StoragePluginGroup{})
+	assert.NoError(t, err)
+	psB, err := config.NewPluginSystem(log, storageB, &ambient.PluginLoader{})
+	assert.NoError(t, err)
+
+	// Changes by the same instance are not reported.
+	assert.NoError(t, psA.SetTitle("Before"))
+	_, changed, err := psA.ReloadIfChanged()
+	assert.NoError(t, err)
+	assert.False(t, changed)
+
+	changes, changed, err := psB.ReloadIfChanged()
+	assert.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, []ambient.SiteChange{{Path: "title", From: "", To: "Before"}}, changes)
+	assert.Equal(t, "Before", psB.Title())
+
+	// A change by the other instance is detected.
+	assert.NoError(t, psB.SetTitle("After"))
+	_, changed, err = psA.ReloadIfChanged()
+	assert.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, "After", psA.Title())
+}
+
+// quietLogger discards the logs without a lock so the race detector doesn't
+// see the logger as synchronization between goroutines.
+type quietLogger struct{}
+
+func (quietLogger) Log(level ambient.LogLevel, format string, v ...interface{}) {}
+func (quietLogger) Debug(format string, v ...interface{})                       {}
+func (quietLogger) Info(format string, v ...interface{})                        {}
+func (quietLogger) Warn(format string, v ...interface{})                        {}
+func (quietLogger) Error(format string, v ...interface{})                       {}
+func (quietLogger) Fatal(format string, v ...interface{})                       {}
+func (quietLogger) SetLogLevel(level ambient.LogLevel)                          {}
+func (l quietLogger) Named(name string) ambient.AppLogger                       { return l }
+func (quietLogger) Name() string                                                { return "quiet" }
+
+func TestPluginSystemConcurrency(t *testing.T) {
+	log := quietLogger{}
+
+	storage, err := config.NewStorage(log, newKeyedStore(), ambient.StoragePluginGroup{})
+	assert.NoError(t, err)
+	ps, err := config.NewPluginSystem(log, storage, &ambient.PluginLoader{
+		Plugins: []ambient.Plugin{
+			mock.NewPlugin("plugina", "1.0.0"),
+			mock.NewPlugin("pluginb", "1.0.0"),
+		},
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, ps.SetGrant("plugina", ambient.GrantSiteTitleRead))
+
+	const iterations = 200
+	var wg sync.WaitGroup
+
+	// Enable and disable the plugins while they are in use.
+	for _, name := range []string{"plugina", "pluginb"} {
+		wg.Add(1)
+		go func(name string) {
+			defer wg.Done()
+			for i := 0; i < iterations; i++ {
+				assert.NoError(t, ps.SetEnabled(name, i%2 == 0))
+				assert.NoError(t, ps.SetSetting(name, "count", i))
+			}
+		}(name)
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < iterations; i++ {
+			if i%2 == 0 {
+				assert.NoError(t, ps.SetGrant("pluginb", ambient.GrantSiteTitleRead))
+			} else {
+				assert.NoError(t, ps.RemoveGrant("pluginb", ambient.GrantSiteTitleRead))
+			}
+			assert.NoError(t, ps.SavePost(fmt.Sprint(i%10), ambient.Post{Title: "Post", Published: true}))
+			assert.NoError(t, ps.SetTitle(fmt.Sprint(i)))
+			ps.SetRoute("pluginb", []ambient.Route{{Method: "GET", Path: fmt.Sprint("/", i)}})
+		}
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < iterations; i++ {
+			assert.NoError(t, ps.LoadPlugin(mock.NewPlugin("pluginc", fmt.Sprint("1.0.", i)), false, false))
+		}
+	}()
+
+	// Read the state like the request handlers and middleware.
+	for r := 0; r < 4; r++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < iterations; i++ {
+				ps.Enabled("plugina")
+				assert.True(t, ps.Authorized("plugina", ambient.GrantSiteTitleRead))
+				ps.Authorized("pluginb", ambient.GrantSiteTitleRead)
+				_, _ = ps.Setting("pluginb", "count")
+				for _, data := range ps.PluginsData() {
+					for range data.Grants {
+					}
+					for range data.Settings {
+					}
+				}
+				for range ps.Plugins() {
+				}
+				assert.NotEmpty(t, ps.Names())
+				ps.Routes("pluginb")
+				ps.Title()
+				ps.PostsAndPages(true)
+				ps.Tags(true)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	assert.False(t, ps.Enabled("plugina"))
+	v, err := ps.Setting("plugina", "count")
+	assert.NoError(t, err)
+	assert.Equal(t, iterations-1, v)
+	assert.Equal(t, fmt.Sprint(iterations-1), ps.Title())
+	assert.Len(t, ps.Names(), 3)
+}
+
+func TestPluginSystemPostRevisions(t *testing.T) {
+	log := newLogger(t)
+
+	ks := newKeyedStore()
+	storage, err := config.NewStorage(log, ks, ambient.StoragePluginGroup{
+		PostRevisionLimit: 2,
+	})
+	assert.NoError(t, err)
+	ps, err := config.NewPluginSystem(log, storage, &ambient.PluginLoader{})
+	assert.NoError(t, err)
+
+	assert.NoError(t, ps.SavePostAs("1", ambient.Post{Title: "Post", Content: "one\ntwo\n"}, "admin"))
+	assert.NoError(t, ps.SavePost("1", ambient.Post{Title: "Updated", Content: "one\nthree\n", Tags: ambient.TagList{{Name: "go"}}}))
+	assert.NoError(t, ps.SavePostAs("2", ambient.Post{Title: "Other"}, "admin"))
+
+	revisions, err := ps.PostRevisions("1")
+	assert.NoError(t, err)
+	assert.Len(t, revisions, 2)
+	assert.Equal(t, "one\nthree\n", revisions[0].Post.Content)
+	assert.Equal(t, "", revisions[0].Author)
+	assert.Equal(t, "admin", revisions[1].Author)
+	assert.Equal(t, "1", revisions[1].PostID)
+	first := revisions[1].ID
+
+	diff, err := ps.PostRevisionDiff("1", first)
+	assert.NoError(t, err)
+	assert.Contains(t, diff, "-two\n")
+	assert.Contains(t, diff, "+three\n")
+	assert.Contains(t, diff, "-title: Post\n")
+	assert.Contains(t, diff, "+title: Updated\n")
+	assert.Contains(t, diff, "+tags: go\n")
+
+	// Restoring keeps a new revision and removes the oldest over the limit.
+	assert.NoError(t, ps.RestorePostRevision("1", first, "editor"))
+	post, err := ps.PostByID("1")
+	assert.NoError(t, err)
+	assert.Equal(t, "one\ntwo\n", post.Content)
+
+	revisions, err = ps.PostRevisions("1")
+	assert.NoError(t, err)
+	assert.Len(t, revisions, 2)
+	assert.Equal(t, "editor", revisions[0].Author)
+	assert.Equal(t, "one\ntwo\n", revisions[0].Post.Content)
+
+	_, err = ps.PostRevision("1", first)
+	assert.Equal(t, amberror.ErrNotFound, err)
+	assert.Equal(t, amberror.ErrNotFound, ps.RestorePostRevision("1", first, "editor"))
+
+	// Deleting the post removes its revisions.
+	assert.NoError(t, ps.DeletePostByID("1"))
+	revisions, err = ps.PostRevisions("1")
+	assert.NoError(t, err)
+	assert.Len(t, revisions, 0)
+	revisions, err = ps.PostRevisions("2")
+	assert.NoError(t, err)
+	assert.Len(t, revisions, 1)
+
+	// The revisions are kept if the delete is not written.
+	ks.err = errors.New("write failed")
+	assert.Error(t, ps.DeletePostByID("2"))
+	ks.err = nil
+	assert.NoError(t, ps.SavePost("3", ambient.Post{Title: "Third"}))
+	revisions, err = ps.PostRevisions("2")
+	assert.NoError(t, err)
+	assert.Len(t, revisions, 1)
+}
+
+func TestPluginSystemPublishScheduledPosts(t *testing.T) {
+	log := newLogger(t)
+
+	storage, err := config.NewStorage(log, newKeyedStore(), ambient.StoragePluginGroup{})
+	assert.NoError(t, err)
+	ps, err := config.NewPluginSystem(log, storage, &ambient.PluginLoader{})
+	assert.NoError(t, err)
+
+	now := time.Now()
+	assert.NoError(t, ps.SavePost("1", ambient.Post{Title: "Scheduled", Published: true, PublishAt: now.Add(time.Hour)}))
+	assert.NoError(t, ps.SavePost("2", ambient.Post{Title: "Expiring", Published: true, UnpublishAt: now.Add(2 * time.Hour)}))
+	assert.NoError(t, ps.SavePost("3", ambient.Post{Title: "Draft", PublishAt: now.Add(time.Hour)}))
+
+	events, unsubscribe := ps.Subscribe()
+	defer unsubscribe()
+
+	// Nothing is due yet.
+	assert.NoError(t, ps.PublishScheduledPosts(now))
+	assert.Len(t, ps.PublishedPosts(), 1)
+
+	// The schedule is kept on the post.
+	assert.NoError(t, ps.PublishScheduledPosts(now.Add(time.Hour)))
+	post, err := ps.PostByID("1")
+	assert.NoError(t, err)
+	assert.True(t, post.Published)
+	assert.False(t, post.PublishAt.IsZero())
+	e := <-events
+	assert.Equal(t, ambient.EventPostPublished, e.Type)
+	assert.Equal(t, "1", e.ID)
+
+	assert.NoError(t, ps.PublishScheduledPosts(now.Add(2*time.Hour)))
+	post, err = ps.PostByID("2")
+	assert.NoError(t, err)
+	assert.True(t, post.Published)
+	assert.False(t, post.UnpublishAt.IsZero())
+	assert.False(t, post.IsPublished(now.Add(2*time.Hour)))
+	e = <-events
+	assert.Equal(t, ambient.EventPostUnpublished, e.Type)
+	assert.Equal(t, "2", e.ID)
+
+	// The time of the check is stored so the events are not sent again.
+	assert.NoError(t, ps.Load())
+	assert.NoError(t, ps.PublishScheduledPosts(now.Add(3*time.Hour)))
+	for len(events) > 0 {
+		e = <-events
+		assert.Equal(t, ambient.EventSiteReloaded, e.Type)
+	}
+}
+
+func TestPluginSystemPublishScheduledPostsFirstRun(t *testing.T) {
+	log := newLogger(t)
+
+	storage, err := config.NewStorage(log, newKeyedStore(), ambient.StoragePluginGroup{})
+	assert.NoError(t, err)
+	ps, err := config.NewPluginSystem(log, storage, &ambient.PluginLoader{})
+	assert.NoError(t, err)
+
+	now := time.Now()
+	assert.NoError(t, ps.SavePost("1", ambient.Post{Title: "Old", Published: true, PublishAt: now.Add(-time.Hour)}))
+
+	events, unsubscribe := ps.Subscribe()
+	defer unsubscribe()
+
+	// The first check doesn't send events for the posts already live.
+	assert.NoError(t, ps.PublishScheduledPosts(now))
+	assert.Len(t, events, 0)
+	assert.Len(t, ps.PublishedPosts(), 1)
+
+	assert.NoError(t, ps.PublishScheduledPosts(now.Add(time.Hour)))
+	assert.Len(t, events, 0)
+}
+
+func TestPluginSystemPostPreviews(t *testing.T) {
+	log := newLogger(t)
+
+	ring, err := keyring.New(keyring.Key{ID: "key", Secret: bytes.Repeat([]byte("a"), 32)})
+	assert.NoError(t, err)
+	ks := newKeyedStore()
+	storage, err := config.NewStorage(log, ks, ambient.StoragePluginGroup{Encryption: ring})
+	assert.NoError(t, err)
+	ps, err := config.NewPluginSystem(log, storage, &ambient.PluginLoader{})
+	assert.NoError(t, err)
+
+	assert.NoError(t, ps.SavePost("1", ambient.Post{Title: "Draft", URL: "draft"}))
+	assert.NoError(t, ps.SavePost("2", ambient.Post{Title: "Other", URL: "other"}))
+
+	_, err = ps.PreviewPost("missing")
+	assert.Equal(t, amberror.ErrInvalidToken, err)
+	_, err = ps.CreatePostPreview("3", 0, "admin")
+	assert.Equal(t, amberror.ErrNotFound, err)
+
+	preview, err := ps.CreatePostPreview("1", 0, "admin")
+	assert.NoError(t, err)
+	assert.NotEmpty(t, preview.Token)
+	assert.Equal(t, "admin", preview.Author)
+
+	post, err := ps.PreviewPost(preview.Token)
+	assert.NoError(t, err)
+	assert.Equal(t, "1", post.ID)
+	assert.Equal(t, "Draft", post.Title)
+
+	// The signing key is encrypted.
+	b, found, err := ks.Find("previewkey", "key")
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.NotContains(t, string(b), "secret")
+
+	// A changed token is not valid.
+	_, err = ps.PreviewPost(preview.Token + "a")
+	assert.Equal(t, amberror.ErrInvalidToken, err)
+
+	// An expired token is not valid and is removed when the next preview is
+	// created.
+	expired, err := ps.CreatePostPreview("1", time.Nanosecond, "admin")
+	assert.NoError(t, err)
+	time.Sleep(time.Millisecond)
+	_, err = ps.PreviewPost(expired.Token)
+	assert.Equal(t, amberror.ErrInvalidToken, err)
+
+	other, err := ps.CreatePostPreview("2", time.Hour, "")
+	assert.NoError(t, err)
+	keys, err := ks.Keys("previews")
+	assert.NoError(t, err)
+	assert.Len(t, keys, 2)
+
+	previews, err := ps.PostPreviews("1")
+	assert.NoError(t, err)
+	assert.Len(t, previews, 1)
+	assert.Equal(t, preview.ID, previews[0].ID)
+	assert.Empty(t, previews[0].Token)
+
+	// A revoked token is not valid.
+	assert.NoError(t, ps.RevokePostPreview("1", preview.ID))
+	_, err = ps.PreviewPost(preview.Token)
+	assert.Equal(t, amberror.ErrInvalidToken, err)
+	assert.Equal(t, amberror.ErrNotFound, ps.RevokePostPreview("1", preview.ID))
+
+	// Tokens are still valid after a reload.
+	assert.NoError(t, ps.Load())
+	post, err = ps.PreviewPost(other.Token)
+	assert.NoError(t, err)
+	assert.Equal(t, "2", post.ID)
+}
+
+func TestPluginSystemPostPreviewsWithoutEncryption(t *testing.T) {
+	log := newLogger(t)
+
+	ks := newKeyedStore()
+	storage, err := config.NewStorage(log, ks, ambient.StoragePluginGroup{})
+	assert.NoError(t, err)
+	ps, err := config.NewPluginSystem(log, storage, &ambient.PluginLoader{})
+	assert.NoError(t, err)
+
+	assert.NoError(t, ps.SavePost("1", ambient.Post{Title: "Draft", URL: "draft"}))
+
+	// The signing key is not stored in plaintext.
+	_, err = ps.CreatePostPreview("1", 0, "admin")
+	assert.Equal(t, amberror.ErrEncryptionDisabled, err)
+	keys, err := ks.Keys("previewkey")
+	assert.NoError(t, err)
+	assert.Len(t, keys, 0)
+
+	// A key that is stored in plaintext is not trusted.
+	assert.NoError(t, ks.Commit("previewkey", "key", []byte(`{"secret":"YWFhYQ=="}`)))
+	_, err = ps.PreviewPost("e30.c2ln")
+	assert.Equal(t, amberror.ErrInvalidToken, err)
+}
@@ -0,0 +1,49 @@
+package config
+
+import (
+	"github.com/ambientkit/ambient"
+)
+
+// ReloadIfChanged reloads the site if the storage was written by another
+// process and returns the changes.
+func (p *PluginSystem) ReloadIfChanged() ([]ambient.SiteChange, bool, error) {
+	changes, changed, err := p.reloadIfChanged()
+	if err != nil || !changed {
+		return nil, changed, err
+	}
+
+	p.log.Info("reloaded storage after change by another process")
+	for _, change := range changes {
+		// Only the path is logged since the values may hold secrets.
+		p.log.Info("storage change: %v", change.Path)
+	}
+
+	p.events.publish(ambient.Event{Type: ambient.EventSiteReloaded})
+
+	return changes, true, nil
+}
+
+// reloadIfChanged reloads the site while holding the storage lock so the
+// check and the reload can't be split by another change.
+func (p *PluginSystem) reloadIfChanged() ([]ambient.SiteChange, bool, error) {
+	p.storage.m.Lock()
+	defer p.storage.m.Unlock()
+
+	changed, err := p.storage.changed()
+	if err != nil || !changed {
+		return nil, false, err
+	}
+
+	before := p.storage.site
+	err = p.storage.reload(false)
+	if err != nil {
+		return nil, false, err
+	}
+
+	changes, err := diffSites(before, p.storage.site)
+	if err != nil {
+		return nil, true, err
+	}
+
+	return changes, true, nil
+}
@@ -17,16 +17,28 @@ type DevConsole struct {
 	storage       ambient.Storage
 	pluginsystem  ambient.PluginSystem
 	securestorage *secureconfig.SecureSite
+	rotator       ambient.EncryptionRotator
+	repairer      ambient.StorageRepairer
+	sessionstorer ambient.SessionStorer
+}
+
+// sessionEncrypter is implemented by session storers that encrypt the data.
+type sessionEncrypter interface {
+	SaveEncrypted() error
+	SaveDecrypted() error
 }
 
 // NewDevConsole returns the dev console object to receive commands from the amb
 // tool.
-func NewDevConsole(logger ambient.AppLogger, ps ambient.PluginSystem, storage ambient.Storage, site *secureconfig.SecureSite) *DevConsole {
+func NewDevConsole(logger ambient.AppLogger, ps ambient.PluginSystem, storage ambient.Storage, site *secureconfig.SecureSite, rotator ambient.EncryptionRotator, repairer ambient.StorageRepairer, ss ambient.SessionStorer) *DevConsole {
 	return &DevConsole{
 		log:           logger,
 		storage:       storage,
 		pluginsystem:  ps,
 		securestorage: site,
+		rotator:       rotator,
+		repairer:      repairer,
+		sessionstorer: ss,
 	}
 }
 
@@ -62,6 +74,15 @@ func (dc *DevConsole) EnableDevConsole() {
 				return ambient.StatusError{Code: http.StatusInternalServerError, Err: err}
 			}
 
+			// Encrypt the session data if supported.
+			if se, ok := dc.sessionstorer.(sessionEncrypter); ok {
+				dc.log.Debug("session data encrypted")
+				err = se.SaveEncrypted()
+				if err != nil {
+					return ambient.StatusError{Code: http.StatusInternalServerError, Err: err}
+				}
+			}
+
 			return nil
 		})
 
@@ -73,6 +94,103 @@ func (dc *DevConsole) EnableDevConsole() {
 				return ambient.StatusError{Code: http.StatusInternalServerError, Err: err}
 			}
 
+			// Decrypt the session data if supported.
+			if se, ok := dc.sessionstorer.(sessionEncrypter); ok {
+				dc.log.Debug("session data decrypted")
+				err = se.SaveDecrypted()
+				if err != nil {
+					return ambient.StatusError{Code: http.StatusInternalServerError, Err: err}
+				}
+			}
+
+			return nil
+		})
+
+		// Re-encrypt the site and sessions with the primary key.
+		mux.Post("/storage/rotate", func(w http.ResponseWriter, r *http.Request) error {
+			dc.log.Debug("storage encryption rotated")
+			err := dc.rotator.RotateEncryption()
+			if err != nil {
+				return ambient.StatusError{Code: http.StatusInternalServerError, Err: err}
+			}
+
+			return nil
+		})
+
+		// Return the storage mirrors that don't match the storage.
+		mux.Get("/storage/divergence", func(w http.ResponseWriter, r *http.Request) error {
+			dc.log.Debug("get storage divergence")
+			return JSON(w, dc.repairer.StorageDivergence())
+		})
+
+		// Copy the storage to the mirrors that don't match it.
+		mux.Post("/storage/repair", func(w http.ResponseWriter, r *http.Request) error {
+			dc.log.Debug("storage mirrors repaired")
+			err := dc.repairer.RepairStorage()
+			if err != nil {
+				return ambient.StatusError{Code: http.StatusInternalServerError, Err: err}
+			}
+
+			return JSON(w, dc.repairer.StorageDivergence())
+		})
+
+		// Return the schema migrations from the last load.
+		mux.Get("/storage/migrations", func(w http.ResponseWriter, r *http.Request) error {
+			dc.log.Debug("get storage migrations")
+			return JSON(w, dc.storage.MigrationReport())
+		})
+
+		// Return the fallback to a snapshot or backup from the last load.
+		mux.Get("/storage/recovery", func(w http.ResponseWriter, r *http.Request) error {
+			dc.log.Debug("get storage recovery")
+			return JSON(w, dc.storage.RecoveryReport())
+		})
+
+		// Return a list of site snapshots.
+		mux.Get("/storage/snapshots", func(w http.ResponseWriter, r *http.Request) error {
+			dc.log.Debug("get storage snapshots")
+			snapshots, err := dc.securestorage.Snapshots()
+			if err != nil {
+				return ambient.StatusError{Code: http.StatusBadRequest, Err: err}
+			}
+
+			return JSON(w, snapshots)
+		})
+
+		// Create a site snapshot.
+		mux.Post("/storage/snapshots", func(w http.ResponseWriter, r *http.Request) error {
+			dc.log.Debug("create storage snapshot")
+			snapshot, err := dc.securestorage.CreateSnapshot()
+			if err != nil {
+				return ambient.StatusError{Code: http.StatusBadRequest, Err: err}
+			}
+
+			return JSON(w, snapshot)
+		})
+
+		// Return the changes that restoring a site snapshot would make.
+		mux.Get("/storage/snapshots/{snapshotID}/diff", func(w http.ResponseWriter, r *http.Request) error {
+			snapshotID := mux.Param(r, "snapshotID")
+			dc.log.Debug("diff storage snapshot: %v", snapshotID)
+
+			changes, err := dc.securestorage.SnapshotDiff(snapshotID)
+			if err != nil {
+				return ambient.StatusError{Code: http.StatusBadRequest, Err: err}
+			}
+
+			return JSON(w, changes)
+		})
+
+		// Restore a site snapshot.
+		mux.Post("/storage/snapshots/{snapshotID}/restore", func(w http.ResponseWriter, r *http.Request) error {
+			snapshotID := mux.Param(r, "snapshotID")
+			dc.log.Debug("restore storage snapshot: %v", snapshotID)
+
+			err := dc.securestorage.RestoreSnapshot(snapshotID)
+			if err != nil {
+				return ambient.StatusError{Code: http.StatusBadRequest, Err: err}
+			}
+
 			return nil
 		})
 
@@ -143,28 +143,29 @@ func (c *PluginInjector) Inject(inject ambient.LayoutInjector, t *template.Templ
 	}
 
 	// Inject into each component.
+	fm = ambient.NavigationFuncMap(ambient.GlobalFuncMap(fm), c.pluginsystem)
 	var err error
-	t, err = inject.Head(t, pluginHead, ambient.GlobalFuncMap(fm), data)
+	t, err = inject.Head(t, pluginHead, fm, data)
 	if err != nil {
 		return nil, err
 	}
 
-	t, err = inject.Header(t, pluginHeader, ambient.GlobalFuncMap(fm), data)
+	t, err = inject.Header(t, pluginHeader, fm, data)
 	if err != nil {
 		return nil, err
 	}
 
-	t, err = inject.Main(t, pluginMain, ambient.GlobalFuncMap(fm), data)
+	t, err = inject.Main(t, pluginMain, fm, data)
 	if err != nil {
 		return nil, err
 	}
 
-	t, err = inject.Body(t, pluginBody, ambient.GlobalFuncMap(fm), data)
+	t, err = inject.Body(t, pluginBody, fm, data)
 	if err != nil {
 		return nil, err
 	}
 
-	t, err = inject.Footer(t, pluginFooter, ambient.GlobalFuncMap(fm), data)
+	t, err = inject.Footer(t, pluginFooter, fm, data)
 	if err != nil {
 		return nil, err
 	}
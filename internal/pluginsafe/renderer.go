@@ -10,23 +10,26 @@ import (
 // TemplateRenderer represents a plugin template enginer.
 type TemplateRenderer struct {
 	render ambient.Renderer
+	nav    ambient.Navigation
 }
 
-// NewRenderer returns a new template engine for plugins.
-func NewRenderer(render ambient.Renderer) *TemplateRenderer {
+// NewRenderer returns a new template engine for plugins. The menus and page
+// trees from nav are available in all templates.
+func NewRenderer(render ambient.Renderer, nav ambient.Navigation) *TemplateRenderer {
 	return &TemplateRenderer{
 		render: render,
+		nav:    nav,
 	}
 }
 
 // globalFuncMapCallable returns a callable function.
-func globalFuncMapCallable(r *http.Request, fm func(r *http.Request) template.FuncMap) func(r *http.Request) template.FuncMap {
+func (rr *TemplateRenderer) globalFuncMapCallable(r *http.Request, fm func(r *http.Request) template.FuncMap) func(r *http.Request) template.FuncMap {
 	var f = template.FuncMap{}
 	if fm != nil {
 		f = fm(r)
 	}
 	return func(r *http.Request) template.FuncMap {
-		return ambient.GlobalFuncMap(f)
+		return ambient.NavigationFuncMap(ambient.GlobalFuncMap(f), rr.nav)
 	}
 }
 
@@ -34,33 +37,33 @@ func globalFuncMapCallable(r *http.Request, fm func(r *http.Request) template.Fu
 func (rr *TemplateRenderer) Page(w http.ResponseWriter, r *http.Request,
 	assets ambient.FileSystemReader, templateName string, fm func(r *http.Request) template.FuncMap,
 	vars map[string]interface{}) (err error) {
-	return rr.render.Page(w, r, assets, templateName, globalFuncMapCallable(r, fm), vars)
+	return rr.render.Page(w, r, assets, templateName, rr.globalFuncMapCallable(r, fm), vars)
 }
 
 // PageContent renders page content.
 func (rr *TemplateRenderer) PageContent(w http.ResponseWriter, r *http.Request,
 	content string, fm func(r *http.Request) template.FuncMap,
 	vars map[string]interface{}) (err error) {
-	return rr.render.PageContent(w, r, content, globalFuncMapCallable(r, fm), vars)
+	return rr.render.PageContent(w, r, content, rr.globalFuncMapCallable(r, fm), vars)
 }
 
 // Post renders a post.
 func (rr *TemplateRenderer) Post(w http.ResponseWriter, r *http.Request,
 	assets ambient.FileSystemReader, templateName string, fm func(r *http.Request) template.FuncMap,
 	vars map[string]interface{}) (err error) {
-	return rr.render.Post(w, r, assets, templateName, globalFuncMapCallable(r, fm), vars)
+	return rr.render.Post(w, r, assets, templateName, rr.globalFuncMapCallable(r, fm), vars)
 }
 
 // PostContent renders post content.
 func (rr *TemplateRenderer) PostContent(w http.ResponseWriter, r *http.Request,
 	content string, fm func(r *http.Request) template.FuncMap,
 	vars map[string]interface{}) (err error) {
-	return rr.render.PostContent(w, r, content, globalFuncMapCallable(r, fm), vars)
+	return rr.render.PostContent(w, r, content, rr.globalFuncMapCallable(r, fm), vars)
 }
 
 // Error renders an error.
 func (rr *TemplateRenderer) Error(w http.ResponseWriter, r *http.Request,
 	content string, statusCode int, fm func(r *http.Request) template.FuncMap,
 	vars map[string]interface{}) (err error) {
-	return rr.render.Error(w, r, content, statusCode, globalFuncMapCallable(r, fm), vars)
+	return rr.render.Error(w, r, content, statusCode, rr.globalFuncMapCallable(r, fm), vars)
 }
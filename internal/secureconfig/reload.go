@@ -0,0 +1,55 @@
+package secureconfig
+
+import (
+	"github.com/ambientkit/ambient"
+)
+
+// ReloadChangedStorage reloads the site if the storage was changed by another
+// process and then enables or disables the plugins that changed.
+func ReloadChangedStorage(ss *SecureSite) ([]ambient.SiteChange, error) {
+	before := ss.pluginStates()
+
+	changes, changed, err := ss.pluginsystem.ReloadIfChanged()
+	if err != nil || !changed {
+		return nil, err
+	}
+
+	ss.applyPluginStates(before)
+
+	return changes, nil
+}
+
+// pluginStates returns whether each plugin is enabled.
+func (ss *SecureSite) pluginStates() map[string]bool {
+	states := make(map[string]bool)
+	for _, name := range ss.pluginsystem.Names() {
+		states[name] = ss.pluginsystem.Enabled(name)
+	}
+
+	return states
+}
+
+// applyPluginStates loads the plugins that are now enabled and disables the
+// plugins that are now disabled after the site changes.
+func (ss *SecureSite) applyPluginStates(before map[string]bool) {
+	for name, enabled := range before {
+		if ss.pluginsystem.Enabled(name) == enabled {
+			continue
+		}
+
+		if !enabled {
+			ss.LoadSinglePluginPages(name)
+			continue
+		}
+
+		plugin, err := ss.pluginsystem.Plugin(name)
+		if err != nil {
+			continue
+		}
+
+		err = plugin.Disable()
+		if err != nil {
+			ss.log.Error("plugin disable: problem disabling plugin (%v): %v", name, err.Error())
+		}
+	}
+}
@@ -2,6 +2,7 @@
 package secureconfig
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/ambientkit/ambient"
@@ -51,6 +52,10 @@ func NewSecureSite(pluginName string, log ambient.AppLogger, ps ambient.PluginSy
 
 // Error returns the proper error. Separated to allow reuse for gRPC.
 func Error(siteError error) (err error) {
+	if errors.Is(siteError, amberror.ErrConflict) {
+		return ambient.StatusError{Code: http.StatusConflict, Err: siteError}
+	}
+
 	switch siteError {
 	case amberror.ErrAccessDenied, amberror.ErrGrantNotRequested, amberror.ErrSettingNotSpecified:
 		return ambient.StatusError{Code: http.StatusForbidden, Err: siteError}
@@ -0,0 +1,19 @@
+package secureconfig
+
+import (
+	"github.com/ambientkit/ambient"
+	"github.com/ambientkit/ambient/pkg/amberror"
+)
+
+// Subscribe returns a channel that receives the changes to the site and a
+// function to stop receiving them. The channel is closed when the plugin is
+// disabled or the grant is removed.
+func (ss *SecureSite) Subscribe() (<-chan ambient.Event, func(), error) {
+	if !ss.Authorized(ambient.GrantSiteEventRead) {
+		return nil, nil, amberror.ErrAccessDenied
+	}
+
+	events, unsubscribe := ss.pluginsystem.SubscribePlugin(ss.pluginName)
+
+	return events, unsubscribe, nil
+}
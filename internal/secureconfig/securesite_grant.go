@@ -101,9 +101,6 @@ func (ss *SecureSite) SetNeighborPluginGrant(pluginName string, grantName ambien
 	} else {
 		err = ss.pluginsystem.RemoveGrant(pluginName, grantName)
 	}
-	if err != nil {
-		return err
-	}
 
-	return ss.pluginsystem.Save()
+	return err
 }
@@ -0,0 +1,63 @@
+package secureconfig
+
+import (
+	"github.com/ambientkit/ambient"
+	"github.com/ambientkit/ambient/pkg/amberror"
+)
+
+// Menus returns the menus sorted by name.
+func (ss *SecureSite) Menus() ([]ambient.Menu, error) {
+	if !ss.Authorized(ambient.GrantSiteMenuRead) {
+		return nil, amberror.ErrAccessDenied
+	}
+
+	return ss.pluginsystem.Menus(), nil
+}
+
+// Menu returns a menu by name.
+func (ss *SecureSite) Menu(name string) (ambient.Menu, error) {
+	if !ss.Authorized(ambient.GrantSiteMenuRead) {
+		return ambient.Menu{}, amberror.ErrAccessDenied
+	}
+
+	return ss.pluginsystem.Menu(name)
+}
+
+// SaveMenu saves a menu by its name.
+func (ss *SecureSite) SaveMenu(menu ambient.Menu) error {
+	if !ss.Authorized(ambient.GrantSiteMenuWrite) {
+		return amberror.ErrAccessDenied
+	}
+
+	return ss.pluginsystem.SaveMenu(menu)
+}
+
+// DeleteMenu deletes a menu by name.
+func (ss *SecureSite) DeleteMenu(name string) error {
+	if !ss.Authorized(ambient.GrantSiteMenuWrite) {
+		return amberror.ErrAccessDenied
+	}
+
+	return ss.pluginsystem.DeleteMenu(name)
+}
+
+// PageChildren returns the published posts and pages under the parent sorted
+// by order and then by title. If the ID is empty, the published top level
+// pages are returned.
+func (ss *SecureSite) PageChildren(ID string) (ambient.PostWithIDList, error) {
+	if !ss.Authorized(ambient.GrantSitePostRead) {
+		return nil, amberror.ErrAccessDenied
+	}
+
+	return ss.pluginsystem.PageChildren(ID), nil
+}
+
+// Breadcrumbs returns the post with its published parent pages with the top
+// level page first.
+func (ss *SecureSite) Breadcrumbs(ID string) (ambient.PostWithIDList, error) {
+	if !ss.Authorized(ambient.GrantSitePostRead) {
+		return nil, amberror.ErrAccessDenied
+	}
+
+	return ss.pluginsystem.Breadcrumbs(ID), nil
+}
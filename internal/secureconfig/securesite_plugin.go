@@ -116,7 +116,7 @@ func (ss *SecureSite) LoadSinglePluginPages(name string) {
 
 	toolkit := &ambient.Toolkit{
 		Mux:    recorder,
-		Render: pluginsafe.NewRenderer(ss.render),
+		Render: pluginsafe.NewRenderer(ss.render, ss.pluginsystem),
 		Site:   pss,
 		Log:    pluginsafe.NewPluginLogger(ss.log),
 	}
@@ -0,0 +1,42 @@
+package secureconfig
+
+import (
+	"github.com/ambientkit/ambient"
+	"github.com/ambientkit/ambient/pkg/amberror"
+)
+
+// PluginStoreGet returns a value from the private store of the plugin.
+func (ss *SecureSite) PluginStoreGet(key string) ([]byte, error) {
+	if !ss.Authorized(ambient.GrantPluginStorageRead) {
+		return nil, amberror.ErrAccessDenied
+	}
+
+	return ss.pluginsystem.PluginStoreGet(ss.pluginName, key)
+}
+
+// PluginStorePut writes a value to the private store of the plugin.
+func (ss *SecureSite) PluginStorePut(key string, value []byte) error {
+	if !ss.Authorized(ambient.GrantPluginStorageWrite) {
+		return amberror.ErrAccessDenied
+	}
+
+	return ss.pluginsystem.PluginStorePut(ss.pluginName, key, value)
+}
+
+// PluginStoreDelete removes a value from the private store of the plugin.
+func (ss *SecureSite) PluginStoreDelete(key string) error {
+	if !ss.Authorized(ambient.GrantPluginStorageWrite) {
+		return amberror.ErrAccessDenied
+	}
+
+	return ss.pluginsystem.PluginStoreDelete(ss.pluginName, key)
+}
+
+// PluginStoreList returns the keys in the private store of the plugin.
+func (ss *SecureSite) PluginStoreList() ([]string, error) {
+	if !ss.Authorized(ambient.GrantPluginStorageRead) {
+		return nil, amberror.ErrAccessDenied
+	}
+
+	return ss.pluginsystem.PluginStoreList(ss.pluginName)
+}
@@ -0,0 +1,62 @@
+package secureconfig_test
+
+import (
+	"testing"
+
+	"github.com/ambientkit/ambient"
+	"github.com/ambientkit/ambient/internal/config"
+	"github.com/ambientkit/ambient/internal/secureconfig"
+	"github.com/ambientkit/ambient/pkg/amberror"
+	"github.com/ambientkit/ambient/pkg/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPluginStore(t *testing.T) {
+	log, err := mock.NewLoggerPlugin(nil).Logger("test", "1.0", nil)
+	assert.NoError(t, err)
+
+	storage, err := config.NewStorage(log, config.NewBlobStorer(mock.NewMemoryStore()), ambient.StoragePluginGroup{})
+	assert.NoError(t, err)
+	ps, err := config.NewPluginSystem(log, storage, &ambient.PluginLoader{
+		Plugins: []ambient.Plugin{
+			mock.NewPlugin("plugina", "1.0.0"),
+			mock.NewPlugin("pluginb", "1.0.0"),
+		},
+	})
+	assert.NoError(t, err)
+
+	for _, name := range []string{"plugina", "pluginb"} {
+		assert.NoError(t, ps.SetGrant(name, ambient.GrantPluginStorageRead))
+		assert.NoError(t, ps.SetGrant(name, ambient.GrantPluginStorageWrite))
+	}
+
+	a, _, err := secureconfig.NewSecureSite("plugina", log, ps, nil, nil, nil, nil, false)
+	assert.NoError(t, err)
+	b, _, err := secureconfig.NewSecureSite("pluginb", log, ps, nil, nil, nil, nil, false)
+	assert.NoError(t, err)
+
+	assert.Equal(t, amberror.ErrPluginStoreKey, a.PluginStorePut("", []byte("secret")))
+	assert.NoError(t, a.PluginStorePut("token", []byte("secret")))
+	value, err := a.PluginStoreGet("token")
+	assert.NoError(t, err)
+	assert.Equal(t, "secret", string(value))
+
+	// Another plugin can't see the keys.
+	_, err = b.PluginStoreGet("token")
+	assert.Equal(t, amberror.ErrNotFound, err)
+	keys, err := b.PluginStoreList()
+	assert.NoError(t, err)
+	assert.Empty(t, keys)
+
+	// The store is not part of the site.
+	assert.Empty(t, ps.PluginsData()["plugina"].Settings)
+
+	assert.NoError(t, a.PluginStoreDelete("token"))
+	keys, err = a.PluginStoreList()
+	assert.NoError(t, err)
+	assert.Empty(t, keys)
+
+	// Access requires the grant.
+	assert.NoError(t, ps.RemoveGrant("pluginb", ambient.GrantPluginStorageWrite))
+	assert.Equal(t, amberror.ErrAccessDenied, b.PluginStorePut("token", []byte("secret")))
+}
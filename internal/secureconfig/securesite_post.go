@@ -55,6 +55,35 @@ func (ss *SecureSite) PostBySlug(slug string) (ambient.PostWithID, error) {
 	return post, nil
 }
 
+// SearchPosts returns a page of the published posts and pages that match the
+// query with the best match first. A limit of 0 returns all of the matches.
+func (ss *SecureSite) SearchPosts(query string, limit int, offset int) (ambient.PostSearchResults, error) {
+	if !ss.Authorized(ambient.GrantSitePostSearch) {
+		return ambient.PostSearchResults{}, amberror.ErrAccessDenied
+	}
+
+	return ss.pluginsystem.SearchPosts(query, true, limit, offset), nil
+}
+
+// QueryPosts returns a page of the posts that match the query.
+func (ss *SecureSite) QueryPosts(q ambient.PostQuery) (ambient.PostQueryResult, error) {
+	if !ss.Authorized(ambient.GrantSitePostRead) {
+		return ambient.PostQueryResult{}, amberror.ErrAccessDenied
+	}
+
+	return ss.pluginsystem.QueryPosts(q)
+}
+
+// ContentTypes returns the content types with the custom fields from the
+// enabled plugins sorted by name.
+func (ss *SecureSite) ContentTypes() ([]ambient.ContentType, error) {
+	if !ss.Authorized(ambient.GrantSitePostRead) {
+		return nil, amberror.ErrAccessDenied
+	}
+
+	return ss.pluginsystem.ContentTypes(), nil
+}
+
 // PostByID returns the post by ID.
 func (ss *SecureSite) PostByID(ID string) (ambient.Post, error) {
 	if !ss.Authorized(ambient.GrantSitePostRead) {
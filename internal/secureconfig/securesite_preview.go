@@ -0,0 +1,65 @@
+package secureconfig
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/ambientkit/ambient"
+	"github.com/ambientkit/ambient/pkg/amberror"
+)
+
+// CreatePostPreview returns a new preview of a post with the current user as
+// the author. The token in the preview allows anyone with it to view the post
+// until it expires or is revoked. The token expires after a week if the
+// duration is 0. Storage encryption must be set.
+func (ss *SecureSite) CreatePostPreview(r *http.Request, ID string, expires time.Duration) (ambient.PostPreview, error) {
+	if !ss.Authorized(ambient.GrantSitePostPreviewWrite) {
+		return ambient.PostPreview{}, amberror.ErrAccessDenied
+	}
+
+	return ss.pluginsystem.CreatePostPreview(ID, expires, ss.author(r))
+}
+
+// PostPreviews returns the previews of a post that have not expired with the
+// newest first. The tokens are not included.
+func (ss *SecureSite) PostPreviews(ID string) ([]ambient.PostPreview, error) {
+	if !ss.Authorized(ambient.GrantSitePostPreviewRead) {
+		return nil, amberror.ErrAccessDenied
+	}
+
+	return ss.pluginsystem.PostPreviews(ID)
+}
+
+// RevokePostPreview revokes the token of a post preview.
+func (ss *SecureSite) RevokePostPreview(ID string, previewID string) error {
+	if !ss.Authorized(ambient.GrantSitePostPreviewWrite) {
+		return amberror.ErrAccessDenied
+	}
+
+	return ss.pluginsystem.RevokePostPreview(ID, previewID)
+}
+
+// PreviewPostBySlug returns the post by slug for a request. The post is
+// returned if it's published or if the request has a valid preview token for
+// it in the query string.
+func (ss *SecureSite) PreviewPostBySlug(r *http.Request, slug string) (ambient.PostWithID, error) {
+	if !ss.Authorized(ambient.GrantSitePostRead) {
+		return ambient.PostWithID{}, amberror.ErrAccessDenied
+	}
+
+	if r != nil {
+		if token := r.URL.Query().Get(ambient.PreviewTokenParam); len(token) > 0 {
+			post, err := ss.pluginsystem.PreviewPost(token)
+			if err == nil && post.URL == slug {
+				return post, nil
+			}
+		}
+	}
+
+	post := ss.pluginsystem.PostBySlug(slug)
+	if post.ID == "" || !post.IsPublished(time.Now()) {
+		return ambient.PostWithID{}, amberror.ErrNotFound
+	}
+
+	return post, nil
+}
@@ -0,0 +1,71 @@
+package secureconfig
+
+import (
+	"net/http"
+
+	"github.com/ambientkit/ambient"
+	"github.com/ambientkit/ambient/pkg/amberror"
+)
+
+// SavePostAs saves a post and keeps a revision with the current user as the
+// author.
+func (ss *SecureSite) SavePostAs(r *http.Request, ID string, post ambient.Post) error {
+	if !ss.Authorized(ambient.GrantSitePostWrite) {
+		return amberror.ErrAccessDenied
+	}
+
+	return ss.pluginsystem.SavePostAs(ID, post, ss.author(r))
+}
+
+// PostRevisions returns the revisions of a post with the newest first.
+func (ss *SecureSite) PostRevisions(ID string) ([]ambient.PostRevision, error) {
+	if !ss.Authorized(ambient.GrantSitePostRevisionRead) {
+		return nil, amberror.ErrAccessDenied
+	}
+
+	return ss.pluginsystem.PostRevisions(ID)
+}
+
+// PostRevision returns a revision of a post.
+func (ss *SecureSite) PostRevision(ID string, revisionID string) (ambient.PostRevision, error) {
+	if !ss.Authorized(ambient.GrantSitePostRevisionRead) {
+		return ambient.PostRevision{}, amberror.ErrAccessDenied
+	}
+
+	return ss.pluginsystem.PostRevision(ID, revisionID)
+}
+
+// PostRevisionDiff returns a unified diff of the title, tags, custom fields,
+// and content from a revision to the current post.
+func (ss *SecureSite) PostRevisionDiff(ID string, revisionID string) (string, error) {
+	if !ss.Authorized(ambient.GrantSitePostRevisionRead) {
+		return "", amberror.ErrAccessDenied
+	}
+
+	return ss.pluginsystem.PostRevisionDiff(ID, revisionID)
+}
+
+// RestorePostRevision saves the post from a revision with the current user as
+// the author.
+func (ss *SecureSite) RestorePostRevision(r *http.Request, ID string, revisionID string) error {
+	if !ss.Authorized(ambient.GrantSitePostRevisionWrite) {
+		return amberror.ErrAccessDenied
+	}
+
+	return ss.pluginsystem.RestorePostRevision(ID, revisionID, ss.author(r))
+}
+
+// author returns the username of the current user or an empty string if the
+// user is not logged in.
+func (ss *SecureSite) author(r *http.Request) string {
+	if ss.sess == nil || r == nil {
+		return ""
+	}
+
+	username, err := ss.sess.AuthenticatedUser(r)
+	if err != nil {
+		return ""
+	}
+
+	return username
+}
@@ -60,6 +60,38 @@ func (ss *SecureSite) LogoutAllUsers(r *http.Request) error {
 	return nil
 }
 
+// UserSessions returns the sessions for a user. Returns
+// amberror.ErrUserSessionsNotSupported if the session manager doesn't support
+// it.
+func (ss *SecureSite) UserSessions(r *http.Request, username string) ([]ambient.UserSession, error) {
+	if !ss.Authorized(ambient.GrantAllUserAuthenticatedRead) {
+		return nil, amberror.ErrAccessDenied
+	}
+
+	us, ok := ss.sess.(ambient.AppUserSessions)
+	if !ok {
+		return nil, amberror.ErrUserSessionsNotSupported
+	}
+
+	return us.UserSessions(r, username)
+}
+
+// LogoutUser logs out all of the sessions for a user. Returns
+// amberror.ErrUserSessionsNotSupported if the session manager doesn't support
+// it.
+func (ss *SecureSite) LogoutUser(r *http.Request, username string) error {
+	if !ss.Authorized(ambient.GrantAllUserAuthenticatedWrite) {
+		return amberror.ErrAccessDenied
+	}
+
+	us, ok := ss.sess.(ambient.AppUserSessions)
+	if !ok {
+		return amberror.ErrUserSessionsNotSupported
+	}
+
+	return us.LogoutUser(r, username)
+}
+
 // SetCSRF sets the session with a token and returns the token for use in a form
 // or header.
 func (ss *SecureSite) SetCSRF(r *http.Request) string {
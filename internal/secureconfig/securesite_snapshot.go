@@ -0,0 +1,92 @@
+package secureconfig
+
+import (
+	"strings"
+
+	"github.com/ambientkit/ambient"
+	"github.com/ambientkit/ambient/pkg/amberror"
+)
+
+// redactedValue replaces a value that the plugin can't read.
+const redactedValue = "[redacted]"
+
+// Snapshots returns the list of site snapshots with the newest first.
+func (ss *SecureSite) Snapshots() ([]ambient.Snapshot, error) {
+	if !ss.Authorized(ambient.GrantSiteSnapshotRead) {
+		return nil, amberror.ErrAccessDenied
+	}
+
+	return ss.pluginsystem.Snapshots()
+}
+
+// CreateSnapshot stores a copy of the current site.
+func (ss *SecureSite) CreateSnapshot() (ambient.Snapshot, error) {
+	if !ss.Authorized(ambient.GrantSiteSnapshotWrite) {
+		return ambient.Snapshot{}, amberror.ErrAccessDenied
+	}
+
+	return ss.pluginsystem.CreateSnapshot()
+}
+
+// SnapshotDiff returns the changes that restoring a snapshot would make. The
+// settings of other plugins are redacted unless the plugin can read them.
+func (ss *SecureSite) SnapshotDiff(ID string) ([]ambient.SiteChange, error) {
+	if !ss.Authorized(ambient.GrantSiteSnapshotRead) {
+		return nil, amberror.ErrAccessDenied
+	}
+
+	changes, err := ss.pluginsystem.SnapshotDiff(ID)
+	if err != nil || ss.Authorized(ambient.GrantPluginNeighborSettingRead) {
+		return changes, err
+	}
+
+	for i, change := range changes {
+		path := strings.Split(change.Path, ".")
+		changes[i].From = ss.redactSettings(path, change.From)
+		changes[i].To = ss.redactSettings(path, change.To)
+	}
+
+	return changes, nil
+}
+
+// redactSettings returns the value at the path with the settings of the
+// other plugins replaced. The plugin data is at: plugins.name.settings
+func (ss *SecureSite) redactSettings(path []string, v interface{}) interface{} {
+	if len(path) >= 3 && path[0] == "plugins" && path[2] == "settings" {
+		if path[1] == ss.pluginName || v == nil {
+			return v
+		}
+		return redactedValue
+	}
+
+	m, ok := v.(map[string]interface{})
+	if !ok || len(path) >= 3 {
+		return v
+	}
+
+	out := make(map[string]interface{}, len(m))
+	for k, child := range m {
+		out[k] = ss.redactSettings(append(path[:len(path):len(path)], k), child)
+	}
+
+	return out
+}
+
+// RestoreSnapshot replaces the site with a snapshot and then enables or
+// disables the plugins that changed.
+func (ss *SecureSite) RestoreSnapshot(ID string) error {
+	if !ss.Authorized(ambient.GrantSiteSnapshotWrite) {
+		return amberror.ErrAccessDenied
+	}
+
+	before := ss.pluginStates()
+
+	err := ss.pluginsystem.RestoreSnapshot(ID)
+	if err != nil {
+		return err
+	}
+
+	ss.applyPluginStates(before)
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package secureconfig_test
+
+import (
+	"testing"
+
+	"github.com/ambientkit/ambient"
+	"github.com/ambientkit/ambient/internal/config"
+	"github.com/ambientkit/ambient/internal/secureconfig"
+	"github.com/ambientkit/ambient/pkg/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnapshotDiffRedactsSettings(t *testing.T) {
+	log, err := mock.NewLoggerPlugin(nil).Logger("test", "1.0", nil)
+	assert.NoError(t, err)
+
+	storage, err := config.NewStorage(log, config.NewBlobStorer(mock.NewMemoryStore()), ambient.StoragePluginGroup{
+		Snapshots: &ambient.SnapshotPolicy{
+			Store:    config.NewBlobStorer(mock.NewMemoryStore()),
+			Interval: -1,
+		},
+	})
+	assert.NoError(t, err)
+	ps, err := config.NewPluginSystem(log, storage, &ambient.PluginLoader{
+		Plugins: []ambient.Plugin{
+			mock.NewPlugin("plugina", "1.0.0"),
+			mock.NewPlugin("pluginb", "1.0.0"),
+		},
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, ps.SetGrant("plugina", ambient.GrantSiteSnapshotRead))
+
+	snapshot, err := ps.CreateSnapshot()
+	assert.NoError(t, err)
+	assert.NoError(t, ps.SetSetting("plugina", "theme", "dark"))
+	assert.NoError(t, ps.SetSetting("pluginb", "password", "secret"))
+
+	a, _, err := secureconfig.NewSecureSite("plugina", log, ps, nil, nil, nil, nil, false)
+	assert.NoError(t, err)
+
+	values := func() map[string]interface{} {
+		changes, err := a.SnapshotDiff(snapshot.ID)
+		assert.NoError(t, err)
+		m := make(map[string]interface{})
+		for _, change := range changes {
+			m[change.Path] = change.From
+		}
+		return m
+	}
+
+	// The plugin can see its own settings, but not the other plugin's.
+	assert.Equal(t, map[string]interface{}{
+		"plugins.plugina.settings.theme":    "dark",
+		"plugins.pluginb.settings.password": "[redacted]",
+	}, values())
+
+	// The neighbor settings grant allows reading them.
+	assert.NoError(t, ps.SetGrant("plugina", ambient.GrantPluginNeighborSettingRead))
+	assert.Equal(t, "secret", values()["plugins.pluginb.settings.password"])
+}
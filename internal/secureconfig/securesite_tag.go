@@ -0,0 +1,61 @@
+package secureconfig
+
+import (
+	"github.com/ambientkit/ambient"
+	"github.com/ambientkit/ambient/pkg/amberror"
+)
+
+// TagDetails returns the tags with their description, slug, and the number of
+// posts with each tag sorted by name.
+func (ss *SecureSite) TagDetails(onlyPublished bool) ([]ambient.TagDetail, error) {
+	if !ss.Authorized(ambient.GrantSitePostRead) {
+		return nil, amberror.ErrAccessDenied
+	}
+
+	return ss.pluginsystem.TagDetails(onlyPublished), nil
+}
+
+// TagBySlug returns the tag with the slug.
+func (ss *SecureSite) TagBySlug(slug string, onlyPublished bool) (ambient.TagDetail, error) {
+	if !ss.Authorized(ambient.GrantSitePostRead) {
+		return ambient.TagDetail{}, amberror.ErrAccessDenied
+	}
+
+	return ss.pluginsystem.TagBySlug(slug, onlyPublished)
+}
+
+// RenameTag renames a tag on every post.
+func (ss *SecureSite) RenameTag(name string, newName string) error {
+	if !ss.Authorized(ambient.GrantSiteTagWrite) {
+		return amberror.ErrAccessDenied
+	}
+
+	return ss.pluginsystem.RenameTag(name, newName)
+}
+
+// MergeTags replaces the tag with the other tag on every post.
+func (ss *SecureSite) MergeTags(name string, into string) error {
+	if !ss.Authorized(ambient.GrantSiteTagWrite) {
+		return amberror.ErrAccessDenied
+	}
+
+	return ss.pluginsystem.MergeTags(name, into)
+}
+
+// DeleteTag removes a tag from every post.
+func (ss *SecureSite) DeleteTag(name string) error {
+	if !ss.Authorized(ambient.GrantSiteTagWrite) {
+		return amberror.ErrAccessDenied
+	}
+
+	return ss.pluginsystem.DeleteTag(name)
+}
+
+// SetTagMeta sets the description and slug of a tag.
+func (ss *SecureSite) SetTagMeta(name string, meta ambient.TagMeta) error {
+	if !ss.Authorized(ambient.GrantSiteTagWrite) {
+		return amberror.ErrAccessDenied
+	}
+
+	return ss.pluginsystem.SetTagMeta(name, meta)
+}
@@ -0,0 +1,41 @@
+package ambient
+
+// The content types that are built in. A plugin can add fields to them by
+// registering a content type with the same name.
+const (
+	ContentTypePost = "post"
+	ContentTypePage = "page"
+)
+
+// ContentType is a kind of post with its own custom fields.
+type ContentType struct {
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	Fields      []Field `json:"fields"`
+}
+
+// Field is a custom field on a post.
+type Field struct {
+	Name        string    `json:"name"`
+	Type        FieldType `json:"type"`
+	Description string    `json:"description"`
+	Required    bool      `json:"required"`
+}
+
+// FieldType is the type of value of a custom field.
+type FieldType string
+
+const (
+	// FieldText is a single line of text.
+	FieldText FieldType = "text"
+	// FieldTextarea is text that can have more than one line.
+	FieldTextarea FieldType = "textarea"
+	// FieldNumber is a number.
+	FieldNumber FieldType = "number"
+	// FieldBool is true or false.
+	FieldBool FieldType = "bool"
+	// FieldDate is a time in RFC 3339 format.
+	FieldDate FieldType = "date"
+	// FieldURL is an absolute http or https URL or a relative path.
+	FieldURL FieldType = "url"
+)
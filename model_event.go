@@ -0,0 +1,45 @@
+package ambient
+
+import (
+	"time"
+)
+
+// EventType is a type of change to the site.
+type EventType string
+
+const (
+	// EventPostSaved is sent when a post is saved.
+	EventPostSaved EventType = "post.saved"
+	// EventPostDeleted is sent when a post is deleted.
+	EventPostDeleted EventType = "post.deleted"
+	// EventPostPublished is sent when a scheduled post is published.
+	EventPostPublished EventType = "post.published"
+	// EventPostUnpublished is sent when a scheduled post is unpublished.
+	EventPostUnpublished EventType = "post.unpublished"
+	// EventSiteChanged is sent when the site title, scheme, URL, or content
+	// changes.
+	EventSiteChanged EventType = "site.changed"
+	// EventSiteReloaded is sent when the site is read again from storage so
+	// any item may have changed.
+	EventSiteReloaded EventType = "site.reloaded"
+	// EventTagChanged is sent when a tag is renamed, merged, or deleted on
+	// the posts or when the tag metadata changes.
+	EventTagChanged EventType = "tag.changed"
+	// EventMenuChanged is sent when a menu is saved or deleted.
+	EventMenuChanged EventType = "menu.changed"
+	// EventSettingChanged is sent when a plugin setting changes.
+	EventSettingChanged EventType = "setting.changed"
+	// EventPluginEnabled is sent when a plugin is enabled.
+	EventPluginEnabled EventType = "plugin.enabled"
+	// EventPluginDisabled is sent when a plugin is disabled.
+	EventPluginDisabled EventType = "plugin.disabled"
+)
+
+// Event represents a change to the site.
+type Event struct {
+	Type   EventType `json:"type"`
+	ID     string    `json:"id"`     // Post ID for post events.
+	Plugin string    `json:"plugin"` // Plugin name for setting and plugin events.
+	Name   string    `json:"name"`   // Setting name, menu name, tag name, or site field like: title, scheme, url, content.
+	Time   time.Time `json:"time"`
+}
@@ -0,0 +1,25 @@
+package ambient
+
+// Menu is a named list of links for the site navigation.
+type Menu struct {
+	Name  string     `json:"name"`
+	Items []MenuItem `json:"items"`
+}
+
+// MenuItem is a link in a menu to a post, a route, or an external URL. Only
+// one of PostID and URL should be set.
+type MenuItem struct {
+	Title    string     `json:"title"`    // Title of the link, defaults to the post title for a post link.
+	PostID   string     `json:"postid"`   // ID of the post to link to.
+	URL      string     `json:"url"`      // Route like /about or external URL like https://example.com.
+	Children []MenuItem `json:"children"` // Links nested under the link.
+}
+
+// MenuLink is a menu item with the URL resolved so it can be rendered. Links
+// to posts that are missing or not published are left out.
+type MenuLink struct {
+	Title    string     `json:"title"`
+	URL      string     `json:"url"`
+	External bool       `json:"external"` // True if the URL is on another site.
+	Children []MenuLink `json:"children"`
+}
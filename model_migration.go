@@ -0,0 +1,17 @@
+package ambient
+
+// MigrationStep represents a single schema migration.
+type MigrationStep struct {
+	Version     int    `json:"version"`
+	Description string `json:"description"`
+}
+
+// MigrationReport represents the schema migrations run on the stored site.
+type MigrationReport struct {
+	From    int             `json:"from"`    // Schema version of the stored site.
+	To      int             `json:"to"`      // Schema version after the migrations.
+	Steps   []MigrationStep `json:"steps"`   // Migrations in the order they run.
+	Changes []SiteChange    `json:"changes"` // Changes made by the migrations.
+	Backup  string          `json:"backup"`  // Key of the backup in the backups collection, empty on a dry run.
+	DryRun  bool            `json:"dryrun"`  // True if the migrations were not saved and the site can't be written.
+}
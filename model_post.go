@@ -1,21 +1,84 @@
 package ambient
 
 import (
+	"os"
 	"time"
 )
 
+// ScheduleTimeFormat is the layout of a schedule time without a time zone
+// like the value of an HTML datetime-local input.
+const ScheduleTimeFormat = "2006-01-02T15:04"
+
 // Post -
 type Post struct {
-	Title     string    `json:"title"`
-	URL       string    `json:"url"`
-	Canonical string    `json:"canonical"`
-	Created   time.Time `json:"created"`
-	Updated   time.Time `json:"updated"`
-	Timestamp time.Time `json:"timestamp"`
-	Content   string    `json:"content"`
-	Published bool      `json:"published"`
-	Page      bool      `json:"page"`
-	Tags      TagList   `json:"tags"`
+	Title       string     `json:"title"`
+	URL         string     `json:"url"`
+	Canonical   string     `json:"canonical"`
+	Created     time.Time  `json:"created"`
+	Updated     time.Time  `json:"updated"`
+	Timestamp   time.Time  `json:"timestamp"`
+	Content     string     `json:"content"`
+	Published   bool       `json:"published"`
+	PublishAt   time.Time  `json:"publishat"`   // Publish the post at this time if set and published.
+	UnpublishAt time.Time  `json:"unpublishat"` // Unpublish the post at this time if set.
+	Page        bool       `json:"page"`
+	Tags        TagList    `json:"tags"`
+	Author      string     `json:"author"`   // Username of the user that first saved the post, empty if unknown.
+	Type        string     `json:"type"`     // Name of the content type, empty for a post or page.
+	Fields      PostFields `json:"fields"`   // Values of the custom fields by name.
+	ParentID    string     `json:"parentid"` // ID of the parent page, empty for a top level page.
+	Order       int        `json:"order"`    // Sort order of the page among the pages with the same parent.
+}
+
+// PostFields are the values of the custom fields on a post.
+type PostFields map[string]interface{}
+
+// ContentType returns the name of the content type of the post.
+func (p Post) ContentType() string {
+	if len(p.Type) > 0 {
+		return p.Type
+	} else if p.Page {
+		return ContentTypePage
+	}
+
+	return ContentTypePost
+}
+
+// IsPublished returns true if the post is published at the time. Published
+// turns the post on and the schedule limits when it's shown: a post isn't
+// published before the publish time or after the unpublish time if they are
+// set.
+func (p Post) IsPublished(now time.Time) bool {
+	if !p.Published {
+		return false
+	}
+
+	if !p.PublishAt.IsZero() && now.Before(p.PublishAt) {
+		return false
+	}
+
+	if !p.UnpublishAt.IsZero() && !now.Before(p.UnpublishAt) {
+		return false
+	}
+
+	return true
+}
+
+// ParseScheduleTime returns the time for a schedule time without a time zone
+// in the ScheduleTimeFormat. The time is read in the time zone from the
+// AMB_TIMEZONE environment variable or in local time if it's not set. Use it
+// to set PublishAt and UnpublishAt from a form.
+func ParseScheduleTime(value string) (time.Time, error) {
+	loc := time.Local
+	if tz := os.Getenv("AMB_TIMEZONE"); len(tz) > 0 {
+		var err error
+		loc, err = time.LoadLocation(tz)
+		if err != nil {
+			return time.Time{}, err
+		}
+	}
+
+	return time.ParseInLocation(ScheduleTimeFormat, value, loc)
 }
 
 // PostWithID -
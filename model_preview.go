@@ -0,0 +1,20 @@
+package ambient
+
+import (
+	"time"
+)
+
+// PreviewTokenParam is the query string parameter that holds the preview
+// token on a request for an unpublished post.
+const PreviewTokenParam = "preview"
+
+// PostPreview represents a token that allows anyone with it to view a post
+// before it's published.
+type PostPreview struct {
+	ID      string    `json:"id"`
+	PostID  string    `json:"postid"`
+	Author  string    `json:"author"` // Username of the user that created the preview, empty if unknown.
+	Created time.Time `json:"created"`
+	Expires time.Time `json:"expires"`
+	Token   string    `json:"token,omitempty"` // Only set when the preview is created.
+}
@@ -0,0 +1,65 @@
+package ambient
+
+import (
+	"time"
+)
+
+// PostKind is the kind of post to return from a query.
+type PostKind string
+
+const (
+	// PostKindAll returns posts, pages, and the other content types.
+	PostKindAll PostKind = ""
+	// PostKindPost returns only posts without another content type.
+	PostKindPost PostKind = "post"
+	// PostKindPage returns only pages without another content type.
+	PostKindPage PostKind = "page"
+)
+
+// PostStatus is the published state of the posts to return from a query.
+type PostStatus string
+
+const (
+	// PostStatusAll returns published and unpublished posts.
+	PostStatusAll PostStatus = ""
+	// PostStatusPublished returns only posts that are published now.
+	PostStatusPublished PostStatus = "published"
+	// PostStatusUnpublished returns only posts that are not published now.
+	PostStatusUnpublished PostStatus = "unpublished"
+)
+
+// PostSort is the order of the posts returned from a query.
+type PostSort string
+
+const (
+	// PostSortNewest returns the newest posts first.
+	PostSortNewest PostSort = ""
+	// PostSortOldest returns the oldest posts first.
+	PostSortOldest PostSort = "oldest"
+	// PostSortTitle returns the posts by title.
+	PostSortTitle PostSort = "title"
+)
+
+// PostQuery filters, sorts, and pages the posts. The zero value returns all
+// of the posts and pages with the newest first.
+type PostQuery struct {
+	Tag    string     `json:"tag"`    // Only posts with the tag name if set.
+	Kind   PostKind   `json:"kind"`   // Posts, pages, or both.
+	Status PostStatus `json:"status"` // Published, unpublished, or both.
+	After  time.Time  `json:"after"`  // Only posts with a timestamp at or after the time if set.
+	Before time.Time  `json:"before"` // Only posts with a timestamp before the time if set.
+	Author string     `json:"author"` // Only posts by the username if set.
+	Type   string     `json:"type"`   // Only posts of the content type if set.
+	Fields PostFields `json:"fields"` // Only posts with each of the custom field values if set.
+	Sort   PostSort   `json:"sort"`
+	Limit  int        `json:"limit"`  // Returns all of the posts if 0.
+	Offset int        `json:"offset"` // Number of posts to skip.
+	Cursor string     `json:"cursor"` // Cursor from the previous result to return the posts after it.
+}
+
+// PostQueryResult is a page of posts from a query.
+type PostQueryResult struct {
+	Total  int            `json:"total"` // Number of matches before the limit, offset, and cursor.
+	Posts  PostWithIDList `json:"posts"`
+	Cursor string         `json:"cursor"` // Cursor for the next page, empty if there are no more posts.
+}
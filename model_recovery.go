@@ -0,0 +1,16 @@
+package ambient
+
+import "time"
+
+// RecoveryReport represents the fallback to a copy of the site after the
+// stored site could not be read.
+type RecoveryReport struct {
+	Error     string    `json:"error"`     // Error that caused the fallback.
+	Source    string    `json:"source"`    // Collection of the copy: snapshots or backups.
+	ID        string    `json:"id"`        // ID of the copy in the collection.
+	Revision  uint64    `json:"revision"`  // Revision of the copy.
+	Recovered []string  `json:"recovered"` // Items that were replaced with the copy.
+	Lost      []string  `json:"lost"`      // Items that could not be read or replaced, moved to the corrupt collection.
+	Skipped   []string  `json:"skipped"`   // Copies that could not be read.
+	Time      time.Time `json:"time"`
+}
@@ -0,0 +1,11 @@
+package ambient
+
+import "time"
+
+// ReplicaDivergence represents a store that doesn't match the other stores
+// because it failed or missed a write.
+type ReplicaDivergence struct {
+	Store string    `json:"store"` // Name of the store.
+	Error string    `json:"error"` // Error from the store or the reason it doesn't match.
+	Time  time.Time `json:"time"`
+}
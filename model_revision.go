@@ -0,0 +1,14 @@
+package ambient
+
+import (
+	"time"
+)
+
+// PostRevision represents a saved version of a post.
+type PostRevision struct {
+	ID      string    `json:"id"`
+	PostID  string    `json:"postid"`
+	Created time.Time `json:"created"`
+	Author  string    `json:"author"` // Username of the user that saved the post, empty if unknown.
+	Post    Post      `json:"post"`
+}
@@ -0,0 +1,14 @@
+package ambient
+
+// PostSearchResult is a post that matches a search query.
+type PostSearchResult struct {
+	PostWithID
+	Score float64 `json:"score"` // Higher is a better match.
+}
+
+// PostSearchResults is a page of posts that match a search query with the
+// best match first.
+type PostSearchResults struct {
+	Total   int                `json:"total"` // Number of matches before the limit and offset.
+	Results []PostSearchResult `json:"results"`
+}
@@ -0,0 +1,14 @@
+package ambient
+
+import (
+	"time"
+)
+
+// UserSession represents a session that belongs to a user. The ID is not the
+// session token so it's safe to show.
+type UserSession struct {
+	ID       string    `json:"id"`
+	Username string    `json:"username"`
+	Created  time.Time `json:"created"`
+	Expires  time.Time `json:"expires"`
+}
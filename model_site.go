@@ -8,13 +8,18 @@ import (
 
 // Site represents the site information that is in storage.
 type Site struct {
-	Title         string                `json:"title"`   // Title of the site.
-	Content       string                `json:"content"` // Home or default content.
-	Scheme        string                `json:"scheme"`  // http or https
-	URL           string                `json:"url"`     // URL without scheme and without trailing slash.
-	Updated       time.Time             `json:"updated"` // Save time the data was saved (not only changed).
-	Posts         map[string]Post       `json:"posts"`   // List of posts.
-	PluginStorage map[string]PluginData `json:"plugins"` // List of plugins, whether they are found, enabled, and what fields they support.
+	Title         string                `json:"title"`             // Title of the site.
+	Content       string                `json:"content"`           // Home or default content.
+	Scheme        string                `json:"scheme"`            // http or https
+	URL           string                `json:"url"`               // URL without scheme and without trailing slash.
+	Updated       time.Time             `json:"updated"`           // Save time the data was saved (not only changed).
+	Revision      uint64                `json:"revision"`          // Revision increments on every save to detect changes by another process.
+	SchemaVersion int                   `json:"schemaversion"`     // Version of the document format used to run migrations.
+	Scheduled     time.Time             `json:"scheduled"`         // Time the scheduled posts were last checked for changes.
+	Posts         map[string]Post       `json:"posts,omitempty"`   // List of posts.
+	PluginStorage map[string]PluginData `json:"plugins,omitempty"` // List of plugins, whether they are found, enabled, and what fields they support.
+	Menus         map[string]Menu       `json:"menus,omitempty"`   // List of navigation menus by name.
+	TagMeta       map[string]TagMeta    `json:"tagmeta,omitempty"` // List of tag descriptions and slugs by tag name.
 }
 
 // PluginData represents the plugin storage information.
@@ -50,9 +55,10 @@ func (s Site) SiteURL() string {
 
 // PublishedPosts returns published posts (no pages).
 func (s Site) PublishedPosts() []Post {
+	now := time.Now()
 	arr := make(PostList, 0)
 	for _, v := range s.Posts {
-		if v.Published && !v.Page {
+		if v.IsPublished(now) && !v.Page {
 			arr = append(arr, v)
 		}
 	}
@@ -64,9 +70,10 @@ func (s Site) PublishedPosts() []Post {
 
 // PublishedPages returns published pages (no posts).
 func (s Site) PublishedPages() []Post {
+	now := time.Now()
 	arr := make(PostList, 0)
 	for _, v := range s.Posts {
-		if v.Published && v.Page {
+		if v.IsPublished(now) && v.Page {
 			arr = append(arr, v)
 		}
 	}
@@ -78,9 +85,10 @@ func (s Site) PublishedPages() []Post {
 
 // PostsAndPages returns list of posts and pages with IDs.
 func (s Site) PostsAndPages(onlyPublished bool) PostWithIDList {
+	now := time.Now()
 	arr := make(PostWithIDList, 0)
 	for k, v := range s.Posts {
-		if onlyPublished && !v.Published {
+		if onlyPublished && !v.IsPublished(now) {
 			continue
 		}
 
@@ -96,9 +104,10 @@ func (s Site) PostsAndPages(onlyPublished bool) PostWithIDList {
 // Tags returns a list of tags.
 func (s Site) Tags(onlyPublished bool) TagList {
 	// Get unique values.
+	now := time.Now()
 	m := make(map[string]Tag)
 	for _, v := range s.Posts {
-		if onlyPublished && !v.Published {
+		if onlyPublished && !v.IsPublished(now) {
 			continue
 		}
 
@@ -119,9 +128,9 @@ func (s Site) Tags(onlyPublished bool) TagList {
 	return arr
 }
 
-// PostBySlug returns a post by slug/URL.
+// PostBySlug returns a post by slug/URL. It checks every post so the plugin
+// system uses an index instead.
 func (s Site) PostBySlug(slug string) PostWithID {
-	// TODO: This needs to be optimized.
 	var p PostWithID
 	for k, v := range s.Posts {
 		if v.URL == slug {
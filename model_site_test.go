@@ -2,6 +2,7 @@ package ambient_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/ambientkit/ambient"
 	"github.com/stretchr/testify/assert"
@@ -13,3 +14,42 @@ func TestSiteURL(t *testing.T) {
 	s.URL = "localhost"
 	assert.Equal(t, "http://localhost", s.SiteURL())
 }
+
+func TestSiteScheduledPosts(t *testing.T) {
+	now := time.Now()
+	s := new(ambient.Site)
+	s.Posts = map[string]ambient.Post{
+		"1": {Title: "Published", Published: true},
+		"2": {Title: "Draft"},
+		"3": {Title: "Scheduled", Published: true, PublishAt: now.Add(time.Hour)},
+		"4": {Title: "Due", Published: true, PublishAt: now.Add(-time.Hour)},
+		"5": {Title: "Expired", Published: true, UnpublishAt: now.Add(-time.Hour)},
+		"7": {Title: "Unpublished", PublishAt: now.Add(-time.Hour)},
+		"6": {Title: "Page", Page: true, Published: true, PublishAt: now.Add(-time.Hour), UnpublishAt: now.Add(time.Hour)},
+	}
+
+	titles := make([]string, 0)
+	for _, p := range s.PublishedPosts() {
+		titles = append(titles, p.Title)
+	}
+	assert.ElementsMatch(t, []string{"Published", "Due"}, titles)
+	assert.Len(t, s.PublishedPages(), 1)
+	assert.Len(t, s.PostsAndPages(true), 3)
+	assert.Len(t, s.PostsAndPages(false), 7)
+
+	assert.False(t, s.Posts["3"].IsPublished(now))
+	assert.True(t, s.Posts["3"].IsPublished(now.Add(2*time.Hour)))
+	assert.False(t, s.Posts["6"].IsPublished(now.Add(2*time.Hour)))
+	assert.False(t, s.Posts["7"].IsPublished(now))
+}
+
+func TestParseScheduleTime(t *testing.T) {
+	t.Setenv("AMB_TIMEZONE", "America/New_York")
+	at, err := ambient.ParseScheduleTime("2021-06-01T09:30")
+	assert.NoError(t, err)
+	assert.Equal(t, "2021-06-01T13:30:00Z", at.UTC().Format(time.RFC3339))
+
+	t.Setenv("AMB_TIMEZONE", "Nowhere/Bad")
+	_, err = ambient.ParseScheduleTime("2021-06-01T09:30")
+	assert.Error(t, err)
+}
@@ -0,0 +1,27 @@
+package ambient
+
+import (
+	"time"
+)
+
+// SnapshotPolicy represents where and how often point-in-time copies of the
+// site are stored.
+type SnapshotPolicy struct {
+	Store    KeyedDataStorer // Store for the snapshots.
+	Limit    int             // Number of snapshots to keep. Defaults to 10.
+	Interval time.Duration   // Minimum time between automatic snapshots. Defaults to 1 hour. A snapshot is taken after every save if negative.
+}
+
+// Snapshot represents a point-in-time copy of the site.
+type Snapshot struct {
+	ID       string    `json:"id"`
+	Created  time.Time `json:"created"`
+	Revision uint64    `json:"revision"`
+}
+
+// SiteChange represents a single difference between two versions of the site.
+type SiteChange struct {
+	Path string      `json:"path"` // Dot separated path to the field like: posts.id.title
+	From interface{} `json:"from"` // Value before the change, nil if added.
+	To   interface{} `json:"to"`   // Value after the change, nil if removed.
+}
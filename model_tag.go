@@ -24,6 +24,20 @@ type Tag struct {
 	Timestamp time.Time `json:"timestamp"`
 }
 
+// TagMeta is the metadata of a tag that is shared by every post with the tag.
+type TagMeta struct {
+	Slug        string `json:"slug"` // URL friendly name, generated from the tag name if empty.
+	Description string `json:"description"`
+}
+
+// TagDetail is a tag with its metadata and the number of posts with the tag.
+type TagDetail struct {
+	Name        string `json:"name"`
+	Slug        string `json:"slug"`
+	Description string `json:"description"`
+	Count       int    `json:"count"`
+}
+
 // String returns a comma separated list of tags.
 func (t TagList) String() string {
 	arr := make([]string, 0)
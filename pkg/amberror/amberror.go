@@ -1,7 +1,10 @@
 // Package amberror has shared errors across the application.
 package amberror
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
 	// ErrAccessDenied is when access is not allowed to the data item.
@@ -17,4 +20,153 @@ var (
 	// ErrSettingNotSpecified is when a setting is attempted to be set on a
 	// plugin, but the plugin didn't explicity specify it as a setting.
 	ErrSettingNotSpecified = errors.New("setting does not exist for the plugin")
+	// ErrConflict is when the data in storage was changed by another process
+	// after it was loaded.
+	ErrConflict = errors.New("storage data was changed by another process")
+	// ErrStorageCorrupt is when the data in storage doesn't match its checksum.
+	ErrStorageCorrupt = errors.New("storage data is corrupt")
+	// ErrStorageKey is when the data in storage can't be decrypted with the
+	// storage encryption key.
+	ErrStorageKey = errors.New("storage data could not be decrypted")
+	// ErrStorageFormat is when the data in storage can't be decoded.
+	ErrStorageFormat = errors.New("storage data could not be decoded")
+	// ErrSnapshotsDisabled is when a snapshot store is not set.
+	ErrSnapshotsDisabled = errors.New("storage snapshots are not enabled")
+	// ErrMigrationDryRun is when the site is written after the migrations were
+	// loaded with a dry run.
+	ErrMigrationDryRun = errors.New("storage migrations not applied because of dry run")
+	// ErrEncryptionDisabled is when the storage encryption is rotated or a
+	// preview token is created, but storage encryption is not set.
+	ErrEncryptionDisabled = errors.New("storage encryption is not enabled")
+	// ErrPluginStoreKey is when a plugin store key is empty.
+	ErrPluginStoreKey = errors.New("plugin store key is required")
+	// ErrWatchNotSupported is when the data storage can't be watched for
+	// changes.
+	ErrWatchNotSupported = errors.New("storage does not support watching for changes")
+	// ErrChangeTokenNotSupported is when the data storage can't return a
+	// token that changes after each write.
+	ErrChangeTokenNotSupported = errors.New("storage does not support change tokens")
+	// ErrSessionRewriteNotSupported is when the session storage can't replace
+	// the data of every session.
+	ErrSessionRewriteNotSupported = errors.New("session storage does not support rewriting sessions")
+	// ErrUserSessionsNotSupported is when the session manager can't list or
+	// log out the sessions of a user.
+	ErrUserSessionsNotSupported = errors.New("session manager does not support user sessions")
+	// ErrInvalidQuery is when a query has an option that isn't supported or a
+	// cursor that can't be read.
+	ErrInvalidQuery = errors.New("query is not valid")
+	// ErrInvalidToken is when a preview token is not signed with the key,
+	// has expired, or was revoked.
+	ErrInvalidToken = errors.New("preview token is not valid")
+	// ErrInvalidPost is when a post has a content type that isn't registered
+	// or a custom field that doesn't match its definition.
+	ErrInvalidPost = errors.New("post is not valid")
+	// ErrInvalidTag is when a tag name is empty, a tag is renamed to a name
+	// that is already used, or a tag slug is already used by another tag.
+	ErrInvalidTag = errors.New("tag is not valid")
+	// ErrInvalidMenu is when a menu doesn't have a name or has an item that
+	// doesn't link to exactly one post or URL.
+	ErrInvalidMenu = errors.New("menu is not valid")
 )
+
+// ConflictError is returned when the revision of the data in storage does not
+// match the revision that was loaded. Item is set when the same item was
+// changed to different values by this process and by another process so the
+// change can't be applied on top of the latest data.
+type ConflictError struct {
+	Expected uint64
+	Actual   uint64
+	Item     string
+}
+
+// Error returns the error message.
+func (e *ConflictError) Error() string {
+	if len(e.Item) > 0 {
+		return fmt.Sprintf("%v: %v was changed by another process in revision %v", ErrConflict.Error(), e.Item, e.Actual)
+	}
+	return fmt.Sprintf("%v: expected revision %v, found revision %v", ErrConflict.Error(), e.Expected, e.Actual)
+}
+
+// Is allows the error to match ErrConflict.
+func (e *ConflictError) Is(target error) bool {
+	return target == ErrConflict
+}
+
+// StorageCorruptError is returned when the data in storage is truncated or
+// doesn't match its checksum.
+type StorageCorruptError struct {
+	Err error
+}
+
+// Error returns the error message.
+func (e *StorageCorruptError) Error() string {
+	return fmt.Sprintf("%v: %v", ErrStorageCorrupt.Error(), e.Err)
+}
+
+// Is allows the error to match ErrStorageCorrupt.
+func (e *StorageCorruptError) Is(target error) bool {
+	return target == ErrStorageCorrupt
+}
+
+// Unwrap returns the underlying error.
+func (e *StorageCorruptError) Unwrap() error {
+	return e.Err
+}
+
+// StorageKeyError is returned when the data in storage is intact, but can't be
+// decrypted, usually because of the wrong key.
+type StorageKeyError struct {
+	Err error
+}
+
+// Error returns the error message.
+func (e *StorageKeyError) Error() string {
+	return fmt.Sprintf("%v: %v", ErrStorageKey.Error(), e.Err)
+}
+
+// Is allows the error to match ErrStorageKey.
+func (e *StorageKeyError) Is(target error) bool {
+	return target == ErrStorageKey
+}
+
+// Unwrap returns the underlying error.
+func (e *StorageKeyError) Unwrap() error {
+	return e.Err
+}
+
+// StorageFormatError is returned when the data in storage is intact and
+// decrypted, but can't be decoded, like invalid JSON.
+type StorageFormatError struct {
+	Err error
+}
+
+// Error returns the error message.
+func (e *StorageFormatError) Error() string {
+	return fmt.Sprintf("%v: %v", ErrStorageFormat.Error(), e.Err)
+}
+
+// Is allows the error to match ErrStorageFormat.
+func (e *StorageFormatError) Is(target error) bool {
+	return target == ErrStorageFormat
+}
+
+// Unwrap returns the underlying error.
+func (e *StorageFormatError) Unwrap() error {
+	return e.Err
+}
+
+// FieldError is returned when a custom field on a post is not valid.
+type FieldError struct {
+	Field  string
+	Reason string
+}
+
+// Error returns the error message.
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%v: field (%v) %v", ErrInvalidPost.Error(), e.Field, e.Reason)
+}
+
+// Is allows the error to match ErrInvalidPost.
+func (e *FieldError) Is(target error) bool {
+	return target == ErrInvalidPost
+}
@@ -1,11 +1,14 @@
 package ambientapp
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
+	"time"
 
 	"github.com/ambientkit/ambient"
 	"github.com/ambientkit/ambient/internal/config"
@@ -14,7 +17,9 @@ import (
 	"github.com/ambientkit/ambient/internal/injector"
 	"github.com/ambientkit/ambient/internal/pluginsafe"
 	"github.com/ambientkit/ambient/internal/secureconfig"
+	"github.com/ambientkit/ambient/pkg/amberror"
 	"github.com/ambientkit/ambient/pkg/envdetect"
+	"github.com/ambientkit/ambient/pkg/replica"
 	"github.com/ambientkit/ambient/pkg/requestuuid"
 )
 
@@ -23,17 +28,26 @@ type App struct {
 	log           ambient.AppLogger
 	pluginsystem  ambient.PluginSystem
 	grpcsystem    ambient.GRPCSystem
-	sessionstorer ambient.SessionStorer
+	sessionstorer *config.SessionStore
 	mux           ambient.AppRouter
 	renderer      ambient.Renderer
 	sess          ambient.AppSession
 	recorder      *pluginsafe.RouteRecorder
 	securesite    *secureconfig.SecureSite
+	replicas      []replicator
 
 	debugTemplates  bool
 	escapeTemplates bool
+	watchInterval   time.Duration
+	stopWatch       func()
+	sweepInterval   time.Duration
+	stopSweep       func()
+	stopSchedule    func()
 }
 
+// scheduleInterval is how often the scheduled posts are checked.
+const scheduleInterval = time.Minute
+
 // NewAppLogger returns a logger from Ambient without all the other dependencies.
 func NewAppLogger(appName string, appVersion string, logPlugin ambient.LoggingPlugin, logLevel ambient.LogLevel) (ambient.AppLogger, error) {
 	// Set the time zone. Required for plugins that rely on timzone like MFA.
@@ -87,7 +101,7 @@ func NewApp(appName string, appVersion string, logPlugin ambient.LoggingPlugin,
 	log = log.Named("ambient")
 
 	// Get the storage manager.
-	storage, sessionstorer, err := loadStorage(log, storagePluginGroup)
+	storage, sessionstorer, replicas, err := loadStorage(log, storagePluginGroup)
 	if err != nil {
 		return nil, log, err
 	}
@@ -111,7 +125,10 @@ func NewApp(appName string, appVersion string, logPlugin ambient.LoggingPlugin,
 		pluginsystem:    pluginsystem,
 		grpcsystem:      grpcsystem,
 		sessionstorer:   sessionstorer,
+		replicas:        replicas,
 		escapeTemplates: true,
+		watchInterval:   storagePluginGroup.WatchInterval,
+		sweepInterval:   storagePluginGroup.SessionSweepInterval,
 	}
 
 	// Enable the trusted plugins.
@@ -120,50 +137,198 @@ func NewApp(appName string, appVersion string, logPlugin ambient.LoggingPlugin,
 	return ambientApp, log, nil
 }
 
+// replicator is a storer that writes to mirrors of the storage.
+type replicator interface {
+	Divergence() []ambient.ReplicaDivergence
+	Check() ([]ambient.ReplicaDivergence, error)
+	Repair() error
+}
+
 // PluginSystem returns the plugin system.
 func (app *App) PluginSystem() ambient.PluginSystem {
 	return app.pluginsystem
 }
 
 // LoadStorage returns the storage.
-func loadStorage(log ambient.AppLogger, pluginGroup ambient.StoragePluginGroup) (*config.Storage, ambient.SessionStorer, error) {
+func loadStorage(log ambient.AppLogger, pluginGroup ambient.StoragePluginGroup) (*config.Storage, *config.SessionStore, []replicator, error) {
 	// Detect if storage plugin is missing.
 	if pluginGroup.Storage == nil {
-		return nil, nil, fmt.Errorf("ambient: storage plugin is missing")
-	}
-
-	plugin := pluginGroup.Storage
-
-	// Validate plugin name and version.
-	err := ambient.Validate(plugin)
-	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, fmt.Errorf("ambient: storage plugin is missing")
 	}
 
 	// Define the storage managers.
 	var ds ambient.DataStorer
 	var ss ambient.SessionStorer
+	var replicas []replicator
+
+	// Get the storage managers from the plugins. A mirror that fails is
+	// skipped so the site still loads.
+	dataMirrors := make([]ambient.DataStorer, 0)
+	sessionMirrors := make([]ambient.SessionStorer, 0)
+	for i, plugin := range append([]ambient.StoragePlugin{pluginGroup.Storage}, pluginGroup.Mirrors...) {
+		// Validate plugin name and version.
+		err := ambient.Validate(plugin)
+		if err != nil {
+			if i == 0 {
+				return nil, nil, nil, err
+			}
+			log.Error("skipping storage mirror: %v", err.Error())
+			continue
+		}
 
-	// Get the storage manager from the plugins.
-	pds, pss, err := plugin.Storage(log)
-	if err != nil {
-		log.Error(err.Error())
-	} else if pds != nil && pss != nil {
-		log.Info("using storage from first plugin: %v", plugin.PluginName())
-		ds = pds
-		ss = pss
+		pds, pss, err := plugin.Storage(log)
+		if err != nil {
+			log.Error(err.Error())
+			continue
+		} else if pds == nil || pss == nil {
+			continue
+		}
+
+		if i == 0 {
+			log.Info("using storage from first plugin: %v", plugin.PluginName())
+			ds = pds
+			ss = pss
+			continue
+		}
+
+		log.Info("using storage mirror from plugin: %v", plugin.PluginName())
+		dataMirrors = append(dataMirrors, pds)
+		sessionMirrors = append(sessionMirrors, pss)
 	}
 	if ds == nil || ss == nil {
-		return nil, nil, fmt.Errorf("ambient: no storage manager found")
+		return nil, nil, nil, fmt.Errorf("ambient: no storage manager found")
+	}
+
+	// Use keyed storage if the storage plugin supports it, else store all of
+	// the collections in a single object.
+	kds, ok := ds.(ambient.KeyedDataStorer)
+	if ok && len(dataMirrors) > 0 {
+		// Write every item to the mirrors as well. Mirrors that can't store
+		// items by key store all of the collections in a single object.
+		keyedMirrors := make([]ambient.KeyedDataStorer, 0, len(dataMirrors))
+		for _, m := range dataMirrors {
+			km, ok := m.(ambient.KeyedDataStorer)
+			if !ok {
+				km = config.NewBlobStorer(m)
+			}
+			keyedMirrors = append(keyedMirrors, km)
+		}
+
+		dr := replica.NewKeyed(log.Named("datareplica"), kds, keyedMirrors...)
+		dr.SetCollections(config.StorageCollections)
+		sr := replicateSessions(log, ss, sessionMirrors)
+
+		// Compare the mirrors since a new mirror or a mirror that missed
+		// writes before a restart isn't known to be divergent.
+		div, err := dr.Check()
+		if err != nil {
+			log.Warn("could not check the storage mirrors: %v", err.Error())
+		} else if len(div) > 0 {
+			log.Warn("%v storage mirrors don't match the storage and need to be repaired", len(div))
+		}
+		replicas = append(replicas, dr, sr)
+		kds = dr
+		ss = sr
+	} else if !ok {
+		// Write every change to the mirrors as well.
+		if len(dataMirrors) > 0 {
+			dr := replica.New(log.Named("datareplica"), ds, dataMirrors...)
+			sr := replicateSessions(log, ss, sessionMirrors)
+			replicas = append(replicas, dr, sr)
+			ds = dr
+			ss = sr
+		}
+
+		kds = config.NewBlobStorer(ds)
+	}
+
+	// Encrypt the session storage with the session encryption if set, else
+	// with the site encryption.
+	sessionEncryption := pluginGroup.SessionEncryption
+	if sessionEncryption == nil {
+		sessionEncryption = pluginGroup.Encryption
+	}
+	sessionstore := config.NewSessionStore(log.Named("sessionstorer"), ss, sessionEncryption)
+
+	// Only report the schema migrations if set.
+	if envdetect.MigrationDryRun() {
+		pluginGroup.MigrationDryRun = true
 	}
 
 	// Set up the data storage provider.
-	storage, err := config.NewStorage(log, ds, pluginGroup.Encryption)
+	storage, err := config.NewStorage(log, kds, pluginGroup)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
+	}
+
+	return storage, sessionstore, replicas, err
+}
+
+// sessionReplicator is a session storer that writes to mirrors.
+type sessionReplicator interface {
+	ambient.SessionStorer
+	replicator
+}
+
+// replicateSessions returns a session storer that writes to the mirrors as
+// well. Sessions are written one at a time if the session storage supports
+// it.
+func replicateSessions(log ambient.AppLogger, ss ambient.SessionStorer, mirrors []ambient.SessionStorer) sessionReplicator {
+	if _, ok := ss.(ambient.KeyedSessionStorer); ok {
+		return replica.NewKeyedSession(log.Named("sessionreplica"), ss, mirrors...)
+	}
+
+	dm := make([]ambient.DataStorer, 0, len(mirrors))
+	for _, m := range mirrors {
+		dm = append(dm, m)
+	}
+
+	return replica.New(log.Named("sessionreplica"), ss, dm...)
+}
+
+// StorageDivergence returns the storage mirrors that failed or missed a write
+// since they were last repaired.
+func (app *App) StorageDivergence() []ambient.ReplicaDivergence {
+	arr := make([]ambient.ReplicaDivergence, 0)
+	for _, r := range app.replicas {
+		arr = append(arr, r.Divergence()...)
 	}
 
-	return storage, ss, err
+	return arr
+}
+
+// RepairStorage writes any pending changes and then copies the storage to the
+// mirrors that don't match it.
+func (app *App) RepairStorage() error {
+	err := app.pluginsystem.Flush()
+	if err != nil {
+		return err
+	}
+
+	for _, r := range app.replicas {
+		_, err = r.Check()
+		if err != nil {
+			return err
+		}
+
+		err = r.Repair()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RotateEncryption re-encrypts the site storage and the session storage (if
+// supported) with the current encryption key.
+func (app *App) RotateEncryption() error {
+	err := app.pluginsystem.RotateEncryption()
+	if err != nil {
+		return err
+	}
+
+	return app.sessionstorer.RotateEncryption()
 }
 
 // StopGRPCClients stops the gRPC plugins.
@@ -235,10 +400,19 @@ func (app *App) Handler() (http.Handler, error) {
 	// Start monitoring with the ability to restart/reload plugin.
 	app.grpcsystem.Monitor(app.securesite)
 
+	// Reload the storage when it's changed by another process.
+	app.watchStorage()
+
+	// Remove the expired sessions.
+	app.sweepSessions()
+
+	// Publish and unpublish the scheduled posts.
+	app.schedulePosts()
+
 	// Start Dev Console if enabled via environment variable.
 	if envdetect.DevConsoleEnabled() {
 		// TODO: Should probably store in an object that can be edited by system.
-		dc := devconsole.NewDevConsole(app.log.Named("devconsole"), app.pluginsystem, app.pluginsystem.StorageManager(), app.securesite)
+		dc := devconsole.NewDevConsole(app.log.Named("devconsole"), app.pluginsystem, app.pluginsystem.StorageManager(), app.securesite, app, app, app.sessionstorer)
 		dc.EnableDevConsole()
 	}
 
@@ -246,8 +420,105 @@ func (app *App) Handler() (http.Handler, error) {
 	return requestuuid.Middleware(handler), nil
 }
 
+// watchStorage reloads the site when the storage is changed by another
+// process. The storage is watched if supported, else it's checked on the
+// watch interval if set.
+func (app *App) watchStorage() {
+	reload := func() {
+		_, err := secureconfig.ReloadChangedStorage(app.securesite)
+		if err != nil {
+			app.log.Error("could not reload storage: %v", err.Error())
+		}
+	}
+
+	stop, err := app.pluginsystem.StorageManager().Watch(reload)
+	if err == nil {
+		app.log.Info("watching storage for changes")
+		app.stopWatch = stop
+		return
+	} else if !errors.Is(err, amberror.ErrWatchNotSupported) {
+		app.log.Error("could not watch storage: %v", err.Error())
+	}
+
+	if app.watchInterval <= 0 {
+		return
+	}
+
+	app.log.Info("checking storage for changes every: %v", app.watchInterval)
+	app.stopWatch = every(app.watchInterval, reload)
+}
+
+// sweepSessions removes the expired sessions on the sweep interval if set.
+func (app *App) sweepSessions() {
+	if app.sweepInterval <= 0 {
+		return
+	}
+
+	app.log.Info("removing expired sessions every: %v", app.sweepInterval)
+	app.stopSweep = every(app.sweepInterval, func() {
+		removed, err := app.sessionstorer.Sweep()
+		if err != nil {
+			app.log.Error("could not remove expired sessions: %v", err.Error())
+		} else if removed > 0 {
+			app.log.Debug("removed expired sessions: %v", removed)
+		}
+	})
+}
+
+// schedulePosts publishes and unpublishes the scheduled posts when they are
+// due.
+func (app *App) schedulePosts() {
+	publish := func() {
+		err := app.pluginsystem.PublishScheduledPosts(time.Now())
+		if err != nil {
+			app.log.Error("could not publish scheduled posts: %v", err.Error())
+		}
+	}
+
+	// Catch up on the posts that were due while the app was stopped.
+	publish()
+	app.stopSchedule = every(scheduleInterval, publish)
+}
+
+// every runs the function on the interval until the stop function is called.
+func every(interval time.Duration, fn func()) (stop func()) {
+	done := make(chan struct{})
+	ticker := time.NewTicker(interval)
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				fn()
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			close(done)
+		})
+	}
+}
+
 // GrantAccess grants access to all trusted plugins.
 func (app *App) grantAccess() {
+	// Write the changes for all of the plugins together.
+	err := app.pluginsystem.Batch(func() error {
+		app.grantTrustedPlugins()
+		return nil
+	})
+	if err != nil {
+		app.log.Error("could not save trusted plugin grants: %v", err.Error())
+	}
+}
+
+// grantTrustedPlugins enables and grants access to each of the trusted
+// plugins.
+func (app *App) grantTrustedPlugins() {
 	pluginsData := app.pluginsystem.PluginsData()
 
 	// Enable trusted plugins.
@@ -332,7 +603,7 @@ func (app *App) ListenAndServe(h http.Handler) error {
 
 // handleExit will handle app shutdown when Ctrl+c is pressed.
 func (app *App) handleExit() {
-	c := make(chan os.Signal)
+	c := make(chan os.Signal, 1)
 	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
 	go func() {
 		<-c
@@ -352,9 +623,30 @@ func (app *App) CleanUp() {
 	var err error
 	app.log.Info("shutdown started")
 
+	if app.stopWatch != nil {
+		app.log.Info("stopping storage watcher")
+		app.stopWatch()
+	}
+
+	if app.stopSweep != nil {
+		app.log.Info("stopping session sweeper")
+		app.stopSweep()
+	}
+
+	if app.stopSchedule != nil {
+		app.log.Info("stopping post scheduler")
+		app.stopSchedule()
+	}
+
 	app.log.Info("stopping gRPC plugins")
 	app.StopGRPCClients()
 
+	app.log.Info("flushing storage")
+	err = app.pluginsystem.Flush()
+	if err != nil {
+		app.log.Error("could not flush storage: %v", err.Error())
+	}
+
 	// Load decrypted just in case the storage was decrypted by AMB.
 	app.log.Info("loading storage")
 	err = app.pluginsystem.StorageManager().LoadDecrypted()
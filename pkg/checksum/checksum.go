@@ -0,0 +1,62 @@
+// Package checksum wraps storage data in an envelope with a SHA-256 checksum
+// so truncated or changed data can be told apart from data that can't be
+// decrypted or decoded. Data without an envelope is returned as is so data
+// written before checksums keeps working.
+package checksum
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+)
+
+// magic starts every envelope.
+var magic = []byte{0x00, 'a', 'c', 's'}
+
+// headerSize is the size of the magic and the checksum.
+const headerSize = 4 + sha256.Size
+
+var (
+	// ErrTruncated is returned when the envelope is too short to contain the
+	// checksum.
+	ErrTruncated = errors.New("checksum: data is truncated")
+	// ErrMismatch is returned when the checksum doesn't match the data.
+	ErrMismatch = errors.New("checksum: data does not match the checksum")
+)
+
+// Seal returns the data in an envelope with the checksum of the data.
+func Seal(b []byte) []byte {
+	sum := sha256.Sum256(b)
+
+	out := make([]byte, 0, headerSize+len(b))
+	out = append(out, magic...)
+	out = append(out, sum[:]...)
+	out = append(out, b...)
+
+	return out
+}
+
+// Sealed returns true if the data starts with an envelope.
+func Sealed(b []byte) bool {
+	return bytes.HasPrefix(b, magic)
+}
+
+// Open verifies the checksum and returns the data in the envelope. Data
+// without an envelope is returned as is.
+func Open(b []byte) ([]byte, error) {
+	if !Sealed(b) {
+		return b, nil
+	}
+
+	if len(b) < headerSize {
+		return nil, ErrTruncated
+	}
+
+	data := b[headerSize:]
+	sum := sha256.Sum256(data)
+	if !bytes.Equal(sum[:], b[len(magic):headerSize]) {
+		return nil, ErrMismatch
+	}
+
+	return data, nil
+}
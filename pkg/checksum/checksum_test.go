@@ -0,0 +1,35 @@
+package checksum_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ambientkit/ambient/pkg/checksum"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChecksum(t *testing.T) {
+	sealed := checksum.Seal([]byte(`{"title":"Site"}`))
+	assert.True(t, checksum.Sealed(sealed))
+
+	b, err := checksum.Open(sealed)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"title":"Site"}`, string(b))
+
+	// Data without an envelope is returned as is.
+	b, err = checksum.Open([]byte(`{"title":"Old"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, `{"title":"Old"}`, string(b))
+
+	// Truncated data should fail.
+	_, err = checksum.Open(sealed[:len(sealed)-2])
+	assert.True(t, errors.Is(err, checksum.ErrMismatch))
+	_, err = checksum.Open(sealed[:10])
+	assert.True(t, errors.Is(err, checksum.ErrTruncated))
+
+	// Changed data should fail.
+	changed := append([]byte{}, sealed...)
+	changed[len(changed)-3] = 'X'
+	_, err = checksum.Open(changed)
+	assert.True(t, errors.Is(err, checksum.ErrMismatch))
+}
@@ -0,0 +1,229 @@
+// Package codec encodes and decodes storage data. Data is written with a
+// small header that identifies the codec and compression so it can be read
+// without knowing how it was written. Data without a header is read as JSON
+// so existing storage files keep working.
+package codec
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/ambientkit/ambient"
+)
+
+const (
+	// flagGzip is set when the data is compressed with gzip.
+	flagGzip byte = 1 << 0
+)
+
+// magic starts every header. JSON cannot start with a null byte.
+var magic = []byte{0x00, 'a', 'm', 'b'}
+
+// ErrUnknownCodec is returned when the header contains a codec that is not
+// registered.
+var ErrUnknownCodec = errors.New("unknown storage codec")
+
+func init() {
+	// Register the types that are commonly stored in plugin settings and
+	// custom fields. The basic types and their slices are already registered
+	// by gob. Plugins that store other types in an interface must register
+	// them with gob.Register.
+	gob.Register([]interface{}{})
+	gob.Register(map[string]interface{}{})
+	gob.Register([]map[string]interface{}{})
+	gob.Register(map[string]string{})
+	gob.Register(map[string]bool{})
+	gob.Register(map[string]int{})
+	gob.Register(map[string]float64{})
+	gob.Register(map[string][]string{})
+	gob.Register(time.Time{})
+	gob.Register(time.Duration(0))
+	gob.Register(ambient.PluginGrants{})
+	gob.Register(ambient.PluginSettings{})
+	gob.Register(ambient.PostFields{})
+	gob.Register(ambient.Grant(""))
+}
+
+// JSON is the default codec.
+type JSON struct{}
+
+// Name returns the codec name.
+func (JSON) Name() string {
+	return "json"
+}
+
+// Marshal returns the JSON encoding of v.
+func (JSON) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+// Unmarshal parses the JSON data and stores the result in v.
+func (JSON) Unmarshal(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
+
+// Gob is a compact binary codec that keeps the types of the values stored in
+// interfaces like plugin settings. A value in an interface must have a type
+// that is registered with gob.Register or the save fails.
+type Gob struct{}
+
+// Name returns the codec name.
+func (Gob) Name() string {
+	return "gob"
+}
+
+// Marshal returns the gob encoding of v.
+func (Gob) Marshal(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	err := gob.NewEncoder(&buf).Encode(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Unmarshal parses the gob data and stores the result in v.
+func (Gob) Unmarshal(data []byte, v interface{}) error {
+	return gob.NewDecoder(bytes.NewReader(data)).Decode(v)
+}
+
+// Encode converts the value to bytes with the codec and compresses it if set.
+// JSON without compression is written without a header so it stays readable.
+func Encode(c ambient.StorageCodec, v interface{}, compress bool) ([]byte, error) {
+	if c == nil {
+		c = JSON{}
+	}
+
+	b, err := c.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.Name() == (JSON{}).Name() && !compress {
+		return b, nil
+	}
+
+	name := c.Name()
+	if len(name) == 0 || len(name) > 255 {
+		return nil, fmt.Errorf("storage codec name must be between 1 and 255 characters: %v", name)
+	}
+
+	var flags byte
+	if compress {
+		flags |= flagGzip
+		b, err = gzipBytes(b)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	out := make([]byte, 0, len(magic)+len(name)+2+len(b))
+	out = append(out, magic...)
+	out = append(out, byte(len(name)))
+	out = append(out, name...)
+	out = append(out, flags)
+	out = append(out, b...)
+
+	return out, nil
+}
+
+// Detect reads the header and returns the codec and the uncompressed data.
+// The JSON and gob codecs are always available, other codecs must be passed
+// in.
+func Detect(b []byte, codecs ...ambient.StorageCodec) (ambient.StorageCodec, []byte, error) {
+	if !bytes.HasPrefix(b, magic) {
+		return JSON{}, b, nil
+	}
+
+	rest := b[len(magic):]
+	if len(rest) < 1 || len(rest) < int(rest[0])+2 {
+		return nil, nil, fmt.Errorf("storage data header is too short")
+	}
+
+	name := string(rest[1 : 1+int(rest[0])])
+	flags := rest[1+int(rest[0])]
+	data := rest[2+int(rest[0]):]
+
+	c := find(name, codecs)
+	if c == nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrUnknownCodec, name)
+	}
+
+	if flags&flagGzip != 0 {
+		var err error
+		data, err = gunzipBytes(data)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return c, data, nil
+}
+
+// Decode detects the codec and converts the bytes to the value.
+func Decode(b []byte, v interface{}, codecs ...ambient.StorageCodec) error {
+	c, data, err := Detect(b, codecs...)
+	if err != nil {
+		return err
+	}
+
+	return c.Unmarshal(data, v)
+}
+
+// IsJSON returns true if the codec is the JSON codec.
+func IsJSON(c ambient.StorageCodec) bool {
+	return c != nil && c.Name() == (JSON{}).Name()
+}
+
+// find returns the codec by name.
+func find(name string, codecs []ambient.StorageCodec) ambient.StorageCodec {
+	for _, c := range codecs {
+		if c != nil && c.Name() == name {
+			return c
+		}
+	}
+
+	switch name {
+	case JSON{}.Name():
+		return JSON{}
+	case Gob{}.Name():
+		return Gob{}
+	}
+
+	return nil
+}
+
+// gzipBytes compresses the bytes.
+func gzipBytes(b []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	_, err := zw.Write(b)
+	if err != nil {
+		return nil, err
+	}
+
+	err = zw.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// gunzipBytes decompresses the bytes.
+func gunzipBytes(b []byte) ([]byte, error) {
+	zr, err := gzip.NewReader(bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+
+	return io.ReadAll(zr)
+}
@@ -0,0 +1,116 @@
+package codec_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ambientkit/ambient"
+	"github.com/ambientkit/ambient/pkg/codec"
+	"github.com/stretchr/testify/assert"
+)
+
+// upper is a codec that isn't built in.
+type upper struct {
+	codec.JSON
+}
+
+func (upper) Name() string { return "upper" }
+
+func TestRoundTrip(t *testing.T) {
+	in := ambient.PluginData{
+		Enabled: true,
+		Version: "1.0.0",
+		Settings: map[string]interface{}{
+			"name":  "value",
+			"items": []interface{}{"a", "b"},
+		},
+	}
+
+	for _, c := range []ambient.StorageCodec{codec.JSON{}, codec.Gob{}, nil} {
+		for _, compress := range []bool{false, true} {
+			b, err := codec.Encode(c, in, compress)
+			assert.NoError(t, err)
+
+			out := ambient.PluginData{}
+			assert.NoError(t, codec.Decode(b, &out))
+			assert.Equal(t, in, out)
+		}
+	}
+}
+
+func TestGobSettings(t *testing.T) {
+	created := time.Date(2022, 3, 4, 10, 0, 0, 0, time.UTC)
+	in := ambient.PluginData{
+		Enabled: true,
+		Grants:  ambient.PluginGrants{ambient.GrantSitePostRead: true},
+		Settings: map[string]interface{}{
+			"created": created,
+			"timeout": 5 * time.Second,
+			"labels":  map[string]string{"a": "b"},
+			"flags":   map[string]bool{"on": true},
+			"grants":  ambient.PluginGrants{ambient.GrantSitePostRead: true},
+			"names":   []string{"a", "b"},
+		},
+	}
+
+	b, err := codec.Encode(codec.Gob{}, in, false)
+	assert.NoError(t, err)
+
+	out := ambient.PluginData{}
+	assert.NoError(t, codec.Decode(b, &out))
+	assert.Equal(t, in, out)
+}
+
+func TestDetect(t *testing.T) {
+	v := map[string]string{"title": "Post"}
+
+	// JSON without compression has no header so it stays readable.
+	b, err := codec.Encode(codec.JSON{}, v, false)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"title":"Post"}`, string(b))
+	c, data, err := codec.Detect(b)
+	assert.NoError(t, err)
+	assert.True(t, codec.IsJSON(c))
+	assert.Equal(t, b, data)
+
+	// Other codecs and compression are read from the header.
+	b, err = codec.Encode(codec.Gob{}, v, true)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x00, 'a', 'm', 'b', 3, 'g', 'o', 'b', 1}, b[:9])
+	c, _, err = codec.Detect(b)
+	assert.NoError(t, err)
+	assert.Equal(t, "gob", c.Name())
+
+	b, err = codec.Encode(codec.JSON{}, v, true)
+	assert.NoError(t, err)
+	c, data, err = codec.Detect(b)
+	assert.NoError(t, err)
+	assert.True(t, codec.IsJSON(c))
+	assert.Equal(t, `{"title":"Post"}`, string(data))
+
+	// Codecs that aren't built in must be passed in.
+	b, err = codec.Encode(upper{}, v, false)
+	assert.NoError(t, err)
+	_, _, err = codec.Detect(b)
+	assert.True(t, errors.Is(err, codec.ErrUnknownCodec))
+	c, _, err = codec.Detect(b, upper{})
+	assert.NoError(t, err)
+	assert.Equal(t, "upper", c.Name())
+}
+
+func TestBadHeader(t *testing.T) {
+	// The header is cut off before the flags.
+	_, _, err := codec.Detect([]byte{0x00, 'a', 'm', 'b', 3, 'g', 'o'})
+	assert.Error(t, err)
+
+	_, _, err = codec.Detect([]byte{0x00, 'a', 'm', 'b'})
+	assert.Error(t, err)
+
+	// The data is marked as compressed, but isn't.
+	_, _, err = codec.Detect([]byte{0x00, 'a', 'm', 'b', 4, 'j', 's', 'o', 'n', 1, '{', '}'})
+	assert.Error(t, err)
+
+	err = codec.Decode([]byte{0x00, 'a', 'm', 'b', 3, 'x', 'y', 'z', 0, '{', '}'}, &map[string]string{})
+	assert.True(t, errors.Is(err, codec.ErrUnknownCodec))
+}
@@ -42,3 +42,9 @@ func DevConsolePort() string {
 	}
 	return port
 }
+
+// MigrationDryRun returns true if the AMB_MIGRATION_DRYRUN environment variable is set.
+func MigrationDryRun() bool {
+	result, _ := strconv.ParseBool(os.Getenv("AMB_MIGRATION_DRYRUN"))
+	return result
+}
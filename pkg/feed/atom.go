@@ -0,0 +1,92 @@
+package feed
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+// atomNamespace is the XML namespace of Atom.
+const atomNamespace = "http://www.w3.org/2005/Atom"
+
+// atomDoc is an Atom 1.0 document.
+type atomDoc struct {
+	XMLName  xml.Name    `xml:"feed"`
+	Xmlns    string      `xml:"xmlns,attr"`
+	Title    string      `xml:"title"`
+	Subtitle string      `xml:"subtitle,omitempty"`
+	ID       string      `xml:"id"`
+	Updated  string      `xml:"updated"`
+	Links    []atomLink  `xml:"link"`
+	Author   atomAuthor  `xml:"author"`
+	Entries  []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomEntry struct {
+	Title      string         `xml:"title"`
+	ID         string         `xml:"id"`
+	Link       atomLink       `xml:"link"`
+	Published  string         `xml:"published"`
+	Updated    string         `xml:"updated"`
+	Content    atomContent    `xml:"content"`
+	Categories []atomCategory `xml:"category"`
+}
+
+type atomContent struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+// atom returns the feed as an Atom 1.0 document. The site title is the author
+// since Atom requires one.
+func (f *Feed) atom() ([]byte, error) {
+	doc := atomDoc{
+		Xmlns:   atomNamespace,
+		Title:   f.Title,
+		ID:      f.Link,
+		Updated: f.Updated.Format(time.RFC3339),
+		Links:   []atomLink{{Href: f.Link}},
+		Author:  atomAuthor{Name: f.Title},
+		Entries: make([]atomEntry, 0, len(f.Items)),
+	}
+	if f.Description != f.Title {
+		doc.Subtitle = f.Description
+	}
+	if len(f.FeedURL) > 0 {
+		doc.Links = append(doc.Links, atomLink{Href: f.FeedURL, Rel: "self", Type: FormatAtom.mediaType()})
+	}
+
+	for _, item := range f.Items {
+		entry := atomEntry{
+			Title:      item.Title,
+			ID:         item.ID,
+			Link:       atomLink{Href: item.Link, Rel: "alternate"},
+			Published:  item.Published.Format(time.RFC3339),
+			Updated:    item.Updated.Format(time.RFC3339),
+			Content:    atomContent{Type: "text", Value: item.Content},
+			Categories: make([]atomCategory, 0, len(item.Tags)),
+		}
+		if item.HTML {
+			entry.Content.Type = "html"
+		}
+		for _, tag := range item.Tags {
+			entry.Categories = append(entry.Categories, atomCategory{Term: tag})
+		}
+		doc.Entries = append(doc.Entries, entry)
+	}
+
+	return marshalXML(doc)
+}
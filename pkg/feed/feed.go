@@ -0,0 +1,237 @@
+// Package feed renders the published posts of a site as an RSS 2.0, Atom, or
+// JSON Feed 1.1 document. The handler sets an ETag and a Last-Modified header
+// so feed readers can make conditional requests.
+package feed
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ambientkit/ambient"
+)
+
+// Format is the document format of a feed.
+type Format string
+
+const (
+	// FormatRSS is an RSS 2.0 document.
+	FormatRSS Format = "rss"
+	// FormatAtom is an Atom 1.0 document.
+	FormatAtom Format = "atom"
+	// FormatJSON is a JSON Feed 1.1 document.
+	FormatJSON Format = "json"
+)
+
+// ContentType returns the media type of the format with the charset.
+func (f Format) ContentType() string {
+	return f.mediaType() + "; charset=utf-8"
+}
+
+// mediaType returns the media type of the format.
+func (f Format) mediaType() string {
+	switch f {
+	case FormatRSS:
+		return "application/rss+xml"
+	case FormatAtom:
+		return "application/atom+xml"
+	case FormatJSON:
+		return "application/feed+json"
+	}
+
+	return "application/octet-stream"
+}
+
+// Site is the part of ambient.SecureSite that is used to build a feed. The
+// plugin needs the grants to read the site title, URL, scheme, updated
+// timestamp, and posts.
+type Site interface {
+	Title() (string, error)
+	FullURL() (string, error)
+	Updated() (time.Time, error)
+	QueryPosts(q ambient.PostQuery) (ambient.PostQueryResult, error)
+}
+
+// Ensure the plugin toolkit site can build a feed.
+var _ Site = ambient.SecureSite(nil)
+
+// Options changes which posts are in a feed and how they are shown.
+type Options struct {
+	Tag         string // Only posts with the tag name if set.
+	Limit       int    // Returns all of the posts if 0.
+	Title       string // Defaults to the site title, followed by the tag if set.
+	Description string // Defaults to the title.
+	FeedURL     string // URL of the feed itself, the handler sets it from the request if empty.
+
+	// ContentHTML converts the post content to HTML. If it's not set, the
+	// content is sent as plain text.
+	ContentHTML func(post ambient.PostWithID) string
+}
+
+// Feed is a list of the published posts with the newest first.
+type Feed struct {
+	Title       string
+	Link        string // URL of the site.
+	FeedURL     string
+	Description string
+	Updated     time.Time // Time the newest post or the site was changed.
+	Items       []Item
+}
+
+// Item is a post in a feed.
+type Item struct {
+	ID        string // Permanent link to the post.
+	Title     string
+	Link      string
+	Content   string
+	HTML      bool // True if the content is HTML.
+	Published time.Time
+	Updated   time.Time
+	Tags      []string
+}
+
+// New returns the feed of the published posts on the site.
+func New(site Site, opts Options) (*Feed, error) {
+	title, err := site.Title()
+	if err != nil {
+		return nil, err
+	}
+
+	link, err := site.FullURL()
+	if err != nil {
+		return nil, err
+	}
+	link = strings.TrimSuffix(link, "/") + os.Getenv("AMB_URL_PREFIX")
+
+	updated, err := site.Updated()
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := site.QueryPosts(ambient.PostQuery{
+		Tag:    opts.Tag,
+		Kind:   ambient.PostKindPost,
+		Status: ambient.PostStatusPublished,
+		Limit:  opts.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	f := &Feed{
+		Title:       opts.Title,
+		Link:        link + "/",
+		FeedURL:     opts.FeedURL,
+		Description: opts.Description,
+		Items:       make([]Item, 0, len(result.Posts)),
+	}
+	if len(f.Title) == 0 {
+		f.Title = title
+		if len(opts.Tag) > 0 {
+			f.Title = fmt.Sprintf("%v - %v", title, opts.Tag)
+		}
+	}
+	if len(f.Description) == 0 {
+		f.Description = f.Title
+	}
+
+	for _, p := range result.Posts {
+		// A scheduled post is published when it goes live.
+		published := p.Timestamp
+		if p.PublishAt.After(published) {
+			published = p.PublishAt
+		}
+
+		item := Item{
+			Title:     p.Title,
+			Link:      link + "/" + strings.TrimPrefix(p.URL, "/"),
+			Content:   p.Content,
+			Published: published.UTC(),
+			Updated:   published.UTC(),
+			Tags:      make([]string, 0, len(p.Tags)),
+		}
+		item.ID = item.Link
+		if len(p.Canonical) > 0 {
+			item.Link = p.Canonical
+		}
+		if p.Updated.After(published) {
+			item.Updated = p.Updated.UTC()
+		}
+		if opts.ContentHTML != nil {
+			item.Content = opts.ContentHTML(p)
+			item.HTML = true
+		}
+		for _, t := range p.Tags {
+			item.Tags = append(item.Tags, t.Name)
+		}
+
+		if item.Updated.After(f.Updated) {
+			f.Updated = item.Updated
+		}
+		f.Items = append(f.Items, item)
+	}
+
+	// The feed also changes when a post is removed or unpublished so it's
+	// updated no earlier than the time the site was saved.
+	if updated.After(f.Updated) {
+		f.Updated = updated.UTC()
+	}
+
+	return f, nil
+}
+
+// Render returns the feed as a document in the format.
+func (f *Feed) Render(format Format) ([]byte, error) {
+	switch format {
+	case FormatRSS:
+		return f.rss()
+	case FormatAtom:
+		return f.atom()
+	case FormatJSON:
+		return f.json()
+	}
+
+	return nil, fmt.Errorf("feed: format is not supported: %v", format)
+}
+
+// Write renders the feed of the published posts and writes it to the
+// response. It responds with 304 Not Modified if the request has an
+// If-None-Match or If-Modified-Since header that matches the feed.
+func Write(w http.ResponseWriter, r *http.Request, site Site, format Format, opts Options) error {
+	if len(opts.FeedURL) == 0 {
+		link, err := site.FullURL()
+		if err != nil {
+			return err
+		}
+		opts.FeedURL = strings.TrimSuffix(link, "/") + r.URL.Path
+	}
+
+	f, err := New(site, opts)
+	if err != nil {
+		return err
+	}
+
+	b, err := f.Render(format)
+	if err != nil {
+		return ambient.StatusError{Code: http.StatusInternalServerError, Err: err}
+	}
+
+	sum := sha256.Sum256(b)
+	w.Header().Set("Content-Type", format.ContentType())
+	w.Header().Set("ETag", `"`+hex.EncodeToString(sum[:16])+`"`)
+	http.ServeContent(w, r, "", f.Updated, bytes.NewReader(b))
+
+	return nil
+}
+
+// Handler returns a route handler that writes the feed in the format.
+func Handler(site Site, format Format, opts Options) func(http.ResponseWriter, *http.Request) error {
+	return func(w http.ResponseWriter, r *http.Request) error {
+		return Write(w, r, site, format, opts)
+	}
+}
@@ -0,0 +1,193 @@
+package feed_test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ambientkit/ambient"
+	"github.com/ambientkit/ambient/pkg/feed"
+	"github.com/stretchr/testify/assert"
+)
+
+// site returns the published posts with the tag from the query.
+type site struct {
+	updated time.Time
+	posts   ambient.PostWithIDList
+}
+
+func (s *site) Title() (string, error)      { return "Ambient", nil }
+func (s *site) FullURL() (string, error)    { return "https://example.com", nil }
+func (s *site) Updated() (time.Time, error) { return s.updated, nil }
+func (s *site) QueryPosts(q ambient.PostQuery) (ambient.PostQueryResult, error) {
+	result := ambient.PostQueryResult{Posts: make(ambient.PostWithIDList, 0)}
+	for _, p := range s.posts {
+		if len(q.Tag) == 0 || strings.Contains(p.Tags.String(), q.Tag) {
+			result.Posts = append(result.Posts, p)
+		}
+	}
+	result.Total = len(result.Posts)
+	return result, nil
+}
+
+func TestFeed(t *testing.T) {
+	now := time.Date(2022, 3, 4, 10, 0, 0, 0, time.UTC)
+	s := &site{
+		updated: now.Add(15 * time.Minute),
+		posts: ambient.PostWithIDList{
+			{ID: "2", Post: ambient.Post{
+				Title:     "Second <post>",
+				URL:       "second",
+				Content:   "Hello & goodbye",
+				Timestamp: now,
+				Updated:   now.Add(30 * time.Minute),
+				Tags:      ambient.TagList{{Name: "go"}, {Name: "web"}},
+			}},
+			{ID: "1", Post: ambient.Post{
+				Title:     "First",
+				URL:       "first",
+				Content:   "Hi",
+				Timestamp: now.Add(-time.Hour),
+				Tags:      ambient.TagList{{Name: "web"}},
+			}},
+		},
+	}
+
+	f, err := feed.New(s, feed.Options{})
+	assert.NoError(t, err)
+	assert.Equal(t, "https://example.com/", f.Link)
+	assert.Equal(t, now.Add(30*time.Minute), f.Updated)
+	assert.Len(t, f.Items, 2)
+	assert.Equal(t, "https://example.com/second", f.Items[0].Link)
+
+	// Tag feeds only have the posts with the tag.
+	f, err = feed.New(s, feed.Options{Tag: "go"})
+	assert.NoError(t, err)
+	assert.Equal(t, "Ambient - go", f.Title)
+	assert.Len(t, f.Items, 1)
+
+	// RSS.
+	b, err := f.Render(feed.FormatRSS)
+	assert.NoError(t, err)
+	rss := struct {
+		Version string `xml:"version,attr"`
+		Channel struct {
+			Title string `xml:"title"`
+			Items []struct {
+				Title       string   `xml:"title"`
+				Description string   `xml:"description"`
+				PubDate     string   `xml:"pubDate"`
+				Categories  []string `xml:"category"`
+			} `xml:"item"`
+		} `xml:"channel"`
+	}{}
+	assert.NoError(t, xml.Unmarshal(b, &rss))
+	assert.Equal(t, "2.0", rss.Version)
+	assert.Equal(t, "Second <post>", rss.Channel.Items[0].Title)
+	assert.Equal(t, "Hello & goodbye", rss.Channel.Items[0].Description)
+	assert.Equal(t, "Fri, 04 Mar 2022 10:00:00 +0000", rss.Channel.Items[0].PubDate)
+	assert.Equal(t, []string{"go", "web"}, rss.Channel.Items[0].Categories)
+
+	// Atom.
+	b, err = f.Render(feed.FormatAtom)
+	assert.NoError(t, err)
+	atom := struct {
+		XMLName xml.Name
+		Updated string `xml:"updated"`
+		Entries []struct {
+			ID      string `xml:"id"`
+			Updated string `xml:"updated"`
+		} `xml:"entry"`
+	}{}
+	assert.NoError(t, xml.Unmarshal(b, &atom))
+	assert.Equal(t, "http://www.w3.org/2005/Atom", atom.XMLName.Space)
+	assert.Equal(t, "2022-03-04T10:30:00Z", atom.Updated)
+	assert.Equal(t, "https://example.com/second", atom.Entries[0].ID)
+	assert.Equal(t, "2022-03-04T10:30:00Z", atom.Entries[0].Updated)
+
+	// JSON Feed.
+	b, err = f.Render(feed.FormatJSON)
+	assert.NoError(t, err)
+	doc := struct {
+		Version string `json:"version"`
+		Items   []struct {
+			ContentText string   `json:"content_text"`
+			Tags        []string `json:"tags"`
+		} `json:"items"`
+	}{}
+	assert.NoError(t, json.Unmarshal(b, &doc))
+	assert.Equal(t, "https://jsonfeed.org/version/1.1", doc.Version)
+	assert.Equal(t, "Hello & goodbye", doc.Items[0].ContentText)
+
+	_, err = f.Render("other")
+	assert.Error(t, err)
+}
+
+func TestHandler(t *testing.T) {
+	now := time.Date(2022, 3, 4, 10, 0, 0, 0, time.UTC)
+	s := &site{
+		updated: now,
+		posts: ambient.PostWithIDList{
+			{ID: "1", Post: ambient.Post{Title: "First", URL: "first", Timestamp: now}},
+		},
+	}
+	h := feed.Handler(s, feed.FormatRSS, feed.Options{})
+
+	w := httptest.NewRecorder()
+	assert.NoError(t, h(w, httptest.NewRequest("GET", "/rss.xml", nil)))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/rss+xml; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.Equal(t, "Fri, 04 Mar 2022 10:00:00 GMT", w.Header().Get("Last-Modified"))
+	assert.Contains(t, w.Body.String(), `<atom:link href="https://example.com/rss.xml" rel="self" type="application/rss+xml"></atom:link>`)
+	etag := w.Header().Get("ETag")
+	assert.NotEmpty(t, etag)
+
+	// Conditional requests don't send the feed again if it hasn't changed.
+	r := httptest.NewRequest("GET", "/rss.xml", nil)
+	r.Header.Set("If-None-Match", etag)
+	w = httptest.NewRecorder()
+	assert.NoError(t, h(w, r))
+	assert.Equal(t, http.StatusNotModified, w.Code)
+
+	r = httptest.NewRequest("GET", "/rss.xml", nil)
+	r.Header.Set("If-Modified-Since", now.Format(http.TimeFormat))
+	w = httptest.NewRecorder()
+	assert.NoError(t, h(w, r))
+	assert.Equal(t, http.StatusNotModified, w.Code)
+
+	// A new post changes the feed.
+	s.posts = append(ambient.PostWithIDList{
+		{ID: "2", Post: ambient.Post{Title: "Second", URL: "second", Timestamp: now.Add(time.Minute)}},
+	}, s.posts...)
+	r = httptest.NewRequest("GET", "/rss.xml", nil)
+	r.Header.Set("If-None-Match", etag)
+	w = httptest.NewRecorder()
+	assert.NoError(t, h(w, r))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.NotEqual(t, etag, w.Header().Get("ETag"))
+
+	// A scheduled post is updated when it goes live.
+	s.posts = append(ambient.PostWithIDList{
+		{ID: "3", Post: ambient.Post{Title: "Third", URL: "third", Timestamp: now, PublishAt: now.Add(time.Hour)}},
+	}, s.posts...)
+	r = httptest.NewRequest("GET", "/rss.xml", nil)
+	r.Header.Set("If-Modified-Since", now.Add(time.Minute).Format(http.TimeFormat))
+	w = httptest.NewRecorder()
+	assert.NoError(t, h(w, r))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "Fri, 04 Mar 2022 11:00:00 GMT", w.Header().Get("Last-Modified"))
+
+	// Removing a post changes the time the site was saved.
+	s.posts = s.posts[1:]
+	s.updated = now.Add(2 * time.Hour)
+	r = httptest.NewRequest("GET", "/rss.xml", nil)
+	r.Header.Set("If-Modified-Since", now.Add(time.Hour).Format(http.TimeFormat))
+	w = httptest.NewRecorder()
+	assert.NoError(t, h(w, r))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "Fri, 04 Mar 2022 12:00:00 GMT", w.Header().Get("Last-Modified"))
+}
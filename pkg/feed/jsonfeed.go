@@ -0,0 +1,63 @@
+package feed
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// jsonFeedVersion is the URL of the JSON Feed version.
+const jsonFeedVersion = "https://jsonfeed.org/version/1.1"
+
+// jsonDoc is a JSON Feed 1.1 document.
+type jsonDoc struct {
+	Version     string     `json:"version"`
+	Title       string     `json:"title"`
+	HomePageURL string     `json:"home_page_url"`
+	FeedURL     string     `json:"feed_url,omitempty"`
+	Description string     `json:"description,omitempty"`
+	Items       []jsonItem `json:"items"`
+}
+
+type jsonItem struct {
+	ID            string   `json:"id"`
+	URL           string   `json:"url"`
+	Title         string   `json:"title"`
+	ContentHTML   string   `json:"content_html,omitempty"`
+	ContentText   *string  `json:"content_text,omitempty"`
+	DatePublished string   `json:"date_published"`
+	DateModified  string   `json:"date_modified"`
+	Tags          []string `json:"tags,omitempty"`
+}
+
+// json returns the feed as a JSON Feed 1.1 document.
+func (f *Feed) json() ([]byte, error) {
+	doc := jsonDoc{
+		Version:     jsonFeedVersion,
+		Title:       f.Title,
+		HomePageURL: f.Link,
+		FeedURL:     f.FeedURL,
+		Description: f.Description,
+		Items:       make([]jsonItem, 0, len(f.Items)),
+	}
+
+	for _, item := range f.Items {
+		ji := jsonItem{
+			ID:            item.ID,
+			URL:           item.Link,
+			Title:         item.Title,
+			DatePublished: item.Published.Format(time.RFC3339),
+			DateModified:  item.Updated.Format(time.RFC3339),
+			Tags:          item.Tags,
+		}
+		if item.HTML {
+			ji.ContentHTML = item.Content
+		} else {
+			// An item must have content so the text is sent even if empty.
+			content := item.Content
+			ji.ContentText = &content
+		}
+		doc.Items = append(doc.Items, ji)
+	}
+
+	return json.MarshalIndent(doc, "", "  ")
+}
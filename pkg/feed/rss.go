@@ -0,0 +1,79 @@
+package feed
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+// rssDoc is an RSS 2.0 document.
+type rssDoc struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Atom    string     `xml:"xmlns:atom,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	LastBuildDate string    `xml:"lastBuildDate"`
+	Self          *atomLink `xml:"atom:link,omitempty"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string   `xml:"title"`
+	Link        string   `xml:"link"`
+	GUID        rssGUID  `xml:"guid"`
+	PubDate     string   `xml:"pubDate"`
+	Description string   `xml:"description"`
+	Categories  []string `xml:"category"`
+}
+
+type rssGUID struct {
+	Value       string `xml:",chardata"`
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+}
+
+// rss returns the feed as an RSS 2.0 document. RSS doesn't mark the content
+// type so HTML content is sent escaped as readers expect.
+func (f *Feed) rss() ([]byte, error) {
+	doc := rssDoc{
+		Version: "2.0",
+		Atom:    atomNamespace,
+		Channel: rssChannel{
+			Title:         f.Title,
+			Link:          f.Link,
+			Description:   f.Description,
+			LastBuildDate: f.Updated.Format(time.RFC1123Z),
+			Items:         make([]rssItem, 0, len(f.Items)),
+		},
+	}
+	if len(f.FeedURL) > 0 {
+		doc.Channel.Self = &atomLink{Href: f.FeedURL, Rel: "self", Type: FormatRSS.mediaType()}
+	}
+
+	for _, item := range f.Items {
+		doc.Channel.Items = append(doc.Channel.Items, rssItem{
+			Title:       item.Title,
+			Link:        item.Link,
+			GUID:        rssGUID{Value: item.ID, IsPermaLink: true},
+			PubDate:     item.Published.Format(time.RFC1123Z),
+			Description: item.Content,
+			Categories:  item.Tags,
+		})
+	}
+
+	return marshalXML(doc)
+}
+
+// marshalXML returns the indented document with the XML header.
+func marshalXML(doc interface{}) ([]byte, error) {
+	b, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte(xml.Header), b...), nil
+}
@@ -213,6 +213,18 @@ func (m *GRPCPlugin) Settings(ctx context.Context, req *protodef.Empty) (*protod
 	}, nil
 }
 
+// ContentTypes handler.
+func (m *GRPCPlugin) ContentTypes(ctx context.Context, req *protodef.Empty) (*protodef.ContentTypesResponse, error) {
+	arr, err := ArrayToProtobufStruct(m.Impl.ContentTypes())
+	if err != nil {
+		return &protodef.ContentTypesResponse{}, err
+	}
+
+	return &protodef.ContentTypesResponse{
+		Contenttypes: arr,
+	}, nil
+}
+
 // FuncMap handler.
 func (m *GRPCPlugin) FuncMap(ctx context.Context, req *protodef.Empty) (*protodef.FuncMapResponse, error) {
 	//m.toolkit.Log.Error("FuncMap called.")
@@ -266,6 +266,53 @@ func (c *GRPCSitePlugin) PostBySlug(slug string) (ambient.PostWithID, error) {
 	return post, err
 }
 
+// SearchPosts handler.
+func (c *GRPCSitePlugin) SearchPosts(query string, limit int, offset int) (ambient.PostSearchResults, error) {
+	resp, err := c.client.SearchPosts(context.Background(), &protodef.SiteSearchPostsRequest{
+		Query:  query,
+		Limit:  int64(limit),
+		Offset: int64(offset),
+	})
+	if err != nil {
+		return ambient.PostSearchResults{}, ErrorHandler(err)
+	}
+
+	results := ambient.PostSearchResults{}
+	err = ProtobufStructToObject(resp.Results, &results)
+	return results, err
+}
+
+// QueryPosts handler.
+func (c *GRPCSitePlugin) QueryPosts(q ambient.PostQuery) (ambient.PostQueryResult, error) {
+	qs, err := ObjectToProtobufStruct(q)
+	if err != nil {
+		return ambient.PostQueryResult{}, ErrorHandler(err)
+	}
+
+	resp, err := c.client.QueryPosts(context.Background(), &protodef.SiteQueryPostsRequest{
+		Query: qs,
+	})
+	if err != nil {
+		return ambient.PostQueryResult{}, ErrorHandler(err)
+	}
+
+	result := ambient.PostQueryResult{}
+	err = ProtobufStructToObject(resp.Result, &result)
+	return result, err
+}
+
+// ContentTypes handler.
+func (c *GRPCSitePlugin) ContentTypes() ([]ambient.ContentType, error) {
+	resp, err := c.client.ContentTypes(context.Background(), &protodef.Empty{})
+	if err != nil {
+		return make([]ambient.ContentType, 0), ErrorHandler(err)
+	}
+
+	types := make([]ambient.ContentType, 0)
+	err = ProtobufStructToArray(resp.Contenttypes, &types)
+	return types, err
+}
+
 // PostByID handler.
 func (c *GRPCSitePlugin) PostByID(ID string) (ambient.Post, error) {
 	resp, err := c.client.PostByID(context.Background(), &protodef.SitePostByIDRequest{
@@ -292,6 +339,222 @@ func (c *GRPCSitePlugin) DeletePostByID(ID string) error {
 	return nil
 }
 
+// SavePostAs handler.
+func (c *GRPCSitePlugin) SavePostAs(r *http.Request, ID string, post ambient.Post) error {
+	ps, err := ObjectToProtobufStruct(post)
+	if err != nil {
+		return ErrorHandler(err)
+	}
+
+	_, err = c.client.SavePostAs(context.Background(), &protodef.SiteSavePostAsRequest{
+		Requestid: requestuuid.Get(r),
+		Id:        ID,
+		Post:      ps,
+	})
+	if err != nil {
+		return ErrorHandler(err)
+	}
+
+	return nil
+}
+
+// PostRevisions handler.
+func (c *GRPCSitePlugin) PostRevisions(ID string) ([]ambient.PostRevision, error) {
+	resp, err := c.client.PostRevisions(context.Background(), &protodef.SitePostRevisionsRequest{
+		Id: ID,
+	})
+	if err != nil {
+		return make([]ambient.PostRevision, 0), ErrorHandler(err)
+	}
+
+	revisions := make([]ambient.PostRevision, 0)
+	err = ProtobufStructToArray(resp.Revisions, &revisions)
+	return revisions, err
+}
+
+// PostRevision handler.
+func (c *GRPCSitePlugin) PostRevision(ID string, revisionID string) (ambient.PostRevision, error) {
+	resp, err := c.client.PostRevision(context.Background(), &protodef.SitePostRevisionRequest{
+		Id:         ID,
+		Revisionid: revisionID,
+	})
+	if err != nil {
+		return ambient.PostRevision{}, ErrorHandler(err)
+	}
+
+	revision := ambient.PostRevision{}
+	err = ProtobufStructToObject(resp.Revision, &revision)
+	return revision, err
+}
+
+// PostRevisionDiff handler.
+func (c *GRPCSitePlugin) PostRevisionDiff(ID string, revisionID string) (string, error) {
+	resp, err := c.client.PostRevisionDiff(context.Background(), &protodef.SitePostRevisionRequest{
+		Id:         ID,
+		Revisionid: revisionID,
+	})
+	if err != nil {
+		return "", ErrorHandler(err)
+	}
+
+	return resp.Diff, nil
+}
+
+// RestorePostRevision handler.
+func (c *GRPCSitePlugin) RestorePostRevision(r *http.Request, ID string, revisionID string) error {
+	_, err := c.client.RestorePostRevision(context.Background(), &protodef.SiteRestorePostRevisionRequest{
+		Requestid:  requestuuid.Get(r),
+		Id:         ID,
+		Revisionid: revisionID,
+	})
+	if err != nil {
+		return ErrorHandler(err)
+	}
+
+	return nil
+}
+
+// CreatePostPreview handler.
+func (c *GRPCSitePlugin) CreatePostPreview(r *http.Request, ID string, expires time.Duration) (ambient.PostPreview, error) {
+	resp, err := c.client.CreatePostPreview(context.Background(), &protodef.SiteCreatePostPreviewRequest{
+		Requestid: requestuuid.Get(r),
+		Id:        ID,
+		Expires:   int64(expires),
+	})
+	if err != nil {
+		return ambient.PostPreview{}, ErrorHandler(err)
+	}
+
+	preview := ambient.PostPreview{}
+	err = ProtobufStructToObject(resp.Preview, &preview)
+	return preview, err
+}
+
+// PostPreviews handler.
+func (c *GRPCSitePlugin) PostPreviews(ID string) ([]ambient.PostPreview, error) {
+	resp, err := c.client.PostPreviews(context.Background(), &protodef.SitePostPreviewsRequest{
+		Id: ID,
+	})
+	if err != nil {
+		return make([]ambient.PostPreview, 0), ErrorHandler(err)
+	}
+
+	previews := make([]ambient.PostPreview, 0)
+	err = ProtobufStructToArray(resp.Previews, &previews)
+	return previews, err
+}
+
+// RevokePostPreview handler.
+func (c *GRPCSitePlugin) RevokePostPreview(ID string, previewID string) error {
+	_, err := c.client.RevokePostPreview(context.Background(), &protodef.SiteRevokePostPreviewRequest{
+		Id:        ID,
+		Previewid: previewID,
+	})
+	if err != nil {
+		return ErrorHandler(err)
+	}
+
+	return nil
+}
+
+// PreviewPostBySlug handler.
+func (c *GRPCSitePlugin) PreviewPostBySlug(r *http.Request, slug string) (ambient.PostWithID, error) {
+	resp, err := c.client.PreviewPostBySlug(context.Background(), &protodef.SitePreviewPostBySlugRequest{
+		Requestid: requestuuid.Get(r),
+		Slug:      slug,
+	})
+	if err != nil {
+		return ambient.PostWithID{}, ErrorHandler(err)
+	}
+
+	post := ambient.PostWithID{}
+	err = ProtobufStructToObject(resp.Post, &post)
+	return post, err
+}
+
+// PageChildren handler.
+func (c *GRPCSitePlugin) PageChildren(ID string) (ambient.PostWithIDList, error) {
+	resp, err := c.client.PageChildren(context.Background(), &protodef.SitePageChildrenRequest{
+		Id: ID,
+	})
+	if err != nil {
+		return ambient.PostWithIDList{}, ErrorHandler(err)
+	}
+
+	posts := make(ambient.PostWithIDList, 0)
+	err = ProtobufStructToArray(resp.Postwithidlist, &posts)
+	return posts, err
+}
+
+// Breadcrumbs handler.
+func (c *GRPCSitePlugin) Breadcrumbs(ID string) (ambient.PostWithIDList, error) {
+	resp, err := c.client.Breadcrumbs(context.Background(), &protodef.SiteBreadcrumbsRequest{
+		Id: ID,
+	})
+	if err != nil {
+		return ambient.PostWithIDList{}, ErrorHandler(err)
+	}
+
+	posts := make(ambient.PostWithIDList, 0)
+	err = ProtobufStructToArray(resp.Postwithidlist, &posts)
+	return posts, err
+}
+
+// Menus handler.
+func (c *GRPCSitePlugin) Menus() ([]ambient.Menu, error) {
+	resp, err := c.client.Menus(context.Background(), &protodef.Empty{})
+	if err != nil {
+		return make([]ambient.Menu, 0), ErrorHandler(err)
+	}
+
+	menus := make([]ambient.Menu, 0)
+	err = ProtobufStructToArray(resp.Menus, &menus)
+	return menus, err
+}
+
+// Menu handler.
+func (c *GRPCSitePlugin) Menu(name string) (ambient.Menu, error) {
+	resp, err := c.client.Menu(context.Background(), &protodef.SiteMenuRequest{
+		Name: name,
+	})
+	if err != nil {
+		return ambient.Menu{}, ErrorHandler(err)
+	}
+
+	menu := ambient.Menu{}
+	err = ProtobufStructToObject(resp.Menu, &menu)
+	return menu, err
+}
+
+// SaveMenu handler.
+func (c *GRPCSitePlugin) SaveMenu(menu ambient.Menu) error {
+	m, err := ObjectToProtobufStruct(menu)
+	if err != nil {
+		return ErrorHandler(err)
+	}
+
+	_, err = c.client.SaveMenu(context.Background(), &protodef.SiteSaveMenuRequest{
+		Menu: m,
+	})
+	if err != nil {
+		return ErrorHandler(err)
+	}
+
+	return nil
+}
+
+// DeleteMenu handler.
+func (c *GRPCSitePlugin) DeleteMenu(name string) error {
+	_, err := c.client.DeleteMenu(context.Background(), &protodef.SiteDeleteMenuRequest{
+		Name: name,
+	})
+	if err != nil {
+		return ErrorHandler(err)
+	}
+
+	return nil
+}
+
 // PluginNeighborRoutesList handler.
 func (c *GRPCSitePlugin) PluginNeighborRoutesList(pluginName string) ([]ambient.Route, error) {
 	resp, err := c.client.PluginNeighborRoutesList(context.Background(), &protodef.SitePluginNeighborRoutesListRequest{
@@ -368,6 +631,34 @@ func (c *GRPCSitePlugin) LogoutAllUsers(r *http.Request) error {
 	return nil
 }
 
+// UserSessions handler.
+func (c *GRPCSitePlugin) UserSessions(r *http.Request, username string) ([]ambient.UserSession, error) {
+	resp, err := c.client.UserSessions(context.Background(), &protodef.SiteUserSessionsRequest{
+		Requestid: requestuuid.Get(r),
+		Username:  username,
+	})
+	if err != nil {
+		return make([]ambient.UserSession, 0), ErrorHandler(err)
+	}
+
+	sessions := make([]ambient.UserSession, 0)
+	err = ProtobufStructToArray(resp.Sessions, &sessions)
+	return sessions, err
+}
+
+// LogoutUser handler.
+func (c *GRPCSitePlugin) LogoutUser(r *http.Request, username string) error {
+	_, err := c.client.LogoutUser(context.Background(), &protodef.SiteLogoutUserRequest{
+		Requestid: requestuuid.Get(r),
+		Username:  username,
+	})
+	if err != nil {
+		return ErrorHandler(err)
+	}
+
+	return nil
+}
+
 // SetCSRF handler.
 func (c *GRPCSitePlugin) SetCSRF(r *http.Request) string {
 	resp, err := c.client.SetCSRF(context.Background(), &protodef.SiteSetCSRFRequest{
@@ -681,3 +972,228 @@ func (c *GRPCSitePlugin) Tags(onlyPublished bool) (ambient.TagList, error) {
 
 	return tags, nil
 }
+
+// TagDetails handler.
+func (c *GRPCSitePlugin) TagDetails(onlyPublished bool) ([]ambient.TagDetail, error) {
+	resp, err := c.client.TagDetails(context.Background(), &protodef.SiteTagsRequest{
+		Onlypublished: onlyPublished,
+	})
+	if err != nil {
+		return make([]ambient.TagDetail, 0), ErrorHandler(err)
+	}
+
+	tags := make([]ambient.TagDetail, 0)
+	err = ProtobufStructToArray(resp.Tags, &tags)
+	return tags, err
+}
+
+// TagBySlug handler.
+func (c *GRPCSitePlugin) TagBySlug(slug string, onlyPublished bool) (ambient.TagDetail, error) {
+	resp, err := c.client.TagBySlug(context.Background(), &protodef.SiteTagBySlugRequest{
+		Slug:          slug,
+		Onlypublished: onlyPublished,
+	})
+	if err != nil {
+		return ambient.TagDetail{}, ErrorHandler(err)
+	}
+
+	tag := ambient.TagDetail{}
+	err = ProtobufStructToObject(resp.Tag, &tag)
+	return tag, err
+}
+
+// RenameTag handler.
+func (c *GRPCSitePlugin) RenameTag(name string, newName string) error {
+	_, err := c.client.RenameTag(context.Background(), &protodef.SiteRenameTagRequest{
+		Name:    name,
+		Newname: newName,
+	})
+	if err != nil {
+		return ErrorHandler(err)
+	}
+
+	return nil
+}
+
+// MergeTags handler.
+func (c *GRPCSitePlugin) MergeTags(name string, into string) error {
+	_, err := c.client.MergeTags(context.Background(), &protodef.SiteMergeTagsRequest{
+		Name: name,
+		Into: into,
+	})
+	if err != nil {
+		return ErrorHandler(err)
+	}
+
+	return nil
+}
+
+// DeleteTag handler.
+func (c *GRPCSitePlugin) DeleteTag(name string) error {
+	_, err := c.client.DeleteTag(context.Background(), &protodef.SiteDeleteTagRequest{
+		Name: name,
+	})
+	if err != nil {
+		return ErrorHandler(err)
+	}
+
+	return nil
+}
+
+// SetTagMeta handler.
+func (c *GRPCSitePlugin) SetTagMeta(name string, meta ambient.TagMeta) error {
+	m, err := ObjectToProtobufStruct(meta)
+	if err != nil {
+		return ErrorHandler(err)
+	}
+
+	_, err = c.client.SetTagMeta(context.Background(), &protodef.SiteSetTagMetaRequest{
+		Name: name,
+		Meta: m,
+	})
+	if err != nil {
+		return ErrorHandler(err)
+	}
+
+	return nil
+}
+
+// Snapshots handler.
+func (c *GRPCSitePlugin) Snapshots() ([]ambient.Snapshot, error) {
+	resp, err := c.client.Snapshots(context.Background(), &protodef.Empty{})
+	if err != nil {
+		return make([]ambient.Snapshot, 0), ErrorHandler(err)
+	}
+
+	snapshots := make([]ambient.Snapshot, 0)
+	err = ProtobufStructToArray(resp.Snapshots, &snapshots)
+	return snapshots, err
+}
+
+// CreateSnapshot handler.
+func (c *GRPCSitePlugin) CreateSnapshot() (ambient.Snapshot, error) {
+	resp, err := c.client.CreateSnapshot(context.Background(), &protodef.Empty{})
+	if err != nil {
+		return ambient.Snapshot{}, ErrorHandler(err)
+	}
+
+	snapshot := ambient.Snapshot{}
+	err = ProtobufStructToObject(resp.Snapshot, &snapshot)
+	return snapshot, err
+}
+
+// SnapshotDiff handler.
+func (c *GRPCSitePlugin) SnapshotDiff(ID string) ([]ambient.SiteChange, error) {
+	resp, err := c.client.SnapshotDiff(context.Background(), &protodef.SiteSnapshotDiffRequest{
+		Id: ID,
+	})
+	if err != nil {
+		return make([]ambient.SiteChange, 0), ErrorHandler(err)
+	}
+
+	changes := make([]ambient.SiteChange, 0)
+	err = ProtobufStructToArray(resp.Changes, &changes)
+	return changes, err
+}
+
+// RestoreSnapshot handler.
+func (c *GRPCSitePlugin) RestoreSnapshot(ID string) error {
+	_, err := c.client.RestoreSnapshot(context.Background(), &protodef.SiteRestoreSnapshotRequest{
+		Id: ID,
+	})
+	if err != nil {
+		return ErrorHandler(err)
+	}
+
+	return nil
+}
+
+// PluginStoreGet handler.
+func (c *GRPCSitePlugin) PluginStoreGet(key string) ([]byte, error) {
+	resp, err := c.client.PluginStoreGet(context.Background(), &protodef.SitePluginStoreGetRequest{
+		Key: key,
+	})
+	if err != nil {
+		return nil, ErrorHandler(err)
+	}
+
+	return resp.Value, nil
+}
+
+// PluginStorePut handler.
+func (c *GRPCSitePlugin) PluginStorePut(key string, value []byte) error {
+	_, err := c.client.PluginStorePut(context.Background(), &protodef.SitePluginStorePutRequest{
+		Key:   key,
+		Value: value,
+	})
+	if err != nil {
+		return ErrorHandler(err)
+	}
+
+	return nil
+}
+
+// PluginStoreDelete handler.
+func (c *GRPCSitePlugin) PluginStoreDelete(key string) error {
+	_, err := c.client.PluginStoreDelete(context.Background(), &protodef.SitePluginStoreDeleteRequest{
+		Key: key,
+	})
+	if err != nil {
+		return ErrorHandler(err)
+	}
+
+	return nil
+}
+
+// PluginStoreList handler.
+func (c *GRPCSitePlugin) PluginStoreList() ([]string, error) {
+	resp, err := c.client.PluginStoreList(context.Background(), &protodef.Empty{})
+	if err != nil {
+		return make([]string, 0), ErrorHandler(err)
+	}
+
+	return resp.Keys, nil
+}
+
+// Subscribe handler.
+func (c *GRPCSitePlugin) Subscribe() (<-chan ambient.Event, func(), error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := c.client.Subscribe(ctx, &protodef.Empty{})
+	if err != nil {
+		cancel()
+		return nil, nil, ErrorHandler(err)
+	}
+
+	// The server sends the header once subscribed so errors like access
+	// denied are returned here instead of on the first event.
+	_, err = stream.Header()
+	if err != nil {
+		cancel()
+		return nil, nil, ErrorHandler(err)
+	}
+
+	events := make(chan ambient.Event, 64)
+	go func() {
+		defer close(events)
+		for {
+			e, err := stream.Recv()
+			if err != nil {
+				return
+			}
+
+			select {
+			case events <- ambient.Event{
+				Type:   ambient.EventType(e.Type),
+				ID:     e.Id,
+				Plugin: e.Plugin,
+				Name:   e.Name,
+				Time:   e.Timestamp.AsTime(),
+			}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events, cancel, nil
+}
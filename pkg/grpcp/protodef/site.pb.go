@@ -1211,16 +1211,18 @@ func (x *SitePostBySlugResponse) GetPost() *structpb.Struct {
 	return nil
 }
 
-type SitePostByIDRequest struct {
+type SiteSearchPostsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Query  string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Limit  int64  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset int64  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
 }
 
-func (x *SitePostByIDRequest) Reset() {
-	*x = SitePostByIDRequest{}
+func (x *SiteSearchPostsRequest) Reset() {
+	*x = SiteSearchPostsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_site_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1228,13 +1230,13 @@ func (x *SitePostByIDRequest) Reset() {
 	}
 }
 
-func (x *SitePostByIDRequest) String() string {
+func (x *SiteSearchPostsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SitePostByIDRequest) ProtoMessage() {}
+func (*SiteSearchPostsRequest) ProtoMessage() {}
 
-func (x *SitePostByIDRequest) ProtoReflect() protoreflect.Message {
+func (x *SiteSearchPostsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_site_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1246,28 +1248,42 @@ func (x *SitePostByIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SitePostByIDRequest.ProtoReflect.Descriptor instead.
-func (*SitePostByIDRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SiteSearchPostsRequest.ProtoReflect.Descriptor instead.
+func (*SiteSearchPostsRequest) Descriptor() ([]byte, []int) {
 	return file_site_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *SitePostByIDRequest) GetId() string {
+func (x *SiteSearchPostsRequest) GetQuery() string {
 	if x != nil {
-		return x.Id
+		return x.Query
 	}
 	return ""
 }
 
-type SitePostByIDResponse struct {
+func (x *SiteSearchPostsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SiteSearchPostsRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type SiteSearchPostsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Post *structpb.Struct `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	Results *structpb.Struct `protobuf:"bytes,1,opt,name=results,proto3" json:"results,omitempty"`
 }
 
-func (x *SitePostByIDResponse) Reset() {
-	*x = SitePostByIDResponse{}
+func (x *SiteSearchPostsResponse) Reset() {
+	*x = SiteSearchPostsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_site_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1275,13 +1291,13 @@ func (x *SitePostByIDResponse) Reset() {
 	}
 }
 
-func (x *SitePostByIDResponse) String() string {
+func (x *SiteSearchPostsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SitePostByIDResponse) ProtoMessage() {}
+func (*SiteSearchPostsResponse) ProtoMessage() {}
 
-func (x *SitePostByIDResponse) ProtoReflect() protoreflect.Message {
+func (x *SiteSearchPostsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_site_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1293,28 +1309,28 @@ func (x *SitePostByIDResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SitePostByIDResponse.ProtoReflect.Descriptor instead.
-func (*SitePostByIDResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SiteSearchPostsResponse.ProtoReflect.Descriptor instead.
+func (*SiteSearchPostsResponse) Descriptor() ([]byte, []int) {
 	return file_site_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *SitePostByIDResponse) GetPost() *structpb.Struct {
+func (x *SiteSearchPostsResponse) GetResults() *structpb.Struct {
 	if x != nil {
-		return x.Post
+		return x.Results
 	}
 	return nil
 }
 
-type SiteDeletePostByIDRequest struct {
+type SiteQueryPostsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Query *structpb.Struct `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
 }
 
-func (x *SiteDeletePostByIDRequest) Reset() {
-	*x = SiteDeletePostByIDRequest{}
+func (x *SiteQueryPostsRequest) Reset() {
+	*x = SiteQueryPostsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_site_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1322,13 +1338,13 @@ func (x *SiteDeletePostByIDRequest) Reset() {
 	}
 }
 
-func (x *SiteDeletePostByIDRequest) String() string {
+func (x *SiteQueryPostsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SiteDeletePostByIDRequest) ProtoMessage() {}
+func (*SiteQueryPostsRequest) ProtoMessage() {}
 
-func (x *SiteDeletePostByIDRequest) ProtoReflect() protoreflect.Message {
+func (x *SiteQueryPostsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_site_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1340,28 +1356,28 @@ func (x *SiteDeletePostByIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SiteDeletePostByIDRequest.ProtoReflect.Descriptor instead.
-func (*SiteDeletePostByIDRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SiteQueryPostsRequest.ProtoReflect.Descriptor instead.
+func (*SiteQueryPostsRequest) Descriptor() ([]byte, []int) {
 	return file_site_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *SiteDeletePostByIDRequest) GetId() string {
+func (x *SiteQueryPostsRequest) GetQuery() *structpb.Struct {
 	if x != nil {
-		return x.Id
+		return x.Query
 	}
-	return ""
+	return nil
 }
 
-type SitePluginNeighborRoutesListRequest struct {
+type SiteQueryPostsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pluginname string `protobuf:"bytes,1,opt,name=pluginname,proto3" json:"pluginname,omitempty"`
+	Result *structpb.Struct `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
 }
 
-func (x *SitePluginNeighborRoutesListRequest) Reset() {
-	*x = SitePluginNeighborRoutesListRequest{}
+func (x *SiteQueryPostsResponse) Reset() {
+	*x = SiteQueryPostsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_site_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1369,13 +1385,13 @@ func (x *SitePluginNeighborRoutesListRequest) Reset() {
 	}
 }
 
-func (x *SitePluginNeighborRoutesListRequest) String() string {
+func (x *SiteQueryPostsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SitePluginNeighborRoutesListRequest) ProtoMessage() {}
+func (*SiteQueryPostsResponse) ProtoMessage() {}
 
-func (x *SitePluginNeighborRoutesListRequest) ProtoReflect() protoreflect.Message {
+func (x *SiteQueryPostsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_site_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1387,28 +1403,28 @@ func (x *SitePluginNeighborRoutesListRequest) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SitePluginNeighborRoutesListRequest.ProtoReflect.Descriptor instead.
-func (*SitePluginNeighborRoutesListRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SiteQueryPostsResponse.ProtoReflect.Descriptor instead.
+func (*SiteQueryPostsResponse) Descriptor() ([]byte, []int) {
 	return file_site_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *SitePluginNeighborRoutesListRequest) GetPluginname() string {
+func (x *SiteQueryPostsResponse) GetResult() *structpb.Struct {
 	if x != nil {
-		return x.Pluginname
+		return x.Result
 	}
-	return ""
+	return nil
 }
 
-type SitePluginNeighborRoutesListResponse struct {
+type SiteContentTypesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Routes []*structpb.Struct `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes,omitempty"`
+	Contenttypes []*structpb.Struct `protobuf:"bytes,1,rep,name=contenttypes,proto3" json:"contenttypes,omitempty"`
 }
 
-func (x *SitePluginNeighborRoutesListResponse) Reset() {
-	*x = SitePluginNeighborRoutesListResponse{}
+func (x *SiteContentTypesResponse) Reset() {
+	*x = SiteContentTypesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_site_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1416,13 +1432,13 @@ func (x *SitePluginNeighborRoutesListResponse) Reset() {
 	}
 }
 
-func (x *SitePluginNeighborRoutesListResponse) String() string {
+func (x *SiteContentTypesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SitePluginNeighborRoutesListResponse) ProtoMessage() {}
+func (*SiteContentTypesResponse) ProtoMessage() {}
 
-func (x *SitePluginNeighborRoutesListResponse) ProtoReflect() protoreflect.Message {
+func (x *SiteContentTypesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_site_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1434,29 +1450,28 @@ func (x *SitePluginNeighborRoutesListResponse) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SitePluginNeighborRoutesListResponse.ProtoReflect.Descriptor instead.
-func (*SitePluginNeighborRoutesListResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SiteContentTypesResponse.ProtoReflect.Descriptor instead.
+func (*SiteContentTypesResponse) Descriptor() ([]byte, []int) {
 	return file_site_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *SitePluginNeighborRoutesListResponse) GetRoutes() []*structpb.Struct {
+func (x *SiteContentTypesResponse) GetContenttypes() []*structpb.Struct {
 	if x != nil {
-		return x.Routes
+		return x.Contenttypes
 	}
 	return nil
 }
 
-type SiteUserPersistRequest struct {
+type SitePostByIDRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Requestid string `protobuf:"bytes,1,opt,name=requestid,proto3" json:"requestid,omitempty"`
-	Persist   bool   `protobuf:"varint,2,opt,name=persist,proto3" json:"persist,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *SiteUserPersistRequest) Reset() {
-	*x = SiteUserPersistRequest{}
+func (x *SitePostByIDRequest) Reset() {
+	*x = SitePostByIDRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_site_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1464,13 +1479,13 @@ func (x *SiteUserPersistRequest) Reset() {
 	}
 }
 
-func (x *SiteUserPersistRequest) String() string {
+func (x *SitePostByIDRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SiteUserPersistRequest) ProtoMessage() {}
+func (*SitePostByIDRequest) ProtoMessage() {}
 
-func (x *SiteUserPersistRequest) ProtoReflect() protoreflect.Message {
+func (x *SitePostByIDRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_site_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1482,36 +1497,28 @@ func (x *SiteUserPersistRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SiteUserPersistRequest.ProtoReflect.Descriptor instead.
-func (*SiteUserPersistRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SitePostByIDRequest.ProtoReflect.Descriptor instead.
+func (*SitePostByIDRequest) Descriptor() ([]byte, []int) {
 	return file_site_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *SiteUserPersistRequest) GetRequestid() string {
+func (x *SitePostByIDRequest) GetId() string {
 	if x != nil {
-		return x.Requestid
+		return x.Id
 	}
 	return ""
 }
 
-func (x *SiteUserPersistRequest) GetPersist() bool {
-	if x != nil {
-		return x.Persist
-	}
-	return false
-}
-
-type SiteUserLoginRequest struct {
+type SitePostByIDResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Requestid string `protobuf:"bytes,1,opt,name=requestid,proto3" json:"requestid,omitempty"`
-	Username  string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Post *structpb.Struct `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
 }
 
-func (x *SiteUserLoginRequest) Reset() {
-	*x = SiteUserLoginRequest{}
+func (x *SitePostByIDResponse) Reset() {
+	*x = SitePostByIDResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_site_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1519,13 +1526,13 @@ func (x *SiteUserLoginRequest) Reset() {
 	}
 }
 
-func (x *SiteUserLoginRequest) String() string {
+func (x *SitePostByIDResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SiteUserLoginRequest) ProtoMessage() {}
+func (*SitePostByIDResponse) ProtoMessage() {}
 
-func (x *SiteUserLoginRequest) ProtoReflect() protoreflect.Message {
+func (x *SitePostByIDResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_site_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1537,35 +1544,28 @@ func (x *SiteUserLoginRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SiteUserLoginRequest.ProtoReflect.Descriptor instead.
-func (*SiteUserLoginRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SitePostByIDResponse.ProtoReflect.Descriptor instead.
+func (*SitePostByIDResponse) Descriptor() ([]byte, []int) {
 	return file_site_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *SiteUserLoginRequest) GetRequestid() string {
-	if x != nil {
-		return x.Requestid
-	}
-	return ""
-}
-
-func (x *SiteUserLoginRequest) GetUsername() string {
+func (x *SitePostByIDResponse) GetPost() *structpb.Struct {
 	if x != nil {
-		return x.Username
+		return x.Post
 	}
-	return ""
+	return nil
 }
 
-type SiteAuthenticatedUserRequest struct {
+type SiteDeletePostByIDRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Requestid string `protobuf:"bytes,1,opt,name=requestid,proto3" json:"requestid,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *SiteAuthenticatedUserRequest) Reset() {
-	*x = SiteAuthenticatedUserRequest{}
+func (x *SiteDeletePostByIDRequest) Reset() {
+	*x = SiteDeletePostByIDRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_site_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1573,13 +1573,13 @@ func (x *SiteAuthenticatedUserRequest) Reset() {
 	}
 }
 
-func (x *SiteAuthenticatedUserRequest) String() string {
+func (x *SiteDeletePostByIDRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SiteAuthenticatedUserRequest) ProtoMessage() {}
+func (*SiteDeletePostByIDRequest) ProtoMessage() {}
 
-func (x *SiteAuthenticatedUserRequest) ProtoReflect() protoreflect.Message {
+func (x *SiteDeletePostByIDRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_site_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1591,28 +1591,30 @@ func (x *SiteAuthenticatedUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SiteAuthenticatedUserRequest.ProtoReflect.Descriptor instead.
-func (*SiteAuthenticatedUserRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SiteDeletePostByIDRequest.ProtoReflect.Descriptor instead.
+func (*SiteDeletePostByIDRequest) Descriptor() ([]byte, []int) {
 	return file_site_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *SiteAuthenticatedUserRequest) GetRequestid() string {
+func (x *SiteDeletePostByIDRequest) GetId() string {
 	if x != nil {
-		return x.Requestid
+		return x.Id
 	}
 	return ""
 }
 
-type SiteAuthenticatedUserResponse struct {
+type SiteSavePostAsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Requestid string           `protobuf:"bytes,1,opt,name=requestid,proto3" json:"requestid,omitempty"`
+	Id        string           `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Post      *structpb.Struct `protobuf:"bytes,3,opt,name=post,proto3" json:"post,omitempty"`
 }
 
-func (x *SiteAuthenticatedUserResponse) Reset() {
-	*x = SiteAuthenticatedUserResponse{}
+func (x *SiteSavePostAsRequest) Reset() {
+	*x = SiteSavePostAsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_site_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1620,13 +1622,13 @@ func (x *SiteAuthenticatedUserResponse) Reset() {
 	}
 }
 
-func (x *SiteAuthenticatedUserResponse) String() string {
+func (x *SiteSavePostAsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SiteAuthenticatedUserResponse) ProtoMessage() {}
+func (*SiteSavePostAsRequest) ProtoMessage() {}
 
-func (x *SiteAuthenticatedUserResponse) ProtoReflect() protoreflect.Message {
+func (x *SiteSavePostAsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_site_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1638,28 +1640,42 @@ func (x *SiteAuthenticatedUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SiteAuthenticatedUserResponse.ProtoReflect.Descriptor instead.
-func (*SiteAuthenticatedUserResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SiteSavePostAsRequest.ProtoReflect.Descriptor instead.
+func (*SiteSavePostAsRequest) Descriptor() ([]byte, []int) {
 	return file_site_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *SiteAuthenticatedUserResponse) GetUsername() string {
+func (x *SiteSavePostAsRequest) GetRequestid() string {
 	if x != nil {
-		return x.Username
+		return x.Requestid
 	}
 	return ""
 }
 
-type SiteUserLogoutRequest struct {
+func (x *SiteSavePostAsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SiteSavePostAsRequest) GetPost() *structpb.Struct {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+type SitePostRevisionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Requestid string `protobuf:"bytes,1,opt,name=requestid,proto3" json:"requestid,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *SiteUserLogoutRequest) Reset() {
-	*x = SiteUserLogoutRequest{}
+func (x *SitePostRevisionsRequest) Reset() {
+	*x = SitePostRevisionsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_site_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1667,13 +1683,13 @@ func (x *SiteUserLogoutRequest) Reset() {
 	}
 }
 
-func (x *SiteUserLogoutRequest) String() string {
+func (x *SitePostRevisionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SiteUserLogoutRequest) ProtoMessage() {}
+func (*SitePostRevisionsRequest) ProtoMessage() {}
 
-func (x *SiteUserLogoutRequest) ProtoReflect() protoreflect.Message {
+func (x *SitePostRevisionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_site_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1685,28 +1701,28 @@ func (x *SiteUserLogoutRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SiteUserLogoutRequest.ProtoReflect.Descriptor instead.
-func (*SiteUserLogoutRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SitePostRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*SitePostRevisionsRequest) Descriptor() ([]byte, []int) {
 	return file_site_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *SiteUserLogoutRequest) GetRequestid() string {
+func (x *SitePostRevisionsRequest) GetId() string {
 	if x != nil {
-		return x.Requestid
+		return x.Id
 	}
 	return ""
 }
 
-type SiteLogoutAllUsersRequest struct {
+type SitePostRevisionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Requestid string `protobuf:"bytes,1,opt,name=requestid,proto3" json:"requestid,omitempty"`
+	Revisions []*structpb.Struct `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
 }
 
-func (x *SiteLogoutAllUsersRequest) Reset() {
-	*x = SiteLogoutAllUsersRequest{}
+func (x *SitePostRevisionsResponse) Reset() {
+	*x = SitePostRevisionsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_site_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1714,13 +1730,13 @@ func (x *SiteLogoutAllUsersRequest) Reset() {
 	}
 }
 
-func (x *SiteLogoutAllUsersRequest) String() string {
+func (x *SitePostRevisionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SiteLogoutAllUsersRequest) ProtoMessage() {}
+func (*SitePostRevisionsResponse) ProtoMessage() {}
 
-func (x *SiteLogoutAllUsersRequest) ProtoReflect() protoreflect.Message {
+func (x *SitePostRevisionsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_site_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1732,28 +1748,29 @@ func (x *SiteLogoutAllUsersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SiteLogoutAllUsersRequest.ProtoReflect.Descriptor instead.
-func (*SiteLogoutAllUsersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SitePostRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*SitePostRevisionsResponse) Descriptor() ([]byte, []int) {
 	return file_site_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *SiteLogoutAllUsersRequest) GetRequestid() string {
+func (x *SitePostRevisionsResponse) GetRevisions() []*structpb.Struct {
 	if x != nil {
-		return x.Requestid
+		return x.Revisions
 	}
-	return ""
+	return nil
 }
 
-type SiteSetCSRFRequest struct {
+type SitePostRevisionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Requestid string `protobuf:"bytes,1,opt,name=requestid,proto3" json:"requestid,omitempty"`
+	Id         string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Revisionid string `protobuf:"bytes,2,opt,name=revisionid,proto3" json:"revisionid,omitempty"`
 }
 
-func (x *SiteSetCSRFRequest) Reset() {
-	*x = SiteSetCSRFRequest{}
+func (x *SitePostRevisionRequest) Reset() {
+	*x = SitePostRevisionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_site_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1761,13 +1778,13 @@ func (x *SiteSetCSRFRequest) Reset() {
 	}
 }
 
-func (x *SiteSetCSRFRequest) String() string {
+func (x *SitePostRevisionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SiteSetCSRFRequest) ProtoMessage() {}
+func (*SitePostRevisionRequest) ProtoMessage() {}
 
-func (x *SiteSetCSRFRequest) ProtoReflect() protoreflect.Message {
+func (x *SitePostRevisionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_site_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1779,28 +1796,35 @@ func (x *SiteSetCSRFRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SiteSetCSRFRequest.ProtoReflect.Descriptor instead.
-func (*SiteSetCSRFRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SitePostRevisionRequest.ProtoReflect.Descriptor instead.
+func (*SitePostRevisionRequest) Descriptor() ([]byte, []int) {
 	return file_site_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *SiteSetCSRFRequest) GetRequestid() string {
+func (x *SitePostRevisionRequest) GetId() string {
 	if x != nil {
-		return x.Requestid
+		return x.Id
 	}
 	return ""
 }
 
-type SiteSetCSRFResponse struct {
+func (x *SitePostRevisionRequest) GetRevisionid() string {
+	if x != nil {
+		return x.Revisionid
+	}
+	return ""
+}
+
+type SitePostRevisionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Revision *structpb.Struct `protobuf:"bytes,1,opt,name=revision,proto3" json:"revision,omitempty"`
 }
 
-func (x *SiteSetCSRFResponse) Reset() {
-	*x = SiteSetCSRFResponse{}
+func (x *SitePostRevisionResponse) Reset() {
+	*x = SitePostRevisionResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_site_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1808,13 +1832,13 @@ func (x *SiteSetCSRFResponse) Reset() {
 	}
 }
 
-func (x *SiteSetCSRFResponse) String() string {
+func (x *SitePostRevisionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SiteSetCSRFResponse) ProtoMessage() {}
+func (*SitePostRevisionResponse) ProtoMessage() {}
 
-func (x *SiteSetCSRFResponse) ProtoReflect() protoreflect.Message {
+func (x *SitePostRevisionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_site_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1826,29 +1850,28 @@ func (x *SiteSetCSRFResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SiteSetCSRFResponse.ProtoReflect.Descriptor instead.
-func (*SiteSetCSRFResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SitePostRevisionResponse.ProtoReflect.Descriptor instead.
+func (*SitePostRevisionResponse) Descriptor() ([]byte, []int) {
 	return file_site_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *SiteSetCSRFResponse) GetToken() string {
+func (x *SitePostRevisionResponse) GetRevision() *structpb.Struct {
 	if x != nil {
-		return x.Token
+		return x.Revision
 	}
-	return ""
+	return nil
 }
 
-type SiteCSRFRequest struct {
+type SitePostRevisionDiffResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Requestid string `protobuf:"bytes,1,opt,name=requestid,proto3" json:"requestid,omitempty"`
-	Token     string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	Diff string `protobuf:"bytes,1,opt,name=diff,proto3" json:"diff,omitempty"`
 }
 
-func (x *SiteCSRFRequest) Reset() {
-	*x = SiteCSRFRequest{}
+func (x *SitePostRevisionDiffResponse) Reset() {
+	*x = SitePostRevisionDiffResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_site_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1856,13 +1879,13 @@ func (x *SiteCSRFRequest) Reset() {
 	}
 }
 
-func (x *SiteCSRFRequest) String() string {
+func (x *SitePostRevisionDiffResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SiteCSRFRequest) ProtoMessage() {}
+func (*SitePostRevisionDiffResponse) ProtoMessage() {}
 
-func (x *SiteCSRFRequest) ProtoReflect() protoreflect.Message {
+func (x *SitePostRevisionDiffResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_site_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1874,35 +1897,30 @@ func (x *SiteCSRFRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SiteCSRFRequest.ProtoReflect.Descriptor instead.
-func (*SiteCSRFRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SitePostRevisionDiffResponse.ProtoReflect.Descriptor instead.
+func (*SitePostRevisionDiffResponse) Descriptor() ([]byte, []int) {
 	return file_site_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *SiteCSRFRequest) GetRequestid() string {
-	if x != nil {
-		return x.Requestid
-	}
-	return ""
-}
-
-func (x *SiteCSRFRequest) GetToken() string {
+func (x *SitePostRevisionDiffResponse) GetDiff() string {
 	if x != nil {
-		return x.Token
+		return x.Diff
 	}
 	return ""
 }
 
-type SiteCSRFResponse struct {
+type SiteRestorePostRevisionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Requestid  string `protobuf:"bytes,1,opt,name=requestid,proto3" json:"requestid,omitempty"`
+	Id         string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Revisionid string `protobuf:"bytes,3,opt,name=revisionid,proto3" json:"revisionid,omitempty"`
 }
 
-func (x *SiteCSRFResponse) Reset() {
-	*x = SiteCSRFResponse{}
+func (x *SiteRestorePostRevisionRequest) Reset() {
+	*x = SiteRestorePostRevisionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_site_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1910,13 +1928,13 @@ func (x *SiteCSRFResponse) Reset() {
 	}
 }
 
-func (x *SiteCSRFResponse) String() string {
+func (x *SiteRestorePostRevisionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SiteCSRFResponse) ProtoMessage() {}
+func (*SiteRestorePostRevisionRequest) ProtoMessage() {}
 
-func (x *SiteCSRFResponse) ProtoReflect() protoreflect.Message {
+func (x *SiteRestorePostRevisionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_site_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1928,29 +1946,44 @@ func (x *SiteCSRFResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SiteCSRFResponse.ProtoReflect.Descriptor instead.
-func (*SiteCSRFResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SiteRestorePostRevisionRequest.ProtoReflect.Descriptor instead.
+func (*SiteRestorePostRevisionRequest) Descriptor() ([]byte, []int) {
 	return file_site_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *SiteCSRFResponse) GetValid() bool {
+func (x *SiteRestorePostRevisionRequest) GetRequestid() string {
 	if x != nil {
-		return x.Valid
+		return x.Requestid
 	}
-	return false
+	return ""
 }
 
-type SiteSessionValueRequest struct {
+func (x *SiteRestorePostRevisionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SiteRestorePostRevisionRequest) GetRevisionid() string {
+	if x != nil {
+		return x.Revisionid
+	}
+	return ""
+}
+
+type SiteCreatePostPreviewRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Requestid string `protobuf:"bytes,1,opt,name=requestid,proto3" json:"requestid,omitempty"`
-	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Id        string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Expires   int64  `protobuf:"varint,3,opt,name=expires,proto3" json:"expires,omitempty"`
 }
 
-func (x *SiteSessionValueRequest) Reset() {
-	*x = SiteSessionValueRequest{}
+func (x *SiteCreatePostPreviewRequest) Reset() {
+	*x = SiteCreatePostPreviewRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_site_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1958,13 +1991,13 @@ func (x *SiteSessionValueRequest) Reset() {
 	}
 }
 
-func (x *SiteSessionValueRequest) String() string {
+func (x *SiteCreatePostPreviewRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SiteSessionValueRequest) ProtoMessage() {}
+func (*SiteCreatePostPreviewRequest) ProtoMessage() {}
 
-func (x *SiteSessionValueRequest) ProtoReflect() protoreflect.Message {
+func (x *SiteCreatePostPreviewRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_site_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1976,35 +2009,42 @@ func (x *SiteSessionValueRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SiteSessionValueRequest.ProtoReflect.Descriptor instead.
-func (*SiteSessionValueRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SiteCreatePostPreviewRequest.ProtoReflect.Descriptor instead.
+func (*SiteCreatePostPreviewRequest) Descriptor() ([]byte, []int) {
 	return file_site_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *SiteSessionValueRequest) GetRequestid() string {
+func (x *SiteCreatePostPreviewRequest) GetRequestid() string {
 	if x != nil {
 		return x.Requestid
 	}
 	return ""
 }
 
-func (x *SiteSessionValueRequest) GetName() string {
+func (x *SiteCreatePostPreviewRequest) GetId() string {
 	if x != nil {
-		return x.Name
+		return x.Id
 	}
 	return ""
 }
 
-type SiteSessionValueResponse struct {
+func (x *SiteCreatePostPreviewRequest) GetExpires() int64 {
+	if x != nil {
+		return x.Expires
+	}
+	return 0
+}
+
+type SiteCreatePostPreviewResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Preview *structpb.Struct `protobuf:"bytes,1,opt,name=preview,proto3" json:"preview,omitempty"`
 }
 
-func (x *SiteSessionValueResponse) Reset() {
-	*x = SiteSessionValueResponse{}
+func (x *SiteCreatePostPreviewResponse) Reset() {
+	*x = SiteCreatePostPreviewResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_site_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2012,13 +2052,13 @@ func (x *SiteSessionValueResponse) Reset() {
 	}
 }
 
-func (x *SiteSessionValueResponse) String() string {
+func (x *SiteCreatePostPreviewResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SiteSessionValueResponse) ProtoMessage() {}
+func (*SiteCreatePostPreviewResponse) ProtoMessage() {}
 
-func (x *SiteSessionValueResponse) ProtoReflect() protoreflect.Message {
+func (x *SiteCreatePostPreviewResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_site_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2030,30 +2070,28 @@ func (x *SiteSessionValueResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SiteSessionValueResponse.ProtoReflect.Descriptor instead.
-func (*SiteSessionValueResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SiteCreatePostPreviewResponse.ProtoReflect.Descriptor instead.
+func (*SiteCreatePostPreviewResponse) Descriptor() ([]byte, []int) {
 	return file_site_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *SiteSessionValueResponse) GetValue() string {
+func (x *SiteCreatePostPreviewResponse) GetPreview() *structpb.Struct {
 	if x != nil {
-		return x.Value
+		return x.Preview
 	}
-	return ""
+	return nil
 }
 
-type SiteSetSessionValueRequest struct {
+type SitePostPreviewsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Requestid string `protobuf:"bytes,1,opt,name=requestid,proto3" json:"requestid,omitempty"`
-	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Value     string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *SiteSetSessionValueRequest) Reset() {
-	*x = SiteSetSessionValueRequest{}
+func (x *SitePostPreviewsRequest) Reset() {
+	*x = SitePostPreviewsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_site_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2061,13 +2099,13 @@ func (x *SiteSetSessionValueRequest) Reset() {
 	}
 }
 
-func (x *SiteSetSessionValueRequest) String() string {
+func (x *SitePostPreviewsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SiteSetSessionValueRequest) ProtoMessage() {}
+func (*SitePostPreviewsRequest) ProtoMessage() {}
 
-func (x *SiteSetSessionValueRequest) ProtoReflect() protoreflect.Message {
+func (x *SitePostPreviewsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_site_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2079,43 +2117,28 @@ func (x *SiteSetSessionValueRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SiteSetSessionValueRequest.ProtoReflect.Descriptor instead.
-func (*SiteSetSessionValueRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SitePostPreviewsRequest.ProtoReflect.Descriptor instead.
+func (*SitePostPreviewsRequest) Descriptor() ([]byte, []int) {
 	return file_site_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *SiteSetSessionValueRequest) GetRequestid() string {
-	if x != nil {
-		return x.Requestid
-	}
-	return ""
-}
-
-func (x *SiteSetSessionValueRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *SiteSetSessionValueRequest) GetValue() string {
+func (x *SitePostPreviewsRequest) GetId() string {
 	if x != nil {
-		return x.Value
+		return x.Id
 	}
 	return ""
 }
 
-type SiteDeleteSessionValueRequest struct {
+type SitePostPreviewsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Requestid string `protobuf:"bytes,1,opt,name=requestid,proto3" json:"requestid,omitempty"`
-	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Previews []*structpb.Struct `protobuf:"bytes,1,rep,name=previews,proto3" json:"previews,omitempty"`
 }
 
-func (x *SiteDeleteSessionValueRequest) Reset() {
-	*x = SiteDeleteSessionValueRequest{}
+func (x *SitePostPreviewsResponse) Reset() {
+	*x = SitePostPreviewsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_site_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2123,13 +2146,13 @@ func (x *SiteDeleteSessionValueRequest) Reset() {
 	}
 }
 
-func (x *SiteDeleteSessionValueRequest) String() string {
+func (x *SitePostPreviewsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SiteDeleteSessionValueRequest) ProtoMessage() {}
+func (*SitePostPreviewsResponse) ProtoMessage() {}
 
-func (x *SiteDeleteSessionValueRequest) ProtoReflect() protoreflect.Message {
+func (x *SitePostPreviewsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_site_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2141,35 +2164,29 @@ func (x *SiteDeleteSessionValueRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SiteDeleteSessionValueRequest.ProtoReflect.Descriptor instead.
-func (*SiteDeleteSessionValueRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SitePostPreviewsResponse.ProtoReflect.Descriptor instead.
+func (*SitePostPreviewsResponse) Descriptor() ([]byte, []int) {
 	return file_site_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *SiteDeleteSessionValueRequest) GetRequestid() string {
-	if x != nil {
-		return x.Requestid
-	}
-	return ""
-}
-
-func (x *SiteDeleteSessionValueRequest) GetName() string {
+func (x *SitePostPreviewsResponse) GetPreviews() []*structpb.Struct {
 	if x != nil {
-		return x.Name
+		return x.Previews
 	}
-	return ""
+	return nil
 }
 
-type SitePluginNeighborSettingsListRequest struct {
+type SiteRevokePostPreviewRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pluginname string `protobuf:"bytes,1,opt,name=pluginname,proto3" json:"pluginname,omitempty"`
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Previewid string `protobuf:"bytes,2,opt,name=previewid,proto3" json:"previewid,omitempty"`
 }
 
-func (x *SitePluginNeighborSettingsListRequest) Reset() {
-	*x = SitePluginNeighborSettingsListRequest{}
+func (x *SiteRevokePostPreviewRequest) Reset() {
+	*x = SiteRevokePostPreviewRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_site_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2177,13 +2194,13 @@ func (x *SitePluginNeighborSettingsListRequest) Reset() {
 	}
 }
 
-func (x *SitePluginNeighborSettingsListRequest) String() string {
+func (x *SiteRevokePostPreviewRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SitePluginNeighborSettingsListRequest) ProtoMessage() {}
+func (*SiteRevokePostPreviewRequest) ProtoMessage() {}
 
-func (x *SitePluginNeighborSettingsListRequest) ProtoReflect() protoreflect.Message {
+func (x *SiteRevokePostPreviewRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_site_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2195,28 +2212,36 @@ func (x *SitePluginNeighborSettingsListRequest) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SitePluginNeighborSettingsListRequest.ProtoReflect.Descriptor instead.
-func (*SitePluginNeighborSettingsListRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SiteRevokePostPreviewRequest.ProtoReflect.Descriptor instead.
+func (*SiteRevokePostPreviewRequest) Descriptor() ([]byte, []int) {
 	return file_site_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *SitePluginNeighborSettingsListRequest) GetPluginname() string {
+func (x *SiteRevokePostPreviewRequest) GetId() string {
 	if x != nil {
-		return x.Pluginname
+		return x.Id
 	}
 	return ""
 }
 
-type SitePluginNeighborSettingsListResponse struct {
+func (x *SiteRevokePostPreviewRequest) GetPreviewid() string {
+	if x != nil {
+		return x.Previewid
+	}
+	return ""
+}
+
+type SitePreviewPostBySlugRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Settings []*structpb.Struct `protobuf:"bytes,1,rep,name=settings,proto3" json:"settings,omitempty"`
+	Requestid string `protobuf:"bytes,1,opt,name=requestid,proto3" json:"requestid,omitempty"`
+	Slug      string `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
 }
 
-func (x *SitePluginNeighborSettingsListResponse) Reset() {
-	*x = SitePluginNeighborSettingsListResponse{}
+func (x *SitePreviewPostBySlugRequest) Reset() {
+	*x = SitePreviewPostBySlugRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_site_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2224,13 +2249,13 @@ func (x *SitePluginNeighborSettingsListResponse) Reset() {
 	}
 }
 
-func (x *SitePluginNeighborSettingsListResponse) String() string {
+func (x *SitePreviewPostBySlugRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SitePluginNeighborSettingsListResponse) ProtoMessage() {}
+func (*SitePreviewPostBySlugRequest) ProtoMessage() {}
 
-func (x *SitePluginNeighborSettingsListResponse) ProtoReflect() protoreflect.Message {
+func (x *SitePreviewPostBySlugRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_site_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2242,29 +2267,35 @@ func (x *SitePluginNeighborSettingsListResponse) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SitePluginNeighborSettingsListResponse.ProtoReflect.Descriptor instead.
-func (*SitePluginNeighborSettingsListResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SitePreviewPostBySlugRequest.ProtoReflect.Descriptor instead.
+func (*SitePreviewPostBySlugRequest) Descriptor() ([]byte, []int) {
 	return file_site_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *SitePluginNeighborSettingsListResponse) GetSettings() []*structpb.Struct {
+func (x *SitePreviewPostBySlugRequest) GetRequestid() string {
 	if x != nil {
-		return x.Settings
+		return x.Requestid
 	}
-	return nil
+	return ""
 }
 
-type SiteSetPluginSettingRequest struct {
+func (x *SitePreviewPostBySlugRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+type SitePageChildrenRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Settingname string `protobuf:"bytes,1,opt,name=settingname,proto3" json:"settingname,omitempty"`
-	Value       string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *SiteSetPluginSettingRequest) Reset() {
-	*x = SiteSetPluginSettingRequest{}
+func (x *SitePageChildrenRequest) Reset() {
+	*x = SitePageChildrenRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_site_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2272,13 +2303,13 @@ func (x *SiteSetPluginSettingRequest) Reset() {
 	}
 }
 
-func (x *SiteSetPluginSettingRequest) String() string {
+func (x *SitePageChildrenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SiteSetPluginSettingRequest) ProtoMessage() {}
+func (*SitePageChildrenRequest) ProtoMessage() {}
 
-func (x *SiteSetPluginSettingRequest) ProtoReflect() protoreflect.Message {
+func (x *SitePageChildrenRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_site_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2290,35 +2321,28 @@ func (x *SiteSetPluginSettingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SiteSetPluginSettingRequest.ProtoReflect.Descriptor instead.
-func (*SiteSetPluginSettingRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SitePageChildrenRequest.ProtoReflect.Descriptor instead.
+func (*SitePageChildrenRequest) Descriptor() ([]byte, []int) {
 	return file_site_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *SiteSetPluginSettingRequest) GetSettingname() string {
-	if x != nil {
-		return x.Settingname
-	}
-	return ""
-}
-
-func (x *SiteSetPluginSettingRequest) GetValue() string {
+func (x *SitePageChildrenRequest) GetId() string {
 	if x != nil {
-		return x.Value
+		return x.Id
 	}
 	return ""
 }
 
-type SitePluginSettingBoolRequest struct {
+type SiteBreadcrumbsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Fieldname string `protobuf:"bytes,1,opt,name=fieldname,proto3" json:"fieldname,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *SitePluginSettingBoolRequest) Reset() {
-	*x = SitePluginSettingBoolRequest{}
+func (x *SiteBreadcrumbsRequest) Reset() {
+	*x = SiteBreadcrumbsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_site_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2326,13 +2350,13 @@ func (x *SitePluginSettingBoolRequest) Reset() {
 	}
 }
 
-func (x *SitePluginSettingBoolRequest) String() string {
+func (x *SiteBreadcrumbsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SitePluginSettingBoolRequest) ProtoMessage() {}
+func (*SiteBreadcrumbsRequest) ProtoMessage() {}
 
-func (x *SitePluginSettingBoolRequest) ProtoReflect() protoreflect.Message {
+func (x *SiteBreadcrumbsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_site_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2344,28 +2368,28 @@ func (x *SitePluginSettingBoolRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SitePluginSettingBoolRequest.ProtoReflect.Descriptor instead.
-func (*SitePluginSettingBoolRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SiteBreadcrumbsRequest.ProtoReflect.Descriptor instead.
+func (*SiteBreadcrumbsRequest) Descriptor() ([]byte, []int) {
 	return file_site_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *SitePluginSettingBoolRequest) GetFieldname() string {
+func (x *SiteBreadcrumbsRequest) GetId() string {
 	if x != nil {
-		return x.Fieldname
+		return x.Id
 	}
 	return ""
 }
 
-type SitePluginSettingBoolResponse struct {
+type SiteMenusResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Value bool `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	Menus []*structpb.Struct `protobuf:"bytes,1,rep,name=menus,proto3" json:"menus,omitempty"`
 }
 
-func (x *SitePluginSettingBoolResponse) Reset() {
-	*x = SitePluginSettingBoolResponse{}
+func (x *SiteMenusResponse) Reset() {
+	*x = SiteMenusResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_site_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2373,13 +2397,13 @@ func (x *SitePluginSettingBoolResponse) Reset() {
 	}
 }
 
-func (x *SitePluginSettingBoolResponse) String() string {
+func (x *SiteMenusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SitePluginSettingBoolResponse) ProtoMessage() {}
+func (*SiteMenusResponse) ProtoMessage() {}
 
-func (x *SitePluginSettingBoolResponse) ProtoReflect() protoreflect.Message {
+func (x *SiteMenusResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_site_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2391,28 +2415,28 @@ func (x *SitePluginSettingBoolResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SitePluginSettingBoolResponse.ProtoReflect.Descriptor instead.
-func (*SitePluginSettingBoolResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SiteMenusResponse.ProtoReflect.Descriptor instead.
+func (*SiteMenusResponse) Descriptor() ([]byte, []int) {
 	return file_site_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *SitePluginSettingBoolResponse) GetValue() bool {
+func (x *SiteMenusResponse) GetMenus() []*structpb.Struct {
 	if x != nil {
-		return x.Value
+		return x.Menus
 	}
-	return false
+	return nil
 }
 
-type SitePluginSettingStringRequest struct {
+type SiteMenuRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Fieldname string `protobuf:"bytes,1,opt,name=fieldname,proto3" json:"fieldname,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *SitePluginSettingStringRequest) Reset() {
-	*x = SitePluginSettingStringRequest{}
+func (x *SiteMenuRequest) Reset() {
+	*x = SiteMenuRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_site_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2420,13 +2444,13 @@ func (x *SitePluginSettingStringRequest) Reset() {
 	}
 }
 
-func (x *SitePluginSettingStringRequest) String() string {
+func (x *SiteMenuRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SitePluginSettingStringRequest) ProtoMessage() {}
+func (*SiteMenuRequest) ProtoMessage() {}
 
-func (x *SitePluginSettingStringRequest) ProtoReflect() protoreflect.Message {
+func (x *SiteMenuRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_site_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2438,28 +2462,28 @@ func (x *SitePluginSettingStringRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SitePluginSettingStringRequest.ProtoReflect.Descriptor instead.
-func (*SitePluginSettingStringRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SiteMenuRequest.ProtoReflect.Descriptor instead.
+func (*SiteMenuRequest) Descriptor() ([]byte, []int) {
 	return file_site_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *SitePluginSettingStringRequest) GetFieldname() string {
+func (x *SiteMenuRequest) GetName() string {
 	if x != nil {
-		return x.Fieldname
+		return x.Name
 	}
 	return ""
 }
 
-type SitePluginSettingStringResponse struct {
+type SiteMenuResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Menu *structpb.Struct `protobuf:"bytes,1,opt,name=menu,proto3" json:"menu,omitempty"`
 }
 
-func (x *SitePluginSettingStringResponse) Reset() {
-	*x = SitePluginSettingStringResponse{}
+func (x *SiteMenuResponse) Reset() {
+	*x = SiteMenuResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_site_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2467,13 +2491,13 @@ func (x *SitePluginSettingStringResponse) Reset() {
 	}
 }
 
-func (x *SitePluginSettingStringResponse) String() string {
+func (x *SiteMenuResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SitePluginSettingStringResponse) ProtoMessage() {}
+func (*SiteMenuResponse) ProtoMessage() {}
 
-func (x *SitePluginSettingStringResponse) ProtoReflect() protoreflect.Message {
+func (x *SiteMenuResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_site_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2485,28 +2509,28 @@ func (x *SitePluginSettingStringResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SitePluginSettingStringResponse.ProtoReflect.Descriptor instead.
-func (*SitePluginSettingStringResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SiteMenuResponse.ProtoReflect.Descriptor instead.
+func (*SiteMenuResponse) Descriptor() ([]byte, []int) {
 	return file_site_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *SitePluginSettingStringResponse) GetValue() string {
+func (x *SiteMenuResponse) GetMenu() *structpb.Struct {
 	if x != nil {
-		return x.Value
+		return x.Menu
 	}
-	return ""
+	return nil
 }
 
-type SitePluginSettingRequest struct {
+type SiteSaveMenuRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Fieldname string `protobuf:"bytes,1,opt,name=fieldname,proto3" json:"fieldname,omitempty"`
+	Menu *structpb.Struct `protobuf:"bytes,1,opt,name=menu,proto3" json:"menu,omitempty"`
 }
 
-func (x *SitePluginSettingRequest) Reset() {
-	*x = SitePluginSettingRequest{}
+func (x *SiteSaveMenuRequest) Reset() {
+	*x = SiteSaveMenuRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_site_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2514,13 +2538,13 @@ func (x *SitePluginSettingRequest) Reset() {
 	}
 }
 
-func (x *SitePluginSettingRequest) String() string {
+func (x *SiteSaveMenuRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SitePluginSettingRequest) ProtoMessage() {}
+func (*SiteSaveMenuRequest) ProtoMessage() {}
 
-func (x *SitePluginSettingRequest) ProtoReflect() protoreflect.Message {
+func (x *SiteSaveMenuRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_site_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2532,28 +2556,28 @@ func (x *SitePluginSettingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SitePluginSettingRequest.ProtoReflect.Descriptor instead.
-func (*SitePluginSettingRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SiteSaveMenuRequest.ProtoReflect.Descriptor instead.
+func (*SiteSaveMenuRequest) Descriptor() ([]byte, []int) {
 	return file_site_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *SitePluginSettingRequest) GetFieldname() string {
+func (x *SiteSaveMenuRequest) GetMenu() *structpb.Struct {
 	if x != nil {
-		return x.Fieldname
+		return x.Menu
 	}
-	return ""
+	return nil
 }
 
-type SitePluginSettingResponse struct {
+type SiteDeleteMenuRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Value *anypb.Any `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *SitePluginSettingResponse) Reset() {
-	*x = SitePluginSettingResponse{}
+func (x *SiteDeleteMenuRequest) Reset() {
+	*x = SiteDeleteMenuRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_site_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2561,13 +2585,13 @@ func (x *SitePluginSettingResponse) Reset() {
 	}
 }
 
-func (x *SitePluginSettingResponse) String() string {
+func (x *SiteDeleteMenuRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SitePluginSettingResponse) ProtoMessage() {}
+func (*SiteDeleteMenuRequest) ProtoMessage() {}
 
-func (x *SitePluginSettingResponse) ProtoReflect() protoreflect.Message {
+func (x *SiteDeleteMenuRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_site_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2579,30 +2603,28 @@ func (x *SitePluginSettingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SitePluginSettingResponse.ProtoReflect.Descriptor instead.
-func (*SitePluginSettingResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SiteDeleteMenuRequest.ProtoReflect.Descriptor instead.
+func (*SiteDeleteMenuRequest) Descriptor() ([]byte, []int) {
 	return file_site_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *SitePluginSettingResponse) GetValue() *anypb.Any {
+func (x *SiteDeleteMenuRequest) GetName() string {
 	if x != nil {
-		return x.Value
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type SiteSetNeighborPluginSettingRequest struct {
+type SitePluginNeighborRoutesListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pluginname   string `protobuf:"bytes,1,opt,name=pluginname,proto3" json:"pluginname,omitempty"`
-	Settingname  string `protobuf:"bytes,2,opt,name=settingname,proto3" json:"settingname,omitempty"`
-	Settingvalue string `protobuf:"bytes,3,opt,name=settingvalue,proto3" json:"settingvalue,omitempty"`
+	Pluginname string `protobuf:"bytes,1,opt,name=pluginname,proto3" json:"pluginname,omitempty"`
 }
 
-func (x *SiteSetNeighborPluginSettingRequest) Reset() {
-	*x = SiteSetNeighborPluginSettingRequest{}
+func (x *SitePluginNeighborRoutesListRequest) Reset() {
+	*x = SitePluginNeighborRoutesListRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_site_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2610,13 +2632,13 @@ func (x *SiteSetNeighborPluginSettingRequest) Reset() {
 	}
 }
 
-func (x *SiteSetNeighborPluginSettingRequest) String() string {
+func (x *SitePluginNeighborRoutesListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SiteSetNeighborPluginSettingRequest) ProtoMessage() {}
+func (*SitePluginNeighborRoutesListRequest) ProtoMessage() {}
 
-func (x *SiteSetNeighborPluginSettingRequest) ProtoReflect() protoreflect.Message {
+func (x *SitePluginNeighborRoutesListRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_site_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2628,43 +2650,28 @@ func (x *SiteSetNeighborPluginSettingRequest) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SiteSetNeighborPluginSettingRequest.ProtoReflect.Descriptor instead.
-func (*SiteSetNeighborPluginSettingRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SitePluginNeighborRoutesListRequest.ProtoReflect.Descriptor instead.
+func (*SitePluginNeighborRoutesListRequest) Descriptor() ([]byte, []int) {
 	return file_site_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *SiteSetNeighborPluginSettingRequest) GetPluginname() string {
+func (x *SitePluginNeighborRoutesListRequest) GetPluginname() string {
 	if x != nil {
 		return x.Pluginname
 	}
 	return ""
 }
 
-func (x *SiteSetNeighborPluginSettingRequest) GetSettingname() string {
-	if x != nil {
-		return x.Settingname
-	}
-	return ""
-}
-
-func (x *SiteSetNeighborPluginSettingRequest) GetSettingvalue() string {
-	if x != nil {
-		return x.Settingvalue
-	}
-	return ""
-}
-
-type SiteNeighborPluginSettingStringRequest struct {
+type SitePluginNeighborRoutesListResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pluginname string `protobuf:"bytes,1,opt,name=pluginname,proto3" json:"pluginname,omitempty"`
-	Fieldname  string `protobuf:"bytes,2,opt,name=fieldname,proto3" json:"fieldname,omitempty"`
+	Routes []*structpb.Struct `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes,omitempty"`
 }
 
-func (x *SiteNeighborPluginSettingStringRequest) Reset() {
-	*x = SiteNeighborPluginSettingStringRequest{}
+func (x *SitePluginNeighborRoutesListResponse) Reset() {
+	*x = SitePluginNeighborRoutesListResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_site_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2672,13 +2679,13 @@ func (x *SiteNeighborPluginSettingStringRequest) Reset() {
 	}
 }
 
-func (x *SiteNeighborPluginSettingStringRequest) String() string {
+func (x *SitePluginNeighborRoutesListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SiteNeighborPluginSettingStringRequest) ProtoMessage() {}
+func (*SitePluginNeighborRoutesListResponse) ProtoMessage() {}
 
-func (x *SiteNeighborPluginSettingStringRequest) ProtoReflect() protoreflect.Message {
+func (x *SitePluginNeighborRoutesListResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_site_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2690,35 +2697,29 @@ func (x *SiteNeighborPluginSettingStringRequest) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SiteNeighborPluginSettingStringRequest.ProtoReflect.Descriptor instead.
-func (*SiteNeighborPluginSettingStringRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SitePluginNeighborRoutesListResponse.ProtoReflect.Descriptor instead.
+func (*SitePluginNeighborRoutesListResponse) Descriptor() ([]byte, []int) {
 	return file_site_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *SiteNeighborPluginSettingStringRequest) GetPluginname() string {
-	if x != nil {
-		return x.Pluginname
-	}
-	return ""
-}
-
-func (x *SiteNeighborPluginSettingStringRequest) GetFieldname() string {
+func (x *SitePluginNeighborRoutesListResponse) GetRoutes() []*structpb.Struct {
 	if x != nil {
-		return x.Fieldname
+		return x.Routes
 	}
-	return ""
+	return nil
 }
 
-type SiteNeighborPluginSettingStringResponse struct {
+type SiteUserPersistRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Requestid string `protobuf:"bytes,1,opt,name=requestid,proto3" json:"requestid,omitempty"`
+	Persist   bool   `protobuf:"varint,2,opt,name=persist,proto3" json:"persist,omitempty"`
 }
 
-func (x *SiteNeighborPluginSettingStringResponse) Reset() {
-	*x = SiteNeighborPluginSettingStringResponse{}
+func (x *SiteUserPersistRequest) Reset() {
+	*x = SiteUserPersistRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_site_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2726,13 +2727,13 @@ func (x *SiteNeighborPluginSettingStringResponse) Reset() {
 	}
 }
 
-func (x *SiteNeighborPluginSettingStringResponse) String() string {
+func (x *SiteUserPersistRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SiteNeighborPluginSettingStringResponse) ProtoMessage() {}
+func (*SiteUserPersistRequest) ProtoMessage() {}
 
-func (x *SiteNeighborPluginSettingStringResponse) ProtoReflect() protoreflect.Message {
+func (x *SiteUserPersistRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_site_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2744,29 +2745,36 @@ func (x *SiteNeighborPluginSettingStringResponse) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SiteNeighborPluginSettingStringResponse.ProtoReflect.Descriptor instead.
-func (*SiteNeighborPluginSettingStringResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SiteUserPersistRequest.ProtoReflect.Descriptor instead.
+func (*SiteUserPersistRequest) Descriptor() ([]byte, []int) {
 	return file_site_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *SiteNeighborPluginSettingStringResponse) GetValue() string {
+func (x *SiteUserPersistRequest) GetRequestid() string {
 	if x != nil {
-		return x.Value
+		return x.Requestid
 	}
 	return ""
 }
 
-type SiteNeighborPluginSettingRequest struct {
+func (x *SiteUserPersistRequest) GetPersist() bool {
+	if x != nil {
+		return x.Persist
+	}
+	return false
+}
+
+type SiteUserLoginRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pluginname string `protobuf:"bytes,1,opt,name=pluginname,proto3" json:"pluginname,omitempty"`
-	Fieldname  string `protobuf:"bytes,2,opt,name=fieldname,proto3" json:"fieldname,omitempty"`
+	Requestid string `protobuf:"bytes,1,opt,name=requestid,proto3" json:"requestid,omitempty"`
+	Username  string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
 }
 
-func (x *SiteNeighborPluginSettingRequest) Reset() {
-	*x = SiteNeighborPluginSettingRequest{}
+func (x *SiteUserLoginRequest) Reset() {
+	*x = SiteUserLoginRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_site_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2774,13 +2782,13 @@ func (x *SiteNeighborPluginSettingRequest) Reset() {
 	}
 }
 
-func (x *SiteNeighborPluginSettingRequest) String() string {
+func (x *SiteUserLoginRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SiteNeighborPluginSettingRequest) ProtoMessage() {}
+func (*SiteUserLoginRequest) ProtoMessage() {}
 
-func (x *SiteNeighborPluginSettingRequest) ProtoReflect() protoreflect.Message {
+func (x *SiteUserLoginRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_site_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2792,35 +2800,35 @@ func (x *SiteNeighborPluginSettingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SiteNeighborPluginSettingRequest.ProtoReflect.Descriptor instead.
-func (*SiteNeighborPluginSettingRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SiteUserLoginRequest.ProtoReflect.Descriptor instead.
+func (*SiteUserLoginRequest) Descriptor() ([]byte, []int) {
 	return file_site_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *SiteNeighborPluginSettingRequest) GetPluginname() string {
+func (x *SiteUserLoginRequest) GetRequestid() string {
 	if x != nil {
-		return x.Pluginname
+		return x.Requestid
 	}
 	return ""
 }
 
-func (x *SiteNeighborPluginSettingRequest) GetFieldname() string {
+func (x *SiteUserLoginRequest) GetUsername() string {
 	if x != nil {
-		return x.Fieldname
+		return x.Username
 	}
 	return ""
 }
 
-type SiteNeighborPluginSettingResponse struct {
+type SiteAuthenticatedUserRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Value *anypb.Any `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Requestid string `protobuf:"bytes,1,opt,name=requestid,proto3" json:"requestid,omitempty"`
 }
 
-func (x *SiteNeighborPluginSettingResponse) Reset() {
-	*x = SiteNeighborPluginSettingResponse{}
+func (x *SiteAuthenticatedUserRequest) Reset() {
+	*x = SiteAuthenticatedUserRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_site_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2828,13 +2836,13 @@ func (x *SiteNeighborPluginSettingResponse) Reset() {
 	}
 }
 
-func (x *SiteNeighborPluginSettingResponse) String() string {
+func (x *SiteAuthenticatedUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SiteNeighborPluginSettingResponse) ProtoMessage() {}
+func (*SiteAuthenticatedUserRequest) ProtoMessage() {}
 
-func (x *SiteNeighborPluginSettingResponse) ProtoReflect() protoreflect.Message {
+func (x *SiteAuthenticatedUserRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_site_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2846,28 +2854,28 @@ func (x *SiteNeighborPluginSettingResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SiteNeighborPluginSettingResponse.ProtoReflect.Descriptor instead.
-func (*SiteNeighborPluginSettingResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SiteAuthenticatedUserRequest.ProtoReflect.Descriptor instead.
+func (*SiteAuthenticatedUserRequest) Descriptor() ([]byte, []int) {
 	return file_site_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *SiteNeighborPluginSettingResponse) GetValue() *anypb.Any {
+func (x *SiteAuthenticatedUserRequest) GetRequestid() string {
 	if x != nil {
-		return x.Value
+		return x.Requestid
 	}
-	return nil
+	return ""
 }
 
-type SitePluginTrustedRequest struct {
+type SiteAuthenticatedUserResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pluginname string `protobuf:"bytes,1,opt,name=pluginname,proto3" json:"pluginname,omitempty"`
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
 }
 
-func (x *SitePluginTrustedRequest) Reset() {
-	*x = SitePluginTrustedRequest{}
+func (x *SiteAuthenticatedUserResponse) Reset() {
+	*x = SiteAuthenticatedUserResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_site_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2875,13 +2883,13 @@ func (x *SitePluginTrustedRequest) Reset() {
 	}
 }
 
-func (x *SitePluginTrustedRequest) String() string {
+func (x *SiteAuthenticatedUserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SitePluginTrustedRequest) ProtoMessage() {}
+func (*SiteAuthenticatedUserResponse) ProtoMessage() {}
 
-func (x *SitePluginTrustedRequest) ProtoReflect() protoreflect.Message {
+func (x *SiteAuthenticatedUserResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_site_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2893,28 +2901,28 @@ func (x *SitePluginTrustedRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SitePluginTrustedRequest.ProtoReflect.Descriptor instead.
-func (*SitePluginTrustedRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SiteAuthenticatedUserResponse.ProtoReflect.Descriptor instead.
+func (*SiteAuthenticatedUserResponse) Descriptor() ([]byte, []int) {
 	return file_site_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *SitePluginTrustedRequest) GetPluginname() string {
+func (x *SiteAuthenticatedUserResponse) GetUsername() string {
 	if x != nil {
-		return x.Pluginname
+		return x.Username
 	}
 	return ""
 }
 
-type SitePluginTrustedResponse struct {
+type SiteUserLogoutRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Trusted bool `protobuf:"varint,1,opt,name=trusted,proto3" json:"trusted,omitempty"`
+	Requestid string `protobuf:"bytes,1,opt,name=requestid,proto3" json:"requestid,omitempty"`
 }
 
-func (x *SitePluginTrustedResponse) Reset() {
-	*x = SitePluginTrustedResponse{}
+func (x *SiteUserLogoutRequest) Reset() {
+	*x = SiteUserLogoutRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_site_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2922,13 +2930,13 @@ func (x *SitePluginTrustedResponse) Reset() {
 	}
 }
 
-func (x *SitePluginTrustedResponse) String() string {
+func (x *SiteUserLogoutRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SitePluginTrustedResponse) ProtoMessage() {}
+func (*SiteUserLogoutRequest) ProtoMessage() {}
 
-func (x *SitePluginTrustedResponse) ProtoReflect() protoreflect.Message {
+func (x *SiteUserLogoutRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_site_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2940,28 +2948,28 @@ func (x *SitePluginTrustedResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SitePluginTrustedResponse.ProtoReflect.Descriptor instead.
-func (*SitePluginTrustedResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SiteUserLogoutRequest.ProtoReflect.Descriptor instead.
+func (*SiteUserLogoutRequest) Descriptor() ([]byte, []int) {
 	return file_site_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *SitePluginTrustedResponse) GetTrusted() bool {
+func (x *SiteUserLogoutRequest) GetRequestid() string {
 	if x != nil {
-		return x.Trusted
+		return x.Requestid
 	}
-	return false
+	return ""
 }
 
-type SiteSetTitleRequest struct {
+type SiteLogoutAllUsersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Requestid string `protobuf:"bytes,1,opt,name=requestid,proto3" json:"requestid,omitempty"`
 }
 
-func (x *SiteSetTitleRequest) Reset() {
-	*x = SiteSetTitleRequest{}
+func (x *SiteLogoutAllUsersRequest) Reset() {
+	*x = SiteLogoutAllUsersRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_site_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2969,13 +2977,13 @@ func (x *SiteSetTitleRequest) Reset() {
 	}
 }
 
-func (x *SiteSetTitleRequest) String() string {
+func (x *SiteLogoutAllUsersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SiteSetTitleRequest) ProtoMessage() {}
+func (*SiteLogoutAllUsersRequest) ProtoMessage() {}
 
-func (x *SiteSetTitleRequest) ProtoReflect() protoreflect.Message {
+func (x *SiteLogoutAllUsersRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_site_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2987,28 +2995,29 @@ func (x *SiteSetTitleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SiteSetTitleRequest.ProtoReflect.Descriptor instead.
-func (*SiteSetTitleRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SiteLogoutAllUsersRequest.ProtoReflect.Descriptor instead.
+func (*SiteLogoutAllUsersRequest) Descriptor() ([]byte, []int) {
 	return file_site_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *SiteSetTitleRequest) GetTitle() string {
+func (x *SiteLogoutAllUsersRequest) GetRequestid() string {
 	if x != nil {
-		return x.Title
+		return x.Requestid
 	}
 	return ""
 }
 
-type SiteTitleResponse struct {
+type SiteUserSessionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Requestid string `protobuf:"bytes,1,opt,name=requestid,proto3" json:"requestid,omitempty"`
+	Username  string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
 }
 
-func (x *SiteTitleResponse) Reset() {
-	*x = SiteTitleResponse{}
+func (x *SiteUserSessionsRequest) Reset() {
+	*x = SiteUserSessionsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_site_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3016,13 +3025,13 @@ func (x *SiteTitleResponse) Reset() {
 	}
 }
 
-func (x *SiteTitleResponse) String() string {
+func (x *SiteUserSessionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SiteTitleResponse) ProtoMessage() {}
+func (*SiteUserSessionsRequest) ProtoMessage() {}
 
-func (x *SiteTitleResponse) ProtoReflect() protoreflect.Message {
+func (x *SiteUserSessionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_site_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3034,28 +3043,35 @@ func (x *SiteTitleResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SiteTitleResponse.ProtoReflect.Descriptor instead.
-func (*SiteTitleResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SiteUserSessionsRequest.ProtoReflect.Descriptor instead.
+func (*SiteUserSessionsRequest) Descriptor() ([]byte, []int) {
 	return file_site_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *SiteTitleResponse) GetTitle() string {
+func (x *SiteUserSessionsRequest) GetRequestid() string {
 	if x != nil {
-		return x.Title
+		return x.Requestid
 	}
 	return ""
 }
 
-type SiteSetSchemeRequest struct {
+func (x *SiteUserSessionsRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type SiteUserSessionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Scheme string `protobuf:"bytes,1,opt,name=scheme,proto3" json:"scheme,omitempty"`
+	Sessions []*structpb.Struct `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
 }
 
-func (x *SiteSetSchemeRequest) Reset() {
-	*x = SiteSetSchemeRequest{}
+func (x *SiteUserSessionsResponse) Reset() {
+	*x = SiteUserSessionsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_site_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3063,13 +3079,13 @@ func (x *SiteSetSchemeRequest) Reset() {
 	}
 }
 
-func (x *SiteSetSchemeRequest) String() string {
+func (x *SiteUserSessionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SiteSetSchemeRequest) ProtoMessage() {}
+func (*SiteUserSessionsResponse) ProtoMessage() {}
 
-func (x *SiteSetSchemeRequest) ProtoReflect() protoreflect.Message {
+func (x *SiteUserSessionsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_site_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3081,28 +3097,29 @@ func (x *SiteSetSchemeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SiteSetSchemeRequest.ProtoReflect.Descriptor instead.
-func (*SiteSetSchemeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SiteUserSessionsResponse.ProtoReflect.Descriptor instead.
+func (*SiteUserSessionsResponse) Descriptor() ([]byte, []int) {
 	return file_site_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *SiteSetSchemeRequest) GetScheme() string {
+func (x *SiteUserSessionsResponse) GetSessions() []*structpb.Struct {
 	if x != nil {
-		return x.Scheme
+		return x.Sessions
 	}
-	return ""
+	return nil
 }
 
-type SiteSchemeResponse struct {
+type SiteLogoutUserRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Scheme string `protobuf:"bytes,1,opt,name=scheme,proto3" json:"scheme,omitempty"`
+	Requestid string `protobuf:"bytes,1,opt,name=requestid,proto3" json:"requestid,omitempty"`
+	Username  string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
 }
 
-func (x *SiteSchemeResponse) Reset() {
-	*x = SiteSchemeResponse{}
+func (x *SiteLogoutUserRequest) Reset() {
+	*x = SiteLogoutUserRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_site_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3110,13 +3127,13 @@ func (x *SiteSchemeResponse) Reset() {
 	}
 }
 
-func (x *SiteSchemeResponse) String() string {
+func (x *SiteLogoutUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SiteSchemeResponse) ProtoMessage() {}
+func (*SiteLogoutUserRequest) ProtoMessage() {}
 
-func (x *SiteSchemeResponse) ProtoReflect() protoreflect.Message {
+func (x *SiteLogoutUserRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_site_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3128,28 +3145,35 @@ func (x *SiteSchemeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SiteSchemeResponse.ProtoReflect.Descriptor instead.
-func (*SiteSchemeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SiteLogoutUserRequest.ProtoReflect.Descriptor instead.
+func (*SiteLogoutUserRequest) Descriptor() ([]byte, []int) {
 	return file_site_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *SiteSchemeResponse) GetScheme() string {
+func (x *SiteLogoutUserRequest) GetRequestid() string {
 	if x != nil {
-		return x.Scheme
+		return x.Requestid
 	}
 	return ""
 }
 
-type SiteSetURLRequest struct {
+func (x *SiteLogoutUserRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type SiteSetCSRFRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Requestid string `protobuf:"bytes,1,opt,name=requestid,proto3" json:"requestid,omitempty"`
 }
 
-func (x *SiteSetURLRequest) Reset() {
-	*x = SiteSetURLRequest{}
+func (x *SiteSetCSRFRequest) Reset() {
+	*x = SiteSetCSRFRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_site_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3157,13 +3181,13 @@ func (x *SiteSetURLRequest) Reset() {
 	}
 }
 
-func (x *SiteSetURLRequest) String() string {
+func (x *SiteSetCSRFRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SiteSetURLRequest) ProtoMessage() {}
+func (*SiteSetCSRFRequest) ProtoMessage() {}
 
-func (x *SiteSetURLRequest) ProtoReflect() protoreflect.Message {
+func (x *SiteSetCSRFRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_site_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3175,28 +3199,28 @@ func (x *SiteSetURLRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SiteSetURLRequest.ProtoReflect.Descriptor instead.
-func (*SiteSetURLRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SiteSetCSRFRequest.ProtoReflect.Descriptor instead.
+func (*SiteSetCSRFRequest) Descriptor() ([]byte, []int) {
 	return file_site_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *SiteSetURLRequest) GetUrl() string {
+func (x *SiteSetCSRFRequest) GetRequestid() string {
 	if x != nil {
-		return x.Url
+		return x.Requestid
 	}
 	return ""
 }
 
-type SiteURLResponse struct {
+type SiteSetCSRFResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 }
 
-func (x *SiteURLResponse) Reset() {
-	*x = SiteURLResponse{}
+func (x *SiteSetCSRFResponse) Reset() {
+	*x = SiteSetCSRFResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_site_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3204,13 +3228,13 @@ func (x *SiteURLResponse) Reset() {
 	}
 }
 
-func (x *SiteURLResponse) String() string {
+func (x *SiteSetCSRFResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SiteURLResponse) ProtoMessage() {}
+func (*SiteSetCSRFResponse) ProtoMessage() {}
 
-func (x *SiteURLResponse) ProtoReflect() protoreflect.Message {
+func (x *SiteSetCSRFResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_site_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3222,28 +3246,29 @@ func (x *SiteURLResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SiteURLResponse.ProtoReflect.Descriptor instead.
-func (*SiteURLResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SiteSetCSRFResponse.ProtoReflect.Descriptor instead.
+func (*SiteSetCSRFResponse) Descriptor() ([]byte, []int) {
 	return file_site_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *SiteURLResponse) GetUrl() string {
+func (x *SiteSetCSRFResponse) GetToken() string {
 	if x != nil {
-		return x.Url
+		return x.Token
 	}
 	return ""
 }
 
-type SiteFullURLResponse struct {
+type SiteCSRFRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Fullurl string `protobuf:"bytes,1,opt,name=fullurl,proto3" json:"fullurl,omitempty"`
+	Requestid string `protobuf:"bytes,1,opt,name=requestid,proto3" json:"requestid,omitempty"`
+	Token     string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
 }
 
-func (x *SiteFullURLResponse) Reset() {
-	*x = SiteFullURLResponse{}
+func (x *SiteCSRFRequest) Reset() {
+	*x = SiteCSRFRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_site_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3251,13 +3276,13 @@ func (x *SiteFullURLResponse) Reset() {
 	}
 }
 
-func (x *SiteFullURLResponse) String() string {
+func (x *SiteCSRFRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SiteFullURLResponse) ProtoMessage() {}
+func (*SiteCSRFRequest) ProtoMessage() {}
 
-func (x *SiteFullURLResponse) ProtoReflect() protoreflect.Message {
+func (x *SiteCSRFRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_site_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3269,28 +3294,35 @@ func (x *SiteFullURLResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SiteFullURLResponse.ProtoReflect.Descriptor instead.
-func (*SiteFullURLResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SiteCSRFRequest.ProtoReflect.Descriptor instead.
+func (*SiteCSRFRequest) Descriptor() ([]byte, []int) {
 	return file_site_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *SiteFullURLResponse) GetFullurl() string {
+func (x *SiteCSRFRequest) GetRequestid() string {
 	if x != nil {
-		return x.Fullurl
+		return x.Requestid
 	}
 	return ""
 }
 
-type SiteUpdatedResponse struct {
+func (x *SiteCSRFRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type SiteCSRFResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Timestamp *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
 }
 
-func (x *SiteUpdatedResponse) Reset() {
-	*x = SiteUpdatedResponse{}
+func (x *SiteCSRFResponse) Reset() {
+	*x = SiteCSRFResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_site_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3298,13 +3330,13 @@ func (x *SiteUpdatedResponse) Reset() {
 	}
 }
 
-func (x *SiteUpdatedResponse) String() string {
+func (x *SiteCSRFResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SiteUpdatedResponse) ProtoMessage() {}
+func (*SiteCSRFResponse) ProtoMessage() {}
 
-func (x *SiteUpdatedResponse) ProtoReflect() protoreflect.Message {
+func (x *SiteCSRFResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_site_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3316,28 +3348,29 @@ func (x *SiteUpdatedResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SiteUpdatedResponse.ProtoReflect.Descriptor instead.
-func (*SiteUpdatedResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SiteCSRFResponse.ProtoReflect.Descriptor instead.
+func (*SiteCSRFResponse) Descriptor() ([]byte, []int) {
 	return file_site_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *SiteUpdatedResponse) GetTimestamp() *timestamppb.Timestamp {
+func (x *SiteCSRFResponse) GetValid() bool {
 	if x != nil {
-		return x.Timestamp
+		return x.Valid
 	}
-	return nil
+	return false
 }
 
-type SiteSetContentRequest struct {
+type SiteSessionValueRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Content string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	Requestid string `protobuf:"bytes,1,opt,name=requestid,proto3" json:"requestid,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *SiteSetContentRequest) Reset() {
-	*x = SiteSetContentRequest{}
+func (x *SiteSessionValueRequest) Reset() {
+	*x = SiteSessionValueRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_site_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3345,13 +3378,13 @@ func (x *SiteSetContentRequest) Reset() {
 	}
 }
 
-func (x *SiteSetContentRequest) String() string {
+func (x *SiteSessionValueRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SiteSetContentRequest) ProtoMessage() {}
+func (*SiteSessionValueRequest) ProtoMessage() {}
 
-func (x *SiteSetContentRequest) ProtoReflect() protoreflect.Message {
+func (x *SiteSessionValueRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_site_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3363,28 +3396,35 @@ func (x *SiteSetContentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SiteSetContentRequest.ProtoReflect.Descriptor instead.
-func (*SiteSetContentRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SiteSessionValueRequest.ProtoReflect.Descriptor instead.
+func (*SiteSessionValueRequest) Descriptor() ([]byte, []int) {
 	return file_site_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *SiteSetContentRequest) GetContent() string {
+func (x *SiteSessionValueRequest) GetRequestid() string {
 	if x != nil {
-		return x.Content
+		return x.Requestid
 	}
 	return ""
 }
 
-type SiteContentResponse struct {
+func (x *SiteSessionValueRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type SiteSessionValueResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Content string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *SiteContentResponse) Reset() {
-	*x = SiteContentResponse{}
+func (x *SiteSessionValueResponse) Reset() {
+	*x = SiteSessionValueResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_site_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3392,13 +3432,13 @@ func (x *SiteContentResponse) Reset() {
 	}
 }
 
-func (x *SiteContentResponse) String() string {
+func (x *SiteSessionValueResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SiteContentResponse) ProtoMessage() {}
+func (*SiteSessionValueResponse) ProtoMessage() {}
 
-func (x *SiteContentResponse) ProtoReflect() protoreflect.Message {
+func (x *SiteSessionValueResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_site_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3410,28 +3450,30 @@ func (x *SiteContentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SiteContentResponse.ProtoReflect.Descriptor instead.
-func (*SiteContentResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SiteSessionValueResponse.ProtoReflect.Descriptor instead.
+func (*SiteSessionValueResponse) Descriptor() ([]byte, []int) {
 	return file_site_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *SiteContentResponse) GetContent() string {
+func (x *SiteSessionValueResponse) GetValue() string {
 	if x != nil {
-		return x.Content
+		return x.Value
 	}
 	return ""
 }
 
-type SiteTagsRequest struct {
+type SiteSetSessionValueRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Onlypublished bool `protobuf:"varint,1,opt,name=onlypublished,proto3" json:"onlypublished,omitempty"`
+	Requestid string `protobuf:"bytes,1,opt,name=requestid,proto3" json:"requestid,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Value     string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *SiteTagsRequest) Reset() {
-	*x = SiteTagsRequest{}
+func (x *SiteSetSessionValueRequest) Reset() {
+	*x = SiteSetSessionValueRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_site_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3439,13 +3481,13 @@ func (x *SiteTagsRequest) Reset() {
 	}
 }
 
-func (x *SiteTagsRequest) String() string {
+func (x *SiteSetSessionValueRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SiteTagsRequest) ProtoMessage() {}
+func (*SiteSetSessionValueRequest) ProtoMessage() {}
 
-func (x *SiteTagsRequest) ProtoReflect() protoreflect.Message {
+func (x *SiteSetSessionValueRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_site_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3457,28 +3499,43 @@ func (x *SiteTagsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SiteTagsRequest.ProtoReflect.Descriptor instead.
-func (*SiteTagsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SiteSetSessionValueRequest.ProtoReflect.Descriptor instead.
+func (*SiteSetSessionValueRequest) Descriptor() ([]byte, []int) {
 	return file_site_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *SiteTagsRequest) GetOnlypublished() bool {
+func (x *SiteSetSessionValueRequest) GetRequestid() string {
 	if x != nil {
-		return x.Onlypublished
+		return x.Requestid
 	}
-	return false
+	return ""
 }
 
-type SiteTagsResponse struct {
+func (x *SiteSetSessionValueRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SiteSetSessionValueRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type SiteDeleteSessionValueRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tags []*Tag `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	Requestid string `protobuf:"bytes,1,opt,name=requestid,proto3" json:"requestid,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *SiteTagsResponse) Reset() {
-	*x = SiteTagsResponse{}
+func (x *SiteDeleteSessionValueRequest) Reset() {
+	*x = SiteDeleteSessionValueRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_site_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3486,13 +3543,13 @@ func (x *SiteTagsResponse) Reset() {
 	}
 }
 
-func (x *SiteTagsResponse) String() string {
+func (x *SiteDeleteSessionValueRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SiteTagsResponse) ProtoMessage() {}
+func (*SiteDeleteSessionValueRequest) ProtoMessage() {}
 
-func (x *SiteTagsResponse) ProtoReflect() protoreflect.Message {
+func (x *SiteDeleteSessionValueRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_site_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3504,29 +3561,35 @@ func (x *SiteTagsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SiteTagsResponse.ProtoReflect.Descriptor instead.
-func (*SiteTagsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SiteDeleteSessionValueRequest.ProtoReflect.Descriptor instead.
+func (*SiteDeleteSessionValueRequest) Descriptor() ([]byte, []int) {
 	return file_site_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *SiteTagsResponse) GetTags() []*Tag {
+func (x *SiteDeleteSessionValueRequest) GetRequestid() string {
 	if x != nil {
-		return x.Tags
+		return x.Requestid
 	}
-	return nil
+	return ""
 }
 
-type Tag struct {
+func (x *SiteDeleteSessionValueRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type SitePluginNeighborSettingsListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name      string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Pluginname string `protobuf:"bytes,1,opt,name=pluginname,proto3" json:"pluginname,omitempty"`
 }
 
-func (x *Tag) Reset() {
-	*x = Tag{}
+func (x *SitePluginNeighborSettingsListRequest) Reset() {
+	*x = SitePluginNeighborSettingsListRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_site_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3534,13 +3597,13 @@ func (x *Tag) Reset() {
 	}
 }
 
-func (x *Tag) String() string {
+func (x *SitePluginNeighborSettingsListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Tag) ProtoMessage() {}
+func (*SitePluginNeighborSettingsListRequest) ProtoMessage() {}
 
-func (x *Tag) ProtoReflect() protoreflect.Message {
+func (x *SitePluginNeighborSettingsListRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_site_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3552,3439 +3615,7960 @@ func (x *Tag) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Tag.ProtoReflect.Descriptor instead.
-func (*Tag) Descriptor() ([]byte, []int) {
+// Deprecated: Use SitePluginNeighborSettingsListRequest.ProtoReflect.Descriptor instead.
+func (*SitePluginNeighborSettingsListRequest) Descriptor() ([]byte, []int) {
 	return file_site_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *Tag) GetName() string {
+func (x *SitePluginNeighborSettingsListRequest) GetPluginname() string {
 	if x != nil {
-		return x.Name
+		return x.Pluginname
 	}
 	return ""
 }
 
-func (x *Tag) GetTimestamp() *timestamppb.Timestamp {
-	if x != nil {
-		return x.Timestamp
-	}
-	return nil
-}
+type SitePluginNeighborSettingsListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var File_site_proto protoreflect.FileDescriptor
+	Settings []*structpb.Struct `protobuf:"bytes,1,rep,name=settings,proto3" json:"settings,omitempty"`
+}
 
-var file_site_proto_rawDesc = []byte{
-	0x0a, 0x0a, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10, 0x61, 0x6d,
-	0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x1a, 0x0b,
-	0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x70, 0x6c, 0x75,
-	0x67, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63,
-	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0x42, 0x0a, 0x20, 0x53, 0x69, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x64, 0x53,
-	0x69, 0x6e, 0x67, 0x6c, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x50, 0x61, 0x67, 0x65, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x75, 0x67, 0x69,
-	0x6e, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x75,
-	0x67, 0x69, 0x6e, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2d, 0x0a, 0x15, 0x53, 0x69, 0x74, 0x65, 0x41,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x22, 0x38, 0x0a, 0x16, 0x53, 0x69, 0x74, 0x65, 0x41, 0x75,
-	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64,
-	0x22, 0x44, 0x0a, 0x22, 0x53, 0x69, 0x74, 0x65, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72,
-	0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x75, 0x67,
-	0x69, 0x6e, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x5d, 0x0a, 0x23, 0x53, 0x69, 0x74, 0x65, 0x4e, 0x65,
-	0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x47, 0x72, 0x61, 0x6e,
-	0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a,
-	0x06, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
-	0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66,
-	0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x06, 0x67,
-	0x72, 0x61, 0x6e, 0x74, 0x73, 0x22, 0x41, 0x0a, 0x1f, 0x53, 0x69, 0x74, 0x65, 0x4e, 0x65, 0x69,
-	0x67, 0x68, 0x62, 0x6f, 0x72, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x47, 0x72, 0x61, 0x6e, 0x74,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x75, 0x67,
-	0x69, 0x6e, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c,
-	0x75, 0x67, 0x69, 0x6e, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x53, 0x0a, 0x20, 0x53, 0x69, 0x74, 0x65,
-	0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x47, 0x72,
-	0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x06,
-	0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
-	0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x06, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x22, 0x58, 0x0a,
-	0x20, 0x53, 0x69, 0x74, 0x65, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x50, 0x6c, 0x75,
-	0x67, 0x69, 0x6e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x22, 0x3d, 0x0a, 0x21, 0x53, 0x69, 0x74, 0x65, 0x4e,
-	0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x47, 0x72, 0x61,
-	0x6e, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x67,
-	0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x22, 0x5f, 0x0a, 0x27, 0x53, 0x69, 0x74, 0x65, 0x4e, 0x65,
-	0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x65, 0x64, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x22, 0x44, 0x0a, 0x28, 0x53, 0x69, 0x74, 0x65, 0x4e,
-	0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x22, 0x73, 0x0a,
-	0x21, 0x53, 0x69, 0x74, 0x65, 0x53, 0x65, 0x74, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72,
-	0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x6e,
-	0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74,
-	0x65, 0x64, 0x22, 0x4e, 0x0a, 0x13, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0a, 0x70, 0x6c, 0x75,
-	0x67, 0x69, 0x6e, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0a, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x64, 0x61,
-	0x74, 0x61, 0x22, 0x2f, 0x0a, 0x17, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e,
-	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61,
-	0x6d, 0x65, 0x73, 0x22, 0x2d, 0x0a, 0x17, 0x53, 0x69, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x22, 0x41, 0x0a, 0x17, 0x53, 0x69, 0x74, 0x65, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x04, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x46, 0x0a, 0x18, 0x53, 0x69, 0x74, 0x65, 0x44, 0x69, 0x73,
-	0x61, 0x62, 0x6c, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x52, 0x0a,
-	0x13, 0x53, 0x69, 0x74, 0x65, 0x53, 0x61, 0x76, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x2b, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x73,
-	0x74, 0x22, 0x40, 0x0a, 0x18, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x41, 0x6e,
-	0x64, 0x50, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a,
-	0x0d, 0x6f, 0x6e, 0x6c, 0x79, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x6f, 0x6e, 0x6c, 0x79, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73,
-	0x68, 0x65, 0x64, 0x22, 0x5c, 0x0a, 0x19, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x73,
-	0x41, 0x6e, 0x64, 0x50, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x3f, 0x0a, 0x0e, 0x70, 0x6f, 0x73, 0x74, 0x77, 0x69, 0x74, 0x68, 0x69, 0x64, 0x6c, 0x69,
-	0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63,
-	0x74, 0x52, 0x0e, 0x70, 0x6f, 0x73, 0x74, 0x77, 0x69, 0x74, 0x68, 0x69, 0x64, 0x6c, 0x69, 0x73,
-	0x74, 0x22, 0x4b, 0x0a, 0x1a, 0x53, 0x69, 0x74, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
-	0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x2d, 0x0a, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x4b,
-	0x0a, 0x1a, 0x53, 0x69, 0x74, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x50,
-	0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x05,
-	0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
-	0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x2b, 0x0a, 0x15, 0x53,
-	0x69, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x53, 0x6c, 0x75, 0x67, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x22, 0x45, 0x0a, 0x16, 0x53, 0x69, 0x74, 0x65,
-	0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x53, 0x6c, 0x75, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x22,
-	0x25, 0x0a, 0x13, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x43, 0x0a, 0x14, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6f,
-	0x73, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b,
-	0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
-	0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x22, 0x2b, 0x0a, 0x19, 0x53,
-	0x69, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x49,
-	0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x45, 0x0a, 0x23, 0x53, 0x69, 0x74, 0x65,
-	0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x52, 0x6f,
-	0x75, 0x74, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x6e, 0x61, 0x6d, 0x65, 0x22,
-	0x57, 0x0a, 0x24, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4e, 0x65, 0x69,
-	0x67, 0x68, 0x62, 0x6f, 0x72, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74,
-	0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x22, 0x50, 0x0a, 0x16, 0x53, 0x69, 0x74, 0x65,
-	0x55, 0x73, 0x65, 0x72, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x64,
-	0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x07, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x22, 0x50, 0x0a, 0x14, 0x53, 0x69,
-	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x64,
-	0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3c, 0x0a, 0x1c,
-	0x53, 0x69, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65,
-	0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x64, 0x22, 0x3b, 0x0a, 0x1d, 0x53, 0x69,
-	0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75,
-	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
-	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x35, 0x0a, 0x15, 0x53, 0x69, 0x74, 0x65, 0x55,
-	0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x64, 0x22, 0x39,
-	0x0a, 0x19, 0x53, 0x69, 0x74, 0x65, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x41, 0x6c, 0x6c, 0x55,
-	0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x64, 0x22, 0x32, 0x0a, 0x12, 0x53, 0x69, 0x74,
-	0x65, 0x53, 0x65, 0x74, 0x43, 0x53, 0x52, 0x46, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x64, 0x22, 0x2b, 0x0a,
-	0x13, 0x53, 0x69, 0x74, 0x65, 0x53, 0x65, 0x74, 0x43, 0x53, 0x52, 0x46, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x45, 0x0a, 0x0f, 0x53, 0x69,
-	0x74, 0x65, 0x43, 0x53, 0x52, 0x46, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a,
-	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x22, 0x28, 0x0a, 0x10, 0x53, 0x69, 0x74, 0x65, 0x43, 0x53, 0x52, 0x46, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x22, 0x4b, 0x0a, 0x17, 0x53,
-	0x69, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x30, 0x0a, 0x18, 0x53, 0x69, 0x74, 0x65,
-	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x64, 0x0a, 0x1a, 0x53, 0x69,
-	0x74, 0x65, 0x53, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x22, 0x51, 0x0a, 0x1d, 0x53, 0x69, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x22, 0x47, 0x0a, 0x25, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69,
-	0x6e, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
-	0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
-	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x5d, 0x0a, 0x26,
-	0x53, 0x69, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62,
-	0x6f, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e,
-	0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63,
-	0x74, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x55, 0x0a, 0x1b, 0x53,
-	0x69, 0x74, 0x65, 0x53, 0x65, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x74,
-	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65,
-	0x74, 0x74, 0x69, 0x6e, 0x67, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x22, 0x3c, 0x0a, 0x1c, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e,
-	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6e, 0x61, 0x6d, 0x65,
-	0x22, 0x35, 0x0a, 0x1d, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x65,
-	0x74, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3e, 0x0a, 0x1e, 0x53, 0x69, 0x74, 0x65, 0x50,
-	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x65,
-	0x6c, 0x64, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69,
-	0x65, 0x6c, 0x64, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x37, 0x0a, 0x1f, 0x53, 0x69, 0x74, 0x65, 0x50,
-	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x22, 0x38, 0x0a, 0x18, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x65,
-	0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09,
-	0x66, 0x69, 0x65, 0x6c, 0x64, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x47, 0x0a, 0x19, 0x53, 0x69,
-	0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x22, 0x8b, 0x01, 0x0a, 0x23, 0x53, 0x69, 0x74, 0x65, 0x53, 0x65, 0x74, 0x4e,
-	0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x74,
-	0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70,
-	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x73,
-	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a,
-	0x0c, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x22, 0x66, 0x0a, 0x26, 0x53, 0x69, 0x74, 0x65, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f,
-	0x72, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70,
-	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x66,
-	0x69, 0x65, 0x6c, 0x64, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x66, 0x69, 0x65, 0x6c, 0x64, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3f, 0x0a, 0x27, 0x53, 0x69, 0x74,
-	0x65, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53,
-	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x60, 0x0a, 0x20, 0x53, 0x69,
-	0x74, 0x65, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e,
-	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e,
-	0x0a, 0x0a, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c,
-	0x0a, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x4f, 0x0a, 0x21,
-	0x53, 0x69, 0x74, 0x65, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x50, 0x6c, 0x75, 0x67,
-	0x69, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3a, 0x0a,
-	0x18, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x54, 0x72, 0x75, 0x73, 0x74,
-	0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x75,
-	0x67, 0x69, 0x6e, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70,
-	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x35, 0x0a, 0x19, 0x53, 0x69, 0x74,
-	0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64,
-	0x22, 0x2b, 0x0a, 0x13, 0x53, 0x69, 0x74, 0x65, 0x53, 0x65, 0x74, 0x54, 0x69, 0x74, 0x6c, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0x29, 0x0a,
-	0x11, 0x53, 0x69, 0x74, 0x65, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0x2e, 0x0a, 0x14, 0x53, 0x69, 0x74, 0x65,
-	0x53, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x12, 0x53, 0x69, 0x74, 0x65,
-	0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16,
-	0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x22, 0x25, 0x0a, 0x11, 0x53, 0x69, 0x74, 0x65, 0x53, 0x65,
-	0x74, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75,
-	0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x23, 0x0a,
-	0x0f, 0x53, 0x69, 0x74, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
-	0x72, 0x6c, 0x22, 0x2f, 0x0a, 0x13, 0x53, 0x69, 0x74, 0x65, 0x46, 0x75, 0x6c, 0x6c, 0x55, 0x52,
-	0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x75, 0x6c,
-	0x6c, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x75, 0x6c, 0x6c,
-	0x75, 0x72, 0x6c, 0x22, 0x4f, 0x0a, 0x13, 0x53, 0x69, 0x74, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x22, 0x31, 0x0a, 0x15, 0x53, 0x69, 0x74, 0x65, 0x53, 0x65, 0x74, 0x43,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
-	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x2f, 0x0a, 0x13, 0x53, 0x69, 0x74, 0x65, 0x43,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x37, 0x0a, 0x0f, 0x53, 0x69, 0x74, 0x65,
-	0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6f,
-	0x6e, 0x6c, 0x79, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0d, 0x6f, 0x6e, 0x6c, 0x79, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65,
-	0x64, 0x22, 0x3d, 0x0a, 0x10, 0x53, 0x69, 0x74, 0x65, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x54, 0x61, 0x67, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73,
-	0x22, 0x53, 0x0a, 0x03, 0x54, 0x61, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x32, 0x8f, 0x27, 0x0a, 0x04, 0x53, 0x69, 0x74, 0x65, 0x12, 0x3a,
-	0x0a, 0x04, 0x4c, 0x6f, 0x61, 0x64, 0x12, 0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
-	0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64,
-	0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x15, 0x4c, 0x6f,
-	0x61, 0x64, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x50, 0x61,
-	0x67, 0x65, 0x73, 0x12, 0x32, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x64, 0x53,
-	0x69, 0x6e, 0x67, 0x6c, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x50, 0x61, 0x67, 0x65, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e,
-	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x22, 0x00, 0x12, 0x61, 0x0a, 0x0a, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64,
-	0x12, 0x27, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
-	0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x61, 0x6d, 0x62, 0x69,
-	0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74,
-	0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x88, 0x01, 0x0a, 0x17, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62,
-	0x6f, 0x72, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x4c, 0x69, 0x73,
-	0x74, 0x12, 0x34, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f,
-	0x72, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e,
-	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x4e,
-	0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x47, 0x72, 0x61,
-	0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x7f, 0x0a, 0x14, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x50, 0x6c, 0x75, 0x67,
-	0x69, 0x6e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x31, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65,
-	0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65,
-	0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x47, 0x72,
-	0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x61, 0x6d,
-	0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53,
-	0x69, 0x74, 0x65, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x50, 0x6c, 0x75, 0x67, 0x69,
-	0x6e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x82, 0x01, 0x0a, 0x15, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x50, 0x6c,
-	0x75, 0x67, 0x69, 0x6e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x12, 0x32, 0x2e, 0x61, 0x6d,
-	0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53,
-	0x69, 0x74, 0x65, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x50, 0x6c, 0x75, 0x67, 0x69,
-	0x6e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x33, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64,
-	0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x50,
-	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x97, 0x01, 0x0a, 0x1c, 0x4e, 0x65, 0x69, 0x67, 0x68,
-	0x62, 0x6f, 0x72, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x65, 0x64, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x39, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e,
-	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x4e,
-	0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62,
-	0x6f, 0x72, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65,
-	0x64, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x68, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x50,
-	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x33, 0x2e, 0x61, 0x6d, 0x62,
-	0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69,
-	0x74, 0x65, 0x53, 0x65, 0x74, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x50, 0x6c, 0x75,
-	0x67, 0x69, 0x6e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64,
-	0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x07, 0x50, 0x6c,
-	0x75, 0x67, 0x69, 0x6e, 0x73, 0x12, 0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x25,
-	0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65,
-	0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0b, 0x50, 0x6c, 0x75, 0x67, 0x69,
-	0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
-	0x29, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64,
-	0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4e, 0x61, 0x6d,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0c,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x29, 0x2e, 0x61,
-	0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e,
-	0x53, 0x69, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e,
-	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x22, 0x00, 0x12, 0x54, 0x0a, 0x0c, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x6c, 0x75, 0x67,
-	0x69, 0x6e, 0x12, 0x29, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
-	0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x0d, 0x44, 0x69, 0x73, 0x61,
-	0x62, 0x6c, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x2a, 0x2e, 0x61, 0x6d, 0x62, 0x69,
-	0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74,
-	0x65, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
-	0x12, 0x4c, 0x0a, 0x08, 0x53, 0x61, 0x76, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x25, 0x2e, 0x61,
-	0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e,
-	0x53, 0x69, 0x74, 0x65, 0x53, 0x61, 0x76, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x6a,
-	0x0a, 0x0d, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x41, 0x6e, 0x64, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12,
-	0x2a, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64,
-	0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x41, 0x6e, 0x64, 0x50,
-	0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x61, 0x6d,
-	0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53,
-	0x69, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x41, 0x6e, 0x64, 0x50, 0x61, 0x67, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x0e, 0x50, 0x75,
-	0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x17, 0x2e, 0x61,
-	0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x2c, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x50, 0x75, 0x62,
-	0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x0e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
-	0x65, 0x64, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e,
-	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x1a, 0x2c, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65,
-	0x64, 0x50, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x61, 0x0a, 0x0a, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x53, 0x6c, 0x75, 0x67, 0x12, 0x27,
-	0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65,
-	0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x53, 0x6c, 0x75, 0x67,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e,
-	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x50,
-	0x6f, 0x73, 0x74, 0x42, 0x79, 0x53, 0x6c, 0x75, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x08, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x49, 0x44, 0x12,
-	0x25, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64,
-	0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6f,
-	0x73, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x58, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79,
-	0x49, 0x44, 0x12, 0x2b, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64,
-	0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x8b, 0x01, 0x0a, 0x18, 0x50,
-	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x52, 0x6f, 0x75,
-	0x74, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x35, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e,
-	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x50,
-	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x52, 0x6f, 0x75,
-	0x74, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36,
-	0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65,
-	0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4e, 0x65, 0x69, 0x67,
-	0x68, 0x62, 0x6f, 0x72, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72,
-	0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x12, 0x28, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e,
-	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x55,
-	0x73, 0x65, 0x72, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x09,
-	0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x26, 0x2e, 0x61, 0x6d, 0x62, 0x69,
-	0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x76, 0x0a, 0x11,
-	0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x65,
-	0x72, 0x12, 0x2e, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
-	0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x2f, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
-	0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x6f,
-	0x75, 0x74, 0x12, 0x27, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f,
-	0x67, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x6d,
-	0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0e, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74,
-	0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x2b, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65,
-	0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65,
-	0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
-	0x12, 0x58, 0x0a, 0x07, 0x53, 0x65, 0x74, 0x43, 0x53, 0x52, 0x46, 0x12, 0x24, 0x2e, 0x61, 0x6d,
-	0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53,
-	0x69, 0x74, 0x65, 0x53, 0x65, 0x74, 0x43, 0x53, 0x52, 0x46, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x25, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x53, 0x65, 0x74, 0x43, 0x53, 0x52, 0x46,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x04, 0x43, 0x53,
-	0x52, 0x46, 0x12, 0x21, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x43, 0x53, 0x52, 0x46, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x43, 0x53, 0x52,
-	0x46, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x67, 0x0a, 0x0c, 0x53,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x29, 0x2e, 0x61, 0x6d,
-	0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53,
-	0x69, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x53, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2c, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e,
-	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x53,
-	0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
-	0x12, 0x60, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2f, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e,
-	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x22, 0x00, 0x12, 0x91, 0x01, 0x0a, 0x1a, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4e, 0x65, 0x69,
-	0x67, 0x68, 0x62, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x4c, 0x69, 0x73,
-	0x74, 0x12, 0x37, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4e,
-	0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x61, 0x6d, 0x62,
-	0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69,
-	0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72,
-	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x50, 0x6c, 0x75,
-	0x67, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x2d, 0x2e, 0x61, 0x6d, 0x62,
-	0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69,
-	0x74, 0x65, 0x53, 0x65, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69,
-	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69,
-	0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x22, 0x00, 0x12, 0x76, 0x0a, 0x11, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x65,
-	0x74, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x2e, 0x2e, 0x61, 0x6d, 0x62, 0x69,
-	0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74,
-	0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x6f,
-	0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x61, 0x6d, 0x62, 0x69,
-	0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74,
-	0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x6f,
-	0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7c, 0x0a, 0x13,
-	0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x12, 0x30, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69,
-	0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6c, 0x75,
-	0x67, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x0d, 0x50, 0x6c,
-	0x75, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x2a, 0x2e, 0x61, 0x6d,
-	0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53,
-	0x69, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e,
-	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x50,
-	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6c, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x4e, 0x65, 0x69,
-	0x67, 0x68, 0x62, 0x6f, 0x72, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69,
-	0x6e, 0x67, 0x12, 0x35, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x53, 0x65, 0x74, 0x4e, 0x65, 0x69,
-	0x67, 0x68, 0x62, 0x6f, 0x72, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69,
-	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69,
-	0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x22, 0x00, 0x12, 0x94, 0x01, 0x0a, 0x1b, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f,
-	0x72, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x12, 0x38, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x4e, 0x65, 0x69, 0x67,
-	0x68, 0x62, 0x6f, 0x72, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
-	0x67, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39,
-	0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65,
-	0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x50, 0x6c,
-	0x75, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x82, 0x01, 0x0a, 0x15,
-	0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x65,
-	0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x32, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x4e, 0x65, 0x69,
-	0x67, 0x68, 0x62, 0x6f, 0x72, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69,
-	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x61, 0x6d, 0x62, 0x69,
-	0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74,
-	0x65, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53,
-	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x6a, 0x0a, 0x0d, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65,
-	0x64, 0x12, 0x2a, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x54,
-	0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e,
-	0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66,
-	0x2e, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x54, 0x72, 0x75, 0x73, 0x74,
-	0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x08,
-	0x53, 0x65, 0x74, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x25, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65,
-	0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65,
-	0x53, 0x65, 0x74, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64,
-	0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x05, 0x54, 0x69,
-	0x74, 0x6c, 0x65, 0x12, 0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x23, 0x2e, 0x61,
-	0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e,
-	0x53, 0x69, 0x74, 0x65, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65,
-	0x12, 0x26, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x53, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65,
-	0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x06, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x17, 0x2e,
-	0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x24, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x53, 0x63,
-	0x68, 0x65, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48,
-	0x0a, 0x06, 0x53, 0x65, 0x74, 0x55, 0x52, 0x4c, 0x12, 0x23, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65,
-	0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65,
-	0x53, 0x65, 0x74, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
-	0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x12,
-	0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64,
-	0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x21, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65,
-	0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65,
-	0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a,
-	0x07, 0x46, 0x75, 0x6c, 0x6c, 0x55, 0x52, 0x4c, 0x12, 0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65,
-	0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x1a, 0x25, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x46, 0x75, 0x6c, 0x6c, 0x55, 0x52, 0x4c,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x07, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x25,
-	0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65,
-	0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0a, 0x53, 0x65, 0x74, 0x43, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x27, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x53, 0x65, 0x74,
-	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17,
-	0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x07, 0x43, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x25, 0x2e,
-	0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66,
-	0x2e, 0x53, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x04, 0x54, 0x61, 0x67, 0x73, 0x12, 0x21,
-	0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65,
-	0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x22, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x0d, 0x5a, 0x0b, 0x2e, 0x2f, 0x3b, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *SitePluginNeighborSettingsListResponse) Reset() {
+	*x = SitePluginNeighborSettingsListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_site_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-var (
-	file_site_proto_rawDescOnce sync.Once
-	file_site_proto_rawDescData = file_site_proto_rawDesc
-)
+func (x *SitePluginNeighborSettingsListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func file_site_proto_rawDescGZIP() []byte {
-	file_site_proto_rawDescOnce.Do(func() {
-		file_site_proto_rawDescData = protoimpl.X.CompressGZIP(file_site_proto_rawDescData)
-	})
-	return file_site_proto_rawDescData
+func (*SitePluginNeighborSettingsListResponse) ProtoMessage() {}
+
+func (x *SitePluginNeighborSettingsListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var file_site_proto_msgTypes = make([]protoimpl.MessageInfo, 72)
-var file_site_proto_goTypes = []interface{}{
-	(*SiteLoadSinglePluginPagesRequest)(nil),         // 0: ambient.protodef.SiteLoadSinglePluginPagesRequest
-	(*SiteAuthorizedRequest)(nil),                    // 1: ambient.protodef.SiteAuthorizedRequest
-	(*SiteAuthorizedResponse)(nil),                   // 2: ambient.protodef.SiteAuthorizedResponse
-	(*SiteNeighborPluginGrantListRequest)(nil),       // 3: ambient.protodef.SiteNeighborPluginGrantListRequest
-	(*SiteNeighborPluginGrantListResponse)(nil),      // 4: ambient.protodef.SiteNeighborPluginGrantListResponse
-	(*SiteNeighborPluginGrantsRequest)(nil),          // 5: ambient.protodef.SiteNeighborPluginGrantsRequest
-	(*SiteNeighborPluginGrantsResponse)(nil),         // 6: ambient.protodef.SiteNeighborPluginGrantsResponse
-	(*SiteNeighborPluginGrantedRequest)(nil),         // 7: ambient.protodef.SiteNeighborPluginGrantedRequest
-	(*SiteNeighborPluginGrantedResponse)(nil),        // 8: ambient.protodef.SiteNeighborPluginGrantedResponse
-	(*SiteNeighborPluginRequestedGrantRequest)(nil),  // 9: ambient.protodef.SiteNeighborPluginRequestedGrantRequest
-	(*SiteNeighborPluginRequestedGrantResponse)(nil), // 10: ambient.protodef.SiteNeighborPluginRequestedGrantResponse
-	(*SiteSetNeighborPluginGrantRequest)(nil),        // 11: ambient.protodef.SiteSetNeighborPluginGrantRequest
-	(*SitePluginsResponse)(nil),                      // 12: ambient.protodef.SitePluginsResponse
-	(*SitePluginNamesResponse)(nil),                  // 13: ambient.protodef.SitePluginNamesResponse
-	(*SiteDeletePluginRequest)(nil),                  // 14: ambient.protodef.SiteDeletePluginRequest
-	(*SiteEnablePluginRequest)(nil),                  // 15: ambient.protodef.SiteEnablePluginRequest
-	(*SiteDisablePluginRequest)(nil),                 // 16: ambient.protodef.SiteDisablePluginRequest
-	(*SiteSavePostRequest)(nil),                      // 17: ambient.protodef.SiteSavePostRequest
-	(*SitePostsAndPagesRequest)(nil),                 // 18: ambient.protodef.SitePostsAndPagesRequest
-	(*SitePostsAndPagesResponse)(nil),                // 19: ambient.protodef.SitePostsAndPagesResponse
-	(*SitePublishedPostsResponse)(nil),               // 20: ambient.protodef.SitePublishedPostsResponse
-	(*SitePublishedPagesResponse)(nil),               // 21: ambient.protodef.SitePublishedPagesResponse
-	(*SitePostBySlugRequest)(nil),                    // 22: ambient.protodef.SitePostBySlugRequest
-	(*SitePostBySlugResponse)(nil),                   // 23: ambient.protodef.SitePostBySlugResponse
-	(*SitePostByIDRequest)(nil),                      // 24: ambient.protodef.SitePostByIDRequest
-	(*SitePostByIDResponse)(nil),                     // 25: ambient.protodef.SitePostByIDResponse
-	(*SiteDeletePostByIDRequest)(nil),                // 26: ambient.protodef.SiteDeletePostByIDRequest
-	(*SitePluginNeighborRoutesListRequest)(nil),      // 27: ambient.protodef.SitePluginNeighborRoutesListRequest
-	(*SitePluginNeighborRoutesListResponse)(nil),     // 28: ambient.protodef.SitePluginNeighborRoutesListResponse
-	(*SiteUserPersistRequest)(nil),                   // 29: ambient.protodef.SiteUserPersistRequest
-	(*SiteUserLoginRequest)(nil),                     // 30: ambient.protodef.SiteUserLoginRequest
-	(*SiteAuthenticatedUserRequest)(nil),             // 31: ambient.protodef.SiteAuthenticatedUserRequest
-	(*SiteAuthenticatedUserResponse)(nil),            // 32: ambient.protodef.SiteAuthenticatedUserResponse
-	(*SiteUserLogoutRequest)(nil),                    // 33: ambient.protodef.SiteUserLogoutRequest
-	(*SiteLogoutAllUsersRequest)(nil),                // 34: ambient.protodef.SiteLogoutAllUsersRequest
-	(*SiteSetCSRFRequest)(nil),                       // 35: ambient.protodef.SiteSetCSRFRequest
-	(*SiteSetCSRFResponse)(nil),                      // 36: ambient.protodef.SiteSetCSRFResponse
-	(*SiteCSRFRequest)(nil),                          // 37: ambient.protodef.SiteCSRFRequest
-	(*SiteCSRFResponse)(nil),                         // 38: ambient.protodef.SiteCSRFResponse
-	(*SiteSessionValueRequest)(nil),                  // 39: ambient.protodef.SiteSessionValueRequest
-	(*SiteSessionValueResponse)(nil),                 // 40: ambient.protodef.SiteSessionValueResponse
-	(*SiteSetSessionValueRequest)(nil),               // 41: ambient.protodef.SiteSetSessionValueRequest
-	(*SiteDeleteSessionValueRequest)(nil),            // 42: ambient.protodef.SiteDeleteSessionValueRequest
-	(*SitePluginNeighborSettingsListRequest)(nil),    // 43: ambient.protodef.SitePluginNeighborSettingsListRequest
-	(*SitePluginNeighborSettingsListResponse)(nil),   // 44: ambient.protodef.SitePluginNeighborSettingsListResponse
-	(*SiteSetPluginSettingRequest)(nil),              // 45: ambient.protodef.SiteSetPluginSettingRequest
-	(*SitePluginSettingBoolRequest)(nil),             // 46: ambient.protodef.SitePluginSettingBoolRequest
-	(*SitePluginSettingBoolResponse)(nil),            // 47: ambient.protodef.SitePluginSettingBoolResponse
-	(*SitePluginSettingStringRequest)(nil),           // 48: ambient.protodef.SitePluginSettingStringRequest
-	(*SitePluginSettingStringResponse)(nil),          // 49: ambient.protodef.SitePluginSettingStringResponse
-	(*SitePluginSettingRequest)(nil),                 // 50: ambient.protodef.SitePluginSettingRequest
-	(*SitePluginSettingResponse)(nil),                // 51: ambient.protodef.SitePluginSettingResponse
-	(*SiteSetNeighborPluginSettingRequest)(nil),      // 52: ambient.protodef.SiteSetNeighborPluginSettingRequest
-	(*SiteNeighborPluginSettingStringRequest)(nil),   // 53: ambient.protodef.SiteNeighborPluginSettingStringRequest
-	(*SiteNeighborPluginSettingStringResponse)(nil),  // 54: ambient.protodef.SiteNeighborPluginSettingStringResponse
-	(*SiteNeighborPluginSettingRequest)(nil),         // 55: ambient.protodef.SiteNeighborPluginSettingRequest
-	(*SiteNeighborPluginSettingResponse)(nil),        // 56: ambient.protodef.SiteNeighborPluginSettingResponse
-	(*SitePluginTrustedRequest)(nil),                 // 57: ambient.protodef.SitePluginTrustedRequest
-	(*SitePluginTrustedResponse)(nil),                // 58: ambient.protodef.SitePluginTrustedResponse
-	(*SiteSetTitleRequest)(nil),                      // 59: ambient.protodef.SiteSetTitleRequest
-	(*SiteTitleResponse)(nil),                        // 60: ambient.protodef.SiteTitleResponse
-	(*SiteSetSchemeRequest)(nil),                     // 61: ambient.protodef.SiteSetSchemeRequest
-	(*SiteSchemeResponse)(nil),                       // 62: ambient.protodef.SiteSchemeResponse
-	(*SiteSetURLRequest)(nil),                        // 63: ambient.protodef.SiteSetURLRequest
-	(*SiteURLResponse)(nil),                          // 64: ambient.protodef.SiteURLResponse
-	(*SiteFullURLResponse)(nil),                      // 65: ambient.protodef.SiteFullURLResponse
-	(*SiteUpdatedResponse)(nil),                      // 66: ambient.protodef.SiteUpdatedResponse
-	(*SiteSetContentRequest)(nil),                    // 67: ambient.protodef.SiteSetContentRequest
-	(*SiteContentResponse)(nil),                      // 68: ambient.protodef.SiteContentResponse
-	(*SiteTagsRequest)(nil),                          // 69: ambient.protodef.SiteTagsRequest
-	(*SiteTagsResponse)(nil),                         // 70: ambient.protodef.SiteTagsResponse
-	(*Tag)(nil),                                      // 71: ambient.protodef.Tag
-	(*GrantRequest)(nil),                             // 72: ambient.protodef.GrantRequest
-	(*structpb.Struct)(nil),                          // 73: google.protobuf.Struct
-	(*anypb.Any)(nil),                                // 74: google.protobuf.Any
-	(*timestamppb.Timestamp)(nil),                    // 75: google.protobuf.Timestamp
-	(*Empty)(nil),                                    // 76: ambient.protodef.Empty
+// Deprecated: Use SitePluginNeighborSettingsListResponse.ProtoReflect.Descriptor instead.
+func (*SitePluginNeighborSettingsListResponse) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{72}
 }
-var file_site_proto_depIdxs = []int32{
-	72, // 0: ambient.protodef.SiteNeighborPluginGrantListResponse.grants:type_name -> ambient.protodef.GrantRequest
-	73, // 1: ambient.protodef.SiteNeighborPluginGrantsResponse.grants:type_name -> google.protobuf.Struct
-	73, // 2: ambient.protodef.SitePluginsResponse.plugindata:type_name -> google.protobuf.Struct
-	73, // 3: ambient.protodef.SiteSavePostRequest.post:type_name -> google.protobuf.Struct
-	73, // 4: ambient.protodef.SitePostsAndPagesResponse.postwithidlist:type_name -> google.protobuf.Struct
-	73, // 5: ambient.protodef.SitePublishedPostsResponse.posts:type_name -> google.protobuf.Struct
-	73, // 6: ambient.protodef.SitePublishedPagesResponse.posts:type_name -> google.protobuf.Struct
-	73, // 7: ambient.protodef.SitePostBySlugResponse.post:type_name -> google.protobuf.Struct
-	73, // 8: ambient.protodef.SitePostByIDResponse.post:type_name -> google.protobuf.Struct
-	73, // 9: ambient.protodef.SitePluginNeighborRoutesListResponse.routes:type_name -> google.protobuf.Struct
-	73, // 10: ambient.protodef.SitePluginNeighborSettingsListResponse.settings:type_name -> google.protobuf.Struct
-	74, // 11: ambient.protodef.SitePluginSettingResponse.value:type_name -> google.protobuf.Any
-	74, // 12: ambient.protodef.SiteNeighborPluginSettingResponse.value:type_name -> google.protobuf.Any
-	75, // 13: ambient.protodef.SiteUpdatedResponse.timestamp:type_name -> google.protobuf.Timestamp
-	71, // 14: ambient.protodef.SiteTagsResponse.tags:type_name -> ambient.protodef.Tag
-	75, // 15: ambient.protodef.Tag.timestamp:type_name -> google.protobuf.Timestamp
-	76, // 16: ambient.protodef.Site.Load:input_type -> ambient.protodef.Empty
-	0,  // 17: ambient.protodef.Site.LoadSinglePluginPages:input_type -> ambient.protodef.SiteLoadSinglePluginPagesRequest
-	1,  // 18: ambient.protodef.Site.Authorized:input_type -> ambient.protodef.SiteAuthorizedRequest
-	3,  // 19: ambient.protodef.Site.NeighborPluginGrantList:input_type -> ambient.protodef.SiteNeighborPluginGrantListRequest
-	5,  // 20: ambient.protodef.Site.NeighborPluginGrants:input_type -> ambient.protodef.SiteNeighborPluginGrantsRequest
-	7,  // 21: ambient.protodef.Site.NeighborPluginGranted:input_type -> ambient.protodef.SiteNeighborPluginGrantedRequest
-	9,  // 22: ambient.protodef.Site.NeighborPluginRequestedGrant:input_type -> ambient.protodef.SiteNeighborPluginRequestedGrantRequest
-	11, // 23: ambient.protodef.Site.SetNeighborPluginGrant:input_type -> ambient.protodef.SiteSetNeighborPluginGrantRequest
-	76, // 24: ambient.protodef.Site.Plugins:input_type -> ambient.protodef.Empty
-	76, // 25: ambient.protodef.Site.PluginNames:input_type -> ambient.protodef.Empty
-	14, // 26: ambient.protodef.Site.DeletePlugin:input_type -> ambient.protodef.SiteDeletePluginRequest
-	15, // 27: ambient.protodef.Site.EnablePlugin:input_type -> ambient.protodef.SiteEnablePluginRequest
-	16, // 28: ambient.protodef.Site.DisablePlugin:input_type -> ambient.protodef.SiteDisablePluginRequest
-	17, // 29: ambient.protodef.Site.SavePost:input_type -> ambient.protodef.SiteSavePostRequest
-	18, // 30: ambient.protodef.Site.PostsAndPages:input_type -> ambient.protodef.SitePostsAndPagesRequest
-	76, // 31: ambient.protodef.Site.PublishedPosts:input_type -> ambient.protodef.Empty
-	76, // 32: ambient.protodef.Site.PublishedPages:input_type -> ambient.protodef.Empty
-	22, // 33: ambient.protodef.Site.PostBySlug:input_type -> ambient.protodef.SitePostBySlugRequest
-	24, // 34: ambient.protodef.Site.PostByID:input_type -> ambient.protodef.SitePostByIDRequest
-	26, // 35: ambient.protodef.Site.DeletePostByID:input_type -> ambient.protodef.SiteDeletePostByIDRequest
-	27, // 36: ambient.protodef.Site.PluginNeighborRoutesList:input_type -> ambient.protodef.SitePluginNeighborRoutesListRequest
-	29, // 37: ambient.protodef.Site.UserPersist:input_type -> ambient.protodef.SiteUserPersistRequest
-	30, // 38: ambient.protodef.Site.UserLogin:input_type -> ambient.protodef.SiteUserLoginRequest
-	31, // 39: ambient.protodef.Site.AuthenticatedUser:input_type -> ambient.protodef.SiteAuthenticatedUserRequest
-	33, // 40: ambient.protodef.Site.UserLogout:input_type -> ambient.protodef.SiteUserLogoutRequest
-	34, // 41: ambient.protodef.Site.LogoutAllUsers:input_type -> ambient.protodef.SiteLogoutAllUsersRequest
-	35, // 42: ambient.protodef.Site.SetCSRF:input_type -> ambient.protodef.SiteSetCSRFRequest
-	37, // 43: ambient.protodef.Site.CSRF:input_type -> ambient.protodef.SiteCSRFRequest
-	39, // 44: ambient.protodef.Site.SessionValue:input_type -> ambient.protodef.SiteSessionValueRequest
-	41, // 45: ambient.protodef.Site.SetSessionValue:input_type -> ambient.protodef.SiteSetSessionValueRequest
-	42, // 46: ambient.protodef.Site.DeleteSessionValue:input_type -> ambient.protodef.SiteDeleteSessionValueRequest
-	43, // 47: ambient.protodef.Site.PluginNeighborSettingsList:input_type -> ambient.protodef.SitePluginNeighborSettingsListRequest
-	45, // 48: ambient.protodef.Site.SetPluginSetting:input_type -> ambient.protodef.SiteSetPluginSettingRequest
-	46, // 49: ambient.protodef.Site.PluginSettingBool:input_type -> ambient.protodef.SitePluginSettingBoolRequest
-	48, // 50: ambient.protodef.Site.PluginSettingString:input_type -> ambient.protodef.SitePluginSettingStringRequest
-	50, // 51: ambient.protodef.Site.PluginSetting:input_type -> ambient.protodef.SitePluginSettingRequest
-	52, // 52: ambient.protodef.Site.SetNeighborPluginSetting:input_type -> ambient.protodef.SiteSetNeighborPluginSettingRequest
-	53, // 53: ambient.protodef.Site.NeighborPluginSettingString:input_type -> ambient.protodef.SiteNeighborPluginSettingStringRequest
-	55, // 54: ambient.protodef.Site.NeighborPluginSetting:input_type -> ambient.protodef.SiteNeighborPluginSettingRequest
-	57, // 55: ambient.protodef.Site.PluginTrusted:input_type -> ambient.protodef.SitePluginTrustedRequest
-	59, // 56: ambient.protodef.Site.SetTitle:input_type -> ambient.protodef.SiteSetTitleRequest
-	76, // 57: ambient.protodef.Site.Title:input_type -> ambient.protodef.Empty
-	61, // 58: ambient.protodef.Site.SetScheme:input_type -> ambient.protodef.SiteSetSchemeRequest
-	76, // 59: ambient.protodef.Site.Scheme:input_type -> ambient.protodef.Empty
-	63, // 60: ambient.protodef.Site.SetURL:input_type -> ambient.protodef.SiteSetURLRequest
-	76, // 61: ambient.protodef.Site.URL:input_type -> ambient.protodef.Empty
-	76, // 62: ambient.protodef.Site.FullURL:input_type -> ambient.protodef.Empty
-	76, // 63: ambient.protodef.Site.Updated:input_type -> ambient.protodef.Empty
-	67, // 64: ambient.protodef.Site.SetContent:input_type -> ambient.protodef.SiteSetContentRequest
-	76, // 65: ambient.protodef.Site.Content:input_type -> ambient.protodef.Empty
-	69, // 66: ambient.protodef.Site.Tags:input_type -> ambient.protodef.SiteTagsRequest
-	76, // 67: ambient.protodef.Site.Load:output_type -> ambient.protodef.Empty
-	76, // 68: ambient.protodef.Site.LoadSinglePluginPages:output_type -> ambient.protodef.Empty
-	2,  // 69: ambient.protodef.Site.Authorized:output_type -> ambient.protodef.SiteAuthorizedResponse
-	4,  // 70: ambient.protodef.Site.NeighborPluginGrantList:output_type -> ambient.protodef.SiteNeighborPluginGrantListResponse
-	6,  // 71: ambient.protodef.Site.NeighborPluginGrants:output_type -> ambient.protodef.SiteNeighborPluginGrantsResponse
-	8,  // 72: ambient.protodef.Site.NeighborPluginGranted:output_type -> ambient.protodef.SiteNeighborPluginGrantedResponse
-	10, // 73: ambient.protodef.Site.NeighborPluginRequestedGrant:output_type -> ambient.protodef.SiteNeighborPluginRequestedGrantResponse
-	76, // 74: ambient.protodef.Site.SetNeighborPluginGrant:output_type -> ambient.protodef.Empty
-	12, // 75: ambient.protodef.Site.Plugins:output_type -> ambient.protodef.SitePluginsResponse
-	13, // 76: ambient.protodef.Site.PluginNames:output_type -> ambient.protodef.SitePluginNamesResponse
-	76, // 77: ambient.protodef.Site.DeletePlugin:output_type -> ambient.protodef.Empty
-	76, // 78: ambient.protodef.Site.EnablePlugin:output_type -> ambient.protodef.Empty
-	76, // 79: ambient.protodef.Site.DisablePlugin:output_type -> ambient.protodef.Empty
-	76, // 80: ambient.protodef.Site.SavePost:output_type -> ambient.protodef.Empty
-	19, // 81: ambient.protodef.Site.PostsAndPages:output_type -> ambient.protodef.SitePostsAndPagesResponse
-	20, // 82: ambient.protodef.Site.PublishedPosts:output_type -> ambient.protodef.SitePublishedPostsResponse
-	21, // 83: ambient.protodef.Site.PublishedPages:output_type -> ambient.protodef.SitePublishedPagesResponse
-	23, // 84: ambient.protodef.Site.PostBySlug:output_type -> ambient.protodef.SitePostBySlugResponse
-	25, // 85: ambient.protodef.Site.PostByID:output_type -> ambient.protodef.SitePostByIDResponse
-	76, // 86: ambient.protodef.Site.DeletePostByID:output_type -> ambient.protodef.Empty
-	28, // 87: ambient.protodef.Site.PluginNeighborRoutesList:output_type -> ambient.protodef.SitePluginNeighborRoutesListResponse
-	76, // 88: ambient.protodef.Site.UserPersist:output_type -> ambient.protodef.Empty
-	76, // 89: ambient.protodef.Site.UserLogin:output_type -> ambient.protodef.Empty
-	32, // 90: ambient.protodef.Site.AuthenticatedUser:output_type -> ambient.protodef.SiteAuthenticatedUserResponse
-	76, // 91: ambient.protodef.Site.UserLogout:output_type -> ambient.protodef.Empty
-	76, // 92: ambient.protodef.Site.LogoutAllUsers:output_type -> ambient.protodef.Empty
-	36, // 93: ambient.protodef.Site.SetCSRF:output_type -> ambient.protodef.SiteSetCSRFResponse
-	38, // 94: ambient.protodef.Site.CSRF:output_type -> ambient.protodef.SiteCSRFResponse
-	40, // 95: ambient.protodef.Site.SessionValue:output_type -> ambient.protodef.SiteSessionValueResponse
-	76, // 96: ambient.protodef.Site.SetSessionValue:output_type -> ambient.protodef.Empty
-	76, // 97: ambient.protodef.Site.DeleteSessionValue:output_type -> ambient.protodef.Empty
-	44, // 98: ambient.protodef.Site.PluginNeighborSettingsList:output_type -> ambient.protodef.SitePluginNeighborSettingsListResponse
-	76, // 99: ambient.protodef.Site.SetPluginSetting:output_type -> ambient.protodef.Empty
-	47, // 100: ambient.protodef.Site.PluginSettingBool:output_type -> ambient.protodef.SitePluginSettingBoolResponse
-	49, // 101: ambient.protodef.Site.PluginSettingString:output_type -> ambient.protodef.SitePluginSettingStringResponse
-	51, // 102: ambient.protodef.Site.PluginSetting:output_type -> ambient.protodef.SitePluginSettingResponse
-	76, // 103: ambient.protodef.Site.SetNeighborPluginSetting:output_type -> ambient.protodef.Empty
-	54, // 104: ambient.protodef.Site.NeighborPluginSettingString:output_type -> ambient.protodef.SiteNeighborPluginSettingStringResponse
-	56, // 105: ambient.protodef.Site.NeighborPluginSetting:output_type -> ambient.protodef.SiteNeighborPluginSettingResponse
-	58, // 106: ambient.protodef.Site.PluginTrusted:output_type -> ambient.protodef.SitePluginTrustedResponse
-	76, // 107: ambient.protodef.Site.SetTitle:output_type -> ambient.protodef.Empty
-	60, // 108: ambient.protodef.Site.Title:output_type -> ambient.protodef.SiteTitleResponse
-	76, // 109: ambient.protodef.Site.SetScheme:output_type -> ambient.protodef.Empty
-	62, // 110: ambient.protodef.Site.Scheme:output_type -> ambient.protodef.SiteSchemeResponse
-	76, // 111: ambient.protodef.Site.SetURL:output_type -> ambient.protodef.Empty
-	64, // 112: ambient.protodef.Site.URL:output_type -> ambient.protodef.SiteURLResponse
-	65, // 113: ambient.protodef.Site.FullURL:output_type -> ambient.protodef.SiteFullURLResponse
-	66, // 114: ambient.protodef.Site.Updated:output_type -> ambient.protodef.SiteUpdatedResponse
-	76, // 115: ambient.protodef.Site.SetContent:output_type -> ambient.protodef.Empty
-	68, // 116: ambient.protodef.Site.Content:output_type -> ambient.protodef.SiteContentResponse
-	70, // 117: ambient.protodef.Site.Tags:output_type -> ambient.protodef.SiteTagsResponse
-	67, // [67:118] is the sub-list for method output_type
-	16, // [16:67] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+
+func (x *SitePluginNeighborSettingsListResponse) GetSettings() []*structpb.Struct {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
 }
 
-func init() { file_site_proto_init() }
-func file_site_proto_init() {
-	if File_site_proto != nil {
-		return
+type SiteSetPluginSettingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Settingname string `protobuf:"bytes,1,opt,name=settingname,proto3" json:"settingname,omitempty"`
+	Value       string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *SiteSetPluginSettingRequest) Reset() {
+	*x = SiteSetPluginSettingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_site_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	file_empty_proto_init()
-	file_plugin_proto_init()
-	if !protoimpl.UnsafeEnabled {
-		file_site_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SiteLoadSinglePluginPagesRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_site_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SiteAuthorizedRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_site_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SiteAuthorizedResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_site_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SiteNeighborPluginGrantListRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+}
+
+func (x *SiteSetPluginSettingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SiteSetPluginSettingRequest) ProtoMessage() {}
+
+func (x *SiteSetPluginSettingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SiteNeighborPluginGrantListResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SiteSetPluginSettingRequest.ProtoReflect.Descriptor instead.
+func (*SiteSetPluginSettingRequest) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *SiteSetPluginSettingRequest) GetSettingname() string {
+	if x != nil {
+		return x.Settingname
+	}
+	return ""
+}
+
+func (x *SiteSetPluginSettingRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type SitePluginSettingBoolRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fieldname string `protobuf:"bytes,1,opt,name=fieldname,proto3" json:"fieldname,omitempty"`
+}
+
+func (x *SitePluginSettingBoolRequest) Reset() {
+	*x = SitePluginSettingBoolRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_site_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SitePluginSettingBoolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SitePluginSettingBoolRequest) ProtoMessage() {}
+
+func (x *SitePluginSettingBoolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SiteNeighborPluginGrantsRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SitePluginSettingBoolRequest.ProtoReflect.Descriptor instead.
+func (*SitePluginSettingBoolRequest) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *SitePluginSettingBoolRequest) GetFieldname() string {
+	if x != nil {
+		return x.Fieldname
+	}
+	return ""
+}
+
+type SitePluginSettingBoolResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value bool `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *SitePluginSettingBoolResponse) Reset() {
+	*x = SitePluginSettingBoolResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_site_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SitePluginSettingBoolResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SitePluginSettingBoolResponse) ProtoMessage() {}
+
+func (x *SitePluginSettingBoolResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SiteNeighborPluginGrantsResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_site_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SiteNeighborPluginGrantedRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_site_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SiteNeighborPluginGrantedResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_site_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SiteNeighborPluginRequestedGrantRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SitePluginSettingBoolResponse.ProtoReflect.Descriptor instead.
+func (*SitePluginSettingBoolResponse) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *SitePluginSettingBoolResponse) GetValue() bool {
+	if x != nil {
+		return x.Value
+	}
+	return false
+}
+
+type SitePluginSettingStringRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fieldname string `protobuf:"bytes,1,opt,name=fieldname,proto3" json:"fieldname,omitempty"`
+}
+
+func (x *SitePluginSettingStringRequest) Reset() {
+	*x = SitePluginSettingStringRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_site_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SitePluginSettingStringRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SitePluginSettingStringRequest) ProtoMessage() {}
+
+func (x *SitePluginSettingStringRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SiteNeighborPluginRequestedGrantResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SitePluginSettingStringRequest.ProtoReflect.Descriptor instead.
+func (*SitePluginSettingStringRequest) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *SitePluginSettingStringRequest) GetFieldname() string {
+	if x != nil {
+		return x.Fieldname
+	}
+	return ""
+}
+
+type SitePluginSettingStringResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *SitePluginSettingStringResponse) Reset() {
+	*x = SitePluginSettingStringResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_site_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SitePluginSettingStringResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SitePluginSettingStringResponse) ProtoMessage() {}
+
+func (x *SitePluginSettingStringResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SiteSetNeighborPluginGrantRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SitePluginSettingStringResponse.ProtoReflect.Descriptor instead.
+func (*SitePluginSettingStringResponse) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *SitePluginSettingStringResponse) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type SitePluginSettingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fieldname string `protobuf:"bytes,1,opt,name=fieldname,proto3" json:"fieldname,omitempty"`
+}
+
+func (x *SitePluginSettingRequest) Reset() {
+	*x = SitePluginSettingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_site_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SitePluginSettingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SitePluginSettingRequest) ProtoMessage() {}
+
+func (x *SitePluginSettingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SitePluginsResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SitePluginSettingRequest.ProtoReflect.Descriptor instead.
+func (*SitePluginSettingRequest) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *SitePluginSettingRequest) GetFieldname() string {
+	if x != nil {
+		return x.Fieldname
+	}
+	return ""
+}
+
+type SitePluginSettingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value *anypb.Any `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *SitePluginSettingResponse) Reset() {
+	*x = SitePluginSettingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_site_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SitePluginSettingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SitePluginSettingResponse) ProtoMessage() {}
+
+func (x *SitePluginSettingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SitePluginNamesResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_site_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SiteDeletePluginRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_site_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SiteEnablePluginRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SitePluginSettingResponse.ProtoReflect.Descriptor instead.
+func (*SitePluginSettingResponse) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *SitePluginSettingResponse) GetValue() *anypb.Any {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type SiteSetNeighborPluginSettingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pluginname   string `protobuf:"bytes,1,opt,name=pluginname,proto3" json:"pluginname,omitempty"`
+	Settingname  string `protobuf:"bytes,2,opt,name=settingname,proto3" json:"settingname,omitempty"`
+	Settingvalue string `protobuf:"bytes,3,opt,name=settingvalue,proto3" json:"settingvalue,omitempty"`
+}
+
+func (x *SiteSetNeighborPluginSettingRequest) Reset() {
+	*x = SiteSetNeighborPluginSettingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_site_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SiteSetNeighborPluginSettingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SiteSetNeighborPluginSettingRequest) ProtoMessage() {}
+
+func (x *SiteSetNeighborPluginSettingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SiteDisablePluginRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SiteSetNeighborPluginSettingRequest.ProtoReflect.Descriptor instead.
+func (*SiteSetNeighborPluginSettingRequest) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *SiteSetNeighborPluginSettingRequest) GetPluginname() string {
+	if x != nil {
+		return x.Pluginname
+	}
+	return ""
+}
+
+func (x *SiteSetNeighborPluginSettingRequest) GetSettingname() string {
+	if x != nil {
+		return x.Settingname
+	}
+	return ""
+}
+
+func (x *SiteSetNeighborPluginSettingRequest) GetSettingvalue() string {
+	if x != nil {
+		return x.Settingvalue
+	}
+	return ""
+}
+
+type SiteNeighborPluginSettingStringRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pluginname string `protobuf:"bytes,1,opt,name=pluginname,proto3" json:"pluginname,omitempty"`
+	Fieldname  string `protobuf:"bytes,2,opt,name=fieldname,proto3" json:"fieldname,omitempty"`
+}
+
+func (x *SiteNeighborPluginSettingStringRequest) Reset() {
+	*x = SiteNeighborPluginSettingStringRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_site_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SiteNeighborPluginSettingStringRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SiteNeighborPluginSettingStringRequest) ProtoMessage() {}
+
+func (x *SiteNeighborPluginSettingStringRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SiteSavePostRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SiteNeighborPluginSettingStringRequest.ProtoReflect.Descriptor instead.
+func (*SiteNeighborPluginSettingStringRequest) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *SiteNeighborPluginSettingStringRequest) GetPluginname() string {
+	if x != nil {
+		return x.Pluginname
+	}
+	return ""
+}
+
+func (x *SiteNeighborPluginSettingStringRequest) GetFieldname() string {
+	if x != nil {
+		return x.Fieldname
+	}
+	return ""
+}
+
+type SiteNeighborPluginSettingStringResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *SiteNeighborPluginSettingStringResponse) Reset() {
+	*x = SiteNeighborPluginSettingStringResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_site_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SiteNeighborPluginSettingStringResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SiteNeighborPluginSettingStringResponse) ProtoMessage() {}
+
+func (x *SiteNeighborPluginSettingStringResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SitePostsAndPagesRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SiteNeighborPluginSettingStringResponse.ProtoReflect.Descriptor instead.
+func (*SiteNeighborPluginSettingStringResponse) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *SiteNeighborPluginSettingStringResponse) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type SiteNeighborPluginSettingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pluginname string `protobuf:"bytes,1,opt,name=pluginname,proto3" json:"pluginname,omitempty"`
+	Fieldname  string `protobuf:"bytes,2,opt,name=fieldname,proto3" json:"fieldname,omitempty"`
+}
+
+func (x *SiteNeighborPluginSettingRequest) Reset() {
+	*x = SiteNeighborPluginSettingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_site_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SiteNeighborPluginSettingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SiteNeighborPluginSettingRequest) ProtoMessage() {}
+
+func (x *SiteNeighborPluginSettingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SitePostsAndPagesResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_site_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SitePublishedPostsResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_site_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SitePublishedPagesResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SiteNeighborPluginSettingRequest.ProtoReflect.Descriptor instead.
+func (*SiteNeighborPluginSettingRequest) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *SiteNeighborPluginSettingRequest) GetPluginname() string {
+	if x != nil {
+		return x.Pluginname
+	}
+	return ""
+}
+
+func (x *SiteNeighborPluginSettingRequest) GetFieldname() string {
+	if x != nil {
+		return x.Fieldname
+	}
+	return ""
+}
+
+type SiteNeighborPluginSettingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value *anypb.Any `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *SiteNeighborPluginSettingResponse) Reset() {
+	*x = SiteNeighborPluginSettingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_site_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SiteNeighborPluginSettingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SiteNeighborPluginSettingResponse) ProtoMessage() {}
+
+func (x *SiteNeighborPluginSettingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SitePostBySlugRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SiteNeighborPluginSettingResponse.ProtoReflect.Descriptor instead.
+func (*SiteNeighborPluginSettingResponse) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *SiteNeighborPluginSettingResponse) GetValue() *anypb.Any {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type SitePluginTrustedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pluginname string `protobuf:"bytes,1,opt,name=pluginname,proto3" json:"pluginname,omitempty"`
+}
+
+func (x *SitePluginTrustedRequest) Reset() {
+	*x = SitePluginTrustedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_site_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SitePluginTrustedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SitePluginTrustedRequest) ProtoMessage() {}
+
+func (x *SitePluginTrustedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SitePostBySlugResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SitePluginTrustedRequest.ProtoReflect.Descriptor instead.
+func (*SitePluginTrustedRequest) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *SitePluginTrustedRequest) GetPluginname() string {
+	if x != nil {
+		return x.Pluginname
+	}
+	return ""
+}
+
+type SitePluginTrustedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Trusted bool `protobuf:"varint,1,opt,name=trusted,proto3" json:"trusted,omitempty"`
+}
+
+func (x *SitePluginTrustedResponse) Reset() {
+	*x = SitePluginTrustedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_site_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SitePluginTrustedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SitePluginTrustedResponse) ProtoMessage() {}
+
+func (x *SitePluginTrustedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SitePostByIDRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SitePluginTrustedResponse.ProtoReflect.Descriptor instead.
+func (*SitePluginTrustedResponse) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *SitePluginTrustedResponse) GetTrusted() bool {
+	if x != nil {
+		return x.Trusted
+	}
+	return false
+}
+
+type SiteSetTitleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+}
+
+func (x *SiteSetTitleRequest) Reset() {
+	*x = SiteSetTitleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_site_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SiteSetTitleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SiteSetTitleRequest) ProtoMessage() {}
+
+func (x *SiteSetTitleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SitePostByIDResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_site_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SiteDeletePostByIDRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_site_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SitePluginNeighborRoutesListRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_site_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SitePluginNeighborRoutesListResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_site_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SiteUserPersistRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SiteSetTitleRequest.ProtoReflect.Descriptor instead.
+func (*SiteSetTitleRequest) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *SiteSetTitleRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+type SiteTitleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+}
+
+func (x *SiteTitleResponse) Reset() {
+	*x = SiteTitleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_site_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SiteTitleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SiteTitleResponse) ProtoMessage() {}
+
+func (x *SiteTitleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SiteUserLoginRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SiteTitleResponse.ProtoReflect.Descriptor instead.
+func (*SiteTitleResponse) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *SiteTitleResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+type SiteSetSchemeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Scheme string `protobuf:"bytes,1,opt,name=scheme,proto3" json:"scheme,omitempty"`
+}
+
+func (x *SiteSetSchemeRequest) Reset() {
+	*x = SiteSetSchemeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_site_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SiteSetSchemeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SiteSetSchemeRequest) ProtoMessage() {}
+
+func (x *SiteSetSchemeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SiteAuthenticatedUserRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SiteSetSchemeRequest.ProtoReflect.Descriptor instead.
+func (*SiteSetSchemeRequest) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *SiteSetSchemeRequest) GetScheme() string {
+	if x != nil {
+		return x.Scheme
+	}
+	return ""
+}
+
+type SiteSchemeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Scheme string `protobuf:"bytes,1,opt,name=scheme,proto3" json:"scheme,omitempty"`
+}
+
+func (x *SiteSchemeResponse) Reset() {
+	*x = SiteSchemeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_site_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SiteSchemeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SiteSchemeResponse) ProtoMessage() {}
+
+func (x *SiteSchemeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SiteAuthenticatedUserResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SiteSchemeResponse.ProtoReflect.Descriptor instead.
+func (*SiteSchemeResponse) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *SiteSchemeResponse) GetScheme() string {
+	if x != nil {
+		return x.Scheme
+	}
+	return ""
+}
+
+type SiteSetURLRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+}
+
+func (x *SiteSetURLRequest) Reset() {
+	*x = SiteSetURLRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_site_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SiteSetURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SiteSetURLRequest) ProtoMessage() {}
+
+func (x *SiteSetURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SiteUserLogoutRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_site_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SiteLogoutAllUsersRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_site_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SiteSetCSRFRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_site_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SiteSetCSRFResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SiteSetURLRequest.ProtoReflect.Descriptor instead.
+func (*SiteSetURLRequest) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *SiteSetURLRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type SiteURLResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+}
+
+func (x *SiteURLResponse) Reset() {
+	*x = SiteURLResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_site_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SiteURLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SiteURLResponse) ProtoMessage() {}
+
+func (x *SiteURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SiteCSRFRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SiteURLResponse.ProtoReflect.Descriptor instead.
+func (*SiteURLResponse) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *SiteURLResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type SiteFullURLResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fullurl string `protobuf:"bytes,1,opt,name=fullurl,proto3" json:"fullurl,omitempty"`
+}
+
+func (x *SiteFullURLResponse) Reset() {
+	*x = SiteFullURLResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_site_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SiteFullURLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SiteFullURLResponse) ProtoMessage() {}
+
+func (x *SiteFullURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SiteCSRFResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SiteFullURLResponse.ProtoReflect.Descriptor instead.
+func (*SiteFullURLResponse) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *SiteFullURLResponse) GetFullurl() string {
+	if x != nil {
+		return x.Fullurl
+	}
+	return ""
+}
+
+type SiteUpdatedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *SiteUpdatedResponse) Reset() {
+	*x = SiteUpdatedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_site_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SiteUpdatedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SiteUpdatedResponse) ProtoMessage() {}
+
+func (x *SiteUpdatedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SiteSessionValueRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SiteUpdatedResponse.ProtoReflect.Descriptor instead.
+func (*SiteUpdatedResponse) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *SiteUpdatedResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type SiteSetContentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Content string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (x *SiteSetContentRequest) Reset() {
+	*x = SiteSetContentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_site_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SiteSetContentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SiteSetContentRequest) ProtoMessage() {}
+
+func (x *SiteSetContentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SiteSessionValueResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_site_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SiteSetSessionValueRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_site_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SiteDeleteSessionValueRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_site_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SitePluginNeighborSettingsListRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SiteSetContentRequest.ProtoReflect.Descriptor instead.
+func (*SiteSetContentRequest) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *SiteSetContentRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type SiteContentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Content string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (x *SiteContentResponse) Reset() {
+	*x = SiteContentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_site_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SiteContentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SiteContentResponse) ProtoMessage() {}
+
+func (x *SiteContentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SitePluginNeighborSettingsListResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SiteContentResponse.ProtoReflect.Descriptor instead.
+func (*SiteContentResponse) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *SiteContentResponse) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type SiteTagsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Onlypublished bool `protobuf:"varint,1,opt,name=onlypublished,proto3" json:"onlypublished,omitempty"`
+}
+
+func (x *SiteTagsRequest) Reset() {
+	*x = SiteTagsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_site_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SiteTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SiteTagsRequest) ProtoMessage() {}
+
+func (x *SiteTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SiteSetPluginSettingRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SiteTagsRequest.ProtoReflect.Descriptor instead.
+func (*SiteTagsRequest) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *SiteTagsRequest) GetOnlypublished() bool {
+	if x != nil {
+		return x.Onlypublished
+	}
+	return false
+}
+
+type SiteTagsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tags []*Tag `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+}
+
+func (x *SiteTagsResponse) Reset() {
+	*x = SiteTagsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_site_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SiteTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SiteTagsResponse) ProtoMessage() {}
+
+func (x *SiteTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SitePluginSettingBoolRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SiteTagsResponse.ProtoReflect.Descriptor instead.
+func (*SiteTagsResponse) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *SiteTagsResponse) GetTags() []*Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type Tag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *Tag) Reset() {
+	*x = Tag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_site_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tag) ProtoMessage() {}
+
+func (x *Tag) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SitePluginSettingBoolResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_site_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SitePluginSettingStringRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tag.ProtoReflect.Descriptor instead.
+func (*Tag) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *Tag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Tag) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type SiteTagDetailsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tags []*structpb.Struct `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+}
+
+func (x *SiteTagDetailsResponse) Reset() {
+	*x = SiteTagDetailsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_site_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SiteTagDetailsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SiteTagDetailsResponse) ProtoMessage() {}
+
+func (x *SiteTagDetailsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SitePluginSettingStringResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SiteTagDetailsResponse.ProtoReflect.Descriptor instead.
+func (*SiteTagDetailsResponse) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *SiteTagDetailsResponse) GetTags() []*structpb.Struct {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type SiteTagBySlugRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slug          string `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Onlypublished bool   `protobuf:"varint,2,opt,name=onlypublished,proto3" json:"onlypublished,omitempty"`
+}
+
+func (x *SiteTagBySlugRequest) Reset() {
+	*x = SiteTagBySlugRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_site_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SiteTagBySlugRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SiteTagBySlugRequest) ProtoMessage() {}
+
+func (x *SiteTagBySlugRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SitePluginSettingRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SiteTagBySlugRequest.ProtoReflect.Descriptor instead.
+func (*SiteTagBySlugRequest) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *SiteTagBySlugRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *SiteTagBySlugRequest) GetOnlypublished() bool {
+	if x != nil {
+		return x.Onlypublished
+	}
+	return false
+}
+
+type SiteTagBySlugResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tag *structpb.Struct `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+}
+
+func (x *SiteTagBySlugResponse) Reset() {
+	*x = SiteTagBySlugResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_site_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SiteTagBySlugResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SiteTagBySlugResponse) ProtoMessage() {}
+
+func (x *SiteTagBySlugResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SitePluginSettingResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SiteTagBySlugResponse.ProtoReflect.Descriptor instead.
+func (*SiteTagBySlugResponse) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *SiteTagBySlugResponse) GetTag() *structpb.Struct {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+type SiteRenameTagRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Newname string `protobuf:"bytes,2,opt,name=newname,proto3" json:"newname,omitempty"`
+}
+
+func (x *SiteRenameTagRequest) Reset() {
+	*x = SiteRenameTagRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_site_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SiteRenameTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SiteRenameTagRequest) ProtoMessage() {}
+
+func (x *SiteRenameTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SiteSetNeighborPluginSettingRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SiteRenameTagRequest.ProtoReflect.Descriptor instead.
+func (*SiteRenameTagRequest) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *SiteRenameTagRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SiteRenameTagRequest) GetNewname() string {
+	if x != nil {
+		return x.Newname
+	}
+	return ""
+}
+
+type SiteMergeTagsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Into string `protobuf:"bytes,2,opt,name=into,proto3" json:"into,omitempty"`
+}
+
+func (x *SiteMergeTagsRequest) Reset() {
+	*x = SiteMergeTagsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_site_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SiteMergeTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SiteMergeTagsRequest) ProtoMessage() {}
+
+func (x *SiteMergeTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SiteNeighborPluginSettingStringRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_site_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SiteNeighborPluginSettingStringResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_site_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SiteNeighborPluginSettingRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_site_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SiteNeighborPluginSettingResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SiteMergeTagsRequest.ProtoReflect.Descriptor instead.
+func (*SiteMergeTagsRequest) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *SiteMergeTagsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SiteMergeTagsRequest) GetInto() string {
+	if x != nil {
+		return x.Into
+	}
+	return ""
+}
+
+type SiteDeleteTagRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *SiteDeleteTagRequest) Reset() {
+	*x = SiteDeleteTagRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_site_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SiteDeleteTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SiteDeleteTagRequest) ProtoMessage() {}
+
+func (x *SiteDeleteTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SitePluginTrustedRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SiteDeleteTagRequest.ProtoReflect.Descriptor instead.
+func (*SiteDeleteTagRequest) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *SiteDeleteTagRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type SiteSetTagMetaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Meta *structpb.Struct `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
+}
+
+func (x *SiteSetTagMetaRequest) Reset() {
+	*x = SiteSetTagMetaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_site_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SiteSetTagMetaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SiteSetTagMetaRequest) ProtoMessage() {}
+
+func (x *SiteSetTagMetaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SitePluginTrustedResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SiteSetTagMetaRequest.ProtoReflect.Descriptor instead.
+func (*SiteSetTagMetaRequest) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *SiteSetTagMetaRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SiteSetTagMetaRequest) GetMeta() *structpb.Struct {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+type SiteSnapshotsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Snapshots []*structpb.Struct `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+}
+
+func (x *SiteSnapshotsResponse) Reset() {
+	*x = SiteSnapshotsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_site_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SiteSnapshotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SiteSnapshotsResponse) ProtoMessage() {}
+
+func (x *SiteSnapshotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SiteSetTitleRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SiteSnapshotsResponse.ProtoReflect.Descriptor instead.
+func (*SiteSnapshotsResponse) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *SiteSnapshotsResponse) GetSnapshots() []*structpb.Struct {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+type SiteCreateSnapshotResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Snapshot *structpb.Struct `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+}
+
+func (x *SiteCreateSnapshotResponse) Reset() {
+	*x = SiteCreateSnapshotResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_site_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SiteCreateSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SiteCreateSnapshotResponse) ProtoMessage() {}
+
+func (x *SiteCreateSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SiteTitleResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SiteCreateSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*SiteCreateSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *SiteCreateSnapshotResponse) GetSnapshot() *structpb.Struct {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+type SiteSnapshotDiffRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *SiteSnapshotDiffRequest) Reset() {
+	*x = SiteSnapshotDiffRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_site_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SiteSnapshotDiffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SiteSnapshotDiffRequest) ProtoMessage() {}
+
+func (x *SiteSnapshotDiffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SiteSetSchemeRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_site_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SiteSchemeResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_site_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SiteSetURLRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_site_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SiteURLResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SiteSnapshotDiffRequest.ProtoReflect.Descriptor instead.
+func (*SiteSnapshotDiffRequest) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *SiteSnapshotDiffRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type SiteSnapshotDiffResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Changes []*structpb.Struct `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (x *SiteSnapshotDiffResponse) Reset() {
+	*x = SiteSnapshotDiffResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_site_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SiteSnapshotDiffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SiteSnapshotDiffResponse) ProtoMessage() {}
+
+func (x *SiteSnapshotDiffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SiteFullURLResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SiteSnapshotDiffResponse.ProtoReflect.Descriptor instead.
+func (*SiteSnapshotDiffResponse) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *SiteSnapshotDiffResponse) GetChanges() []*structpb.Struct {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type SiteRestoreSnapshotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *SiteRestoreSnapshotRequest) Reset() {
+	*x = SiteRestoreSnapshotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_site_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SiteRestoreSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SiteRestoreSnapshotRequest) ProtoMessage() {}
+
+func (x *SiteRestoreSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SiteUpdatedResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SiteRestoreSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*SiteRestoreSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *SiteRestoreSnapshotRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type SitePluginStoreGetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *SitePluginStoreGetRequest) Reset() {
+	*x = SitePluginStoreGetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_site_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SitePluginStoreGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SitePluginStoreGetRequest) ProtoMessage() {}
+
+func (x *SitePluginStoreGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SiteSetContentRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SitePluginStoreGetRequest.ProtoReflect.Descriptor instead.
+func (*SitePluginStoreGetRequest) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *SitePluginStoreGetRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type SitePluginStoreGetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value []byte `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *SitePluginStoreGetResponse) Reset() {
+	*x = SitePluginStoreGetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_site_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SitePluginStoreGetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SitePluginStoreGetResponse) ProtoMessage() {}
+
+func (x *SitePluginStoreGetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SiteContentResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SitePluginStoreGetResponse.ProtoReflect.Descriptor instead.
+func (*SitePluginStoreGetResponse) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *SitePluginStoreGetResponse) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type SitePluginStorePutRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *SitePluginStorePutRequest) Reset() {
+	*x = SitePluginStorePutRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_site_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SitePluginStorePutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SitePluginStorePutRequest) ProtoMessage() {}
+
+func (x *SitePluginStorePutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SiteTagsRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SitePluginStorePutRequest.ProtoReflect.Descriptor instead.
+func (*SitePluginStorePutRequest) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *SitePluginStorePutRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SitePluginStorePutRequest) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type SitePluginStoreDeleteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *SitePluginStoreDeleteRequest) Reset() {
+	*x = SitePluginStoreDeleteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_site_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SitePluginStoreDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SitePluginStoreDeleteRequest) ProtoMessage() {}
+
+func (x *SitePluginStoreDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SiteTagsResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SitePluginStoreDeleteRequest.ProtoReflect.Descriptor instead.
+func (*SitePluginStoreDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *SitePluginStoreDeleteRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type SitePluginStoreListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keys []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (x *SitePluginStoreListResponse) Reset() {
+	*x = SitePluginStoreListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_site_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SitePluginStoreListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SitePluginStoreListResponse) ProtoMessage() {}
+
+func (x *SitePluginStoreListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_site_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Tag); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SitePluginStoreListResponse.ProtoReflect.Descriptor instead.
+func (*SitePluginStoreListResponse) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *SitePluginStoreListResponse) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type SiteEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type      string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Id        string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Plugin    string                 `protobuf:"bytes,3,opt,name=plugin,proto3" json:"plugin,omitempty"`
+	Name      string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *SiteEvent) Reset() {
+	*x = SiteEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_site_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SiteEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SiteEvent) ProtoMessage() {}
+
+func (x *SiteEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_site_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	type x struct{}
-	out := protoimpl.TypeBuilder{
-		File: protoimpl.DescBuilder{
-			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_site_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   72,
-			NumExtensions: 0,
-			NumServices:   1,
-		},
-		GoTypes:           file_site_proto_goTypes,
-		DependencyIndexes: file_site_proto_depIdxs,
-		MessageInfos:      file_site_proto_msgTypes,
-	}.Build()
-	File_site_proto = out.File
-	file_site_proto_rawDesc = nil
-	file_site_proto_goTypes = nil
-	file_site_proto_depIdxs = nil
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SiteEvent.ProtoReflect.Descriptor instead.
+func (*SiteEvent) Descriptor() ([]byte, []int) {
+	return file_site_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *SiteEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SiteEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SiteEvent) GetPlugin() string {
+	if x != nil {
+		return x.Plugin
+	}
+	return ""
+}
+
+func (x *SiteEvent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SiteEvent) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+var File_site_proto protoreflect.FileDescriptor
+
+var file_site_proto_rawDesc = []byte{
+	0x0a, 0x0a, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10, 0x61, 0x6d,
+	0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x1a, 0x0b,
+	0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x42, 0x0a, 0x20, 0x53, 0x69, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x64, 0x53,
+	0x69, 0x6e, 0x67, 0x6c, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x50, 0x61, 0x67, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2d, 0x0a, 0x15, 0x53, 0x69, 0x74, 0x65, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x22, 0x38, 0x0a, 0x16, 0x53, 0x69, 0x74, 0x65, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64,
+	0x22, 0x44, 0x0a, 0x22, 0x53, 0x69, 0x74, 0x65, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72,
+	0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x5d, 0x0a, 0x23, 0x53, 0x69, 0x74, 0x65, 0x4e, 0x65,
+	0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x47, 0x72, 0x61, 0x6e,
+	0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a,
+	0x06, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66,
+	0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x06, 0x67,
+	0x72, 0x61, 0x6e, 0x74, 0x73, 0x22, 0x41, 0x0a, 0x1f, 0x53, 0x69, 0x74, 0x65, 0x4e, 0x65, 0x69,
+	0x67, 0x68, 0x62, 0x6f, 0x72, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x47, 0x72, 0x61, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x53, 0x0a, 0x20, 0x53, 0x69, 0x74, 0x65,
+	0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x47, 0x72,
+	0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x06,
+	0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x06, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x22, 0x58, 0x0a,
+	0x20, 0x53, 0x69, 0x74, 0x65, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x50, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x22, 0x3d, 0x0a, 0x21, 0x53, 0x69, 0x74, 0x65, 0x4e,
+	0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x47, 0x72, 0x61,
+	0x6e, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x67,
+	0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x22, 0x5f, 0x0a, 0x27, 0x53, 0x69, 0x74, 0x65, 0x4e, 0x65,
+	0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x65, 0x64, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x22, 0x44, 0x0a, 0x28, 0x53, 0x69, 0x74, 0x65, 0x4e,
+	0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x22, 0x73, 0x0a,
+	0x21, 0x53, 0x69, 0x74, 0x65, 0x53, 0x65, 0x74, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72,
+	0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x6e,
+	0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74,
+	0x65, 0x64, 0x22, 0x4e, 0x0a, 0x13, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0a, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0a, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x64, 0x61,
+	0x74, 0x61, 0x22, 0x2f, 0x0a, 0x17, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x22, 0x2d, 0x0a, 0x17, 0x53, 0x69, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x41, 0x0a, 0x17, 0x53, 0x69, 0x74, 0x65, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x04, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x46, 0x0a, 0x18, 0x53, 0x69, 0x74, 0x65, 0x44, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x52, 0x0a,
+	0x13, 0x53, 0x69, 0x74, 0x65, 0x53, 0x61, 0x76, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x2b, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x73,
+	0x74, 0x22, 0x40, 0x0a, 0x18, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x41, 0x6e,
+	0x64, 0x50, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a,
+	0x0d, 0x6f, 0x6e, 0x6c, 0x79, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x6f, 0x6e, 0x6c, 0x79, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x65, 0x64, 0x22, 0x5c, 0x0a, 0x19, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x73,
+	0x41, 0x6e, 0x64, 0x50, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3f, 0x0a, 0x0e, 0x70, 0x6f, 0x73, 0x74, 0x77, 0x69, 0x74, 0x68, 0x69, 0x64, 0x6c, 0x69,
+	0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x52, 0x0e, 0x70, 0x6f, 0x73, 0x74, 0x77, 0x69, 0x74, 0x68, 0x69, 0x64, 0x6c, 0x69, 0x73,
+	0x74, 0x22, 0x4b, 0x0a, 0x1a, 0x53, 0x69, 0x74, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
+	0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2d, 0x0a, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x4b,
+	0x0a, 0x1a, 0x53, 0x69, 0x74, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x50,
+	0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x05,
+	0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x2b, 0x0a, 0x15, 0x53,
+	0x69, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x53, 0x6c, 0x75, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x22, 0x45, 0x0a, 0x16, 0x53, 0x69, 0x74, 0x65,
+	0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x53, 0x6c, 0x75, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x22,
+	0x5c, 0x0a, 0x16, 0x53, 0x69, 0x74, 0x65, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x6f, 0x73,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x4c, 0x0a,
+	0x17, 0x53, 0x69, 0x74, 0x65, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x46, 0x0a, 0x15, 0x53,
+	0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x22, 0x49, 0x0a, 0x16, 0x53, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x57,
+	0x0a, 0x18, 0x53, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0c, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x74, 0x79, 0x70, 0x65, 0x73, 0x22, 0x25, 0x0a, 0x13, 0x53, 0x69, 0x74, 0x65, 0x50,
+	0x6f, 0x73, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x43,
+	0x0a, 0x14, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x04, 0x70,
+	0x6f, 0x73, 0x74, 0x22, 0x2b, 0x0a, 0x19, 0x53, 0x69, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x72, 0x0a, 0x15, 0x53, 0x69, 0x74, 0x65, 0x53, 0x61, 0x76, 0x65, 0x50, 0x6f, 0x73, 0x74,
+	0x41, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2b, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x04,
+	0x70, 0x6f, 0x73, 0x74, 0x22, 0x2a, 0x0a, 0x18, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x52, 0x0a, 0x19, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a,
+	0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x49, 0x0a, 0x17, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x64, 0x22,
+	0x4f, 0x0a, 0x18, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x72,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0x32, 0x0a, 0x1c, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x64, 0x69, 0x66, 0x66, 0x22, 0x6e, 0x0a, 0x1e, 0x53, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x69, 0x64, 0x22, 0x66, 0x0a, 0x1c, 0x53, 0x69, 0x74, 0x65, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x22, 0x52, 0x0a, 0x1d,
+	0x53, 0x69, 0x74, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x50, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a,
+	0x07, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x22, 0x29, 0x0a, 0x17, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x50, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4f, 0x0a, 0x18, 0x53,
+	0x69, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x52, 0x08, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x22, 0x4c, 0x0a, 0x1c,
+	0x53, 0x69, 0x74, 0x65, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x50, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x69, 0x64, 0x22, 0x50, 0x0a, 0x1c, 0x53, 0x69,
+	0x74, 0x65, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x53,
+	0x6c, 0x75, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x22, 0x29, 0x0a, 0x17,
+	0x53, 0x69, 0x74, 0x65, 0x50, 0x61, 0x67, 0x65, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x28, 0x0a, 0x16, 0x53, 0x69, 0x74, 0x65, 0x42,
+	0x72, 0x65, 0x61, 0x64, 0x63, 0x72, 0x75, 0x6d, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x42, 0x0a, 0x11, 0x53, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x6d, 0x65, 0x6e, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05,
+	0x6d, 0x65, 0x6e, 0x75, 0x73, 0x22, 0x25, 0x0a, 0x0f, 0x53, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6e,
+	0x75, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3f, 0x0a, 0x10,
+	0x53, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2b, 0x0a, 0x04, 0x6d, 0x65, 0x6e, 0x75, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x04, 0x6d, 0x65, 0x6e, 0x75, 0x22, 0x42, 0x0a,
+	0x13, 0x53, 0x69, 0x74, 0x65, 0x53, 0x61, 0x76, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x04, 0x6d, 0x65, 0x6e, 0x75, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x04, 0x6d, 0x65, 0x6e,
+	0x75, 0x22, 0x2b, 0x0a, 0x15, 0x53, 0x69, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d,
+	0x65, 0x6e, 0x75, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x45,
+	0x0a, 0x23, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4e, 0x65, 0x69, 0x67,
+	0x68, 0x62, 0x6f, 0x72, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x57, 0x0a, 0x24, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a,
+	0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x22, 0x50,
+	0x0a, 0x16, 0x53, 0x69, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74,
+	0x22, 0x50, 0x0a, 0x14, 0x53, 0x69, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x3c, 0x0a, 0x1c, 0x53, 0x69, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x64,
+	0x22, 0x3b, 0x0a, 0x1d, 0x53, 0x69, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x35, 0x0a,
+	0x15, 0x53, 0x69, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x64, 0x22, 0x39, 0x0a, 0x19, 0x53, 0x69, 0x74, 0x65, 0x4c, 0x6f, 0x67, 0x6f,
+	0x75, 0x74, 0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x64, 0x22,
+	0x53, 0x0a, 0x17, 0x53, 0x69, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x4f, 0x0a, 0x18, 0x53, 0x69, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x33, 0x0a, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x08, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x51, 0x0a, 0x15, 0x53, 0x69, 0x74, 0x65, 0x4c, 0x6f, 0x67,
+	0x6f, 0x75, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x32, 0x0a, 0x12, 0x53, 0x69, 0x74, 0x65,
+	0x53, 0x65, 0x74, 0x43, 0x53, 0x52, 0x46, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x64, 0x22, 0x2b, 0x0a, 0x13,
+	0x53, 0x69, 0x74, 0x65, 0x53, 0x65, 0x74, 0x43, 0x53, 0x52, 0x46, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x45, 0x0a, 0x0f, 0x53, 0x69, 0x74,
+	0x65, 0x43, 0x53, 0x52, 0x46, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x28, 0x0a, 0x10, 0x53, 0x69, 0x74, 0x65, 0x43, 0x53, 0x52, 0x46, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x22, 0x4b, 0x0a, 0x17, 0x53, 0x69,
+	0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x30, 0x0a, 0x18, 0x53, 0x69, 0x74, 0x65, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x64, 0x0a, 0x1a, 0x53, 0x69, 0x74,
+	0x65, 0x53, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
+	0x51, 0x0a, 0x1d, 0x53, 0x69, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x47, 0x0a, 0x25, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x5d, 0x0a, 0x26, 0x53,
+	0x69, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f,
+	0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x55, 0x0a, 0x1b, 0x53, 0x69,
+	0x74, 0x65, 0x53, 0x65, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x22, 0x3c, 0x0a, 0x1c, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0x35, 0x0a, 0x1d, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3e, 0x0a, 0x1e, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x37, 0x0a, 0x1f, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
+	0x38, 0x0a, 0x18, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x47, 0x0a, 0x19, 0x53, 0x69, 0x74,
+	0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0x8b, 0x01, 0x0a, 0x23, 0x53, 0x69, 0x74, 0x65, 0x53, 0x65, 0x74, 0x4e, 0x65,
+	0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c,
+	0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0x66, 0x0a, 0x26, 0x53, 0x69, 0x74, 0x65, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72,
+	0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3f, 0x0a, 0x27, 0x53, 0x69, 0x74, 0x65,
+	0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x60, 0x0a, 0x20, 0x53, 0x69, 0x74,
+	0x65, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x4f, 0x0a, 0x21, 0x53,
+	0x69, 0x74, 0x65, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x50, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3a, 0x0a, 0x18,
+	0x53, 0x69, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x35, 0x0a, 0x19, 0x53, 0x69, 0x74, 0x65,
+	0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x22,
+	0x2b, 0x0a, 0x13, 0x53, 0x69, 0x74, 0x65, 0x53, 0x65, 0x74, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0x29, 0x0a, 0x11,
+	0x53, 0x69, 0x74, 0x65, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0x2e, 0x0a, 0x14, 0x53, 0x69, 0x74, 0x65, 0x53,
+	0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x12, 0x53, 0x69, 0x74, 0x65, 0x53,
+	0x63, 0x68, 0x65, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x65, 0x22, 0x25, 0x0a, 0x11, 0x53, 0x69, 0x74, 0x65, 0x53, 0x65, 0x74,
+	0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x23, 0x0a, 0x0f,
+	0x53, 0x69, 0x74, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72,
+	0x6c, 0x22, 0x2f, 0x0a, 0x13, 0x53, 0x69, 0x74, 0x65, 0x46, 0x75, 0x6c, 0x6c, 0x55, 0x52, 0x4c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x75, 0x6c, 0x6c,
+	0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x75, 0x6c, 0x6c, 0x75,
+	0x72, 0x6c, 0x22, 0x4f, 0x0a, 0x13, 0x53, 0x69, 0x74, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x22, 0x31, 0x0a, 0x15, 0x53, 0x69, 0x74, 0x65, 0x53, 0x65, 0x74, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x2f, 0x0a, 0x13, 0x53, 0x69, 0x74, 0x65, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x37, 0x0a, 0x0f, 0x53, 0x69, 0x74, 0x65, 0x54,
+	0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6f, 0x6e,
+	0x6c, 0x79, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0d, 0x6f, 0x6e, 0x6c, 0x79, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64,
+	0x22, 0x3d, 0x0a, 0x10, 0x53, 0x69, 0x74, 0x65, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x54, 0x61, 0x67, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22,
+	0x53, 0x0a, 0x03, 0x54, 0x61, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x22, 0x45, 0x0a, 0x16, 0x53, 0x69, 0x74, 0x65, 0x54, 0x61, 0x67, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b,
+	0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x50, 0x0a, 0x14, 0x53,
+	0x69, 0x74, 0x65, 0x54, 0x61, 0x67, 0x42, 0x79, 0x53, 0x6c, 0x75, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x12, 0x24, 0x0a, 0x0d, 0x6f, 0x6e, 0x6c, 0x79, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d,
+	0x6f, 0x6e, 0x6c, 0x79, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x22, 0x42, 0x0a,
+	0x15, 0x53, 0x69, 0x74, 0x65, 0x54, 0x61, 0x67, 0x42, 0x79, 0x53, 0x6c, 0x75, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x03, 0x74, 0x61,
+	0x67, 0x22, 0x44, 0x0a, 0x14, 0x53, 0x69, 0x74, 0x65, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x54,
+	0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x6e, 0x65, 0x77, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6e, 0x65, 0x77, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3e, 0x0a, 0x14, 0x53, 0x69, 0x74, 0x65, 0x4d,
+	0x65, 0x72, 0x67, 0x65, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6e, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x69, 0x6e, 0x74, 0x6f, 0x22, 0x2a, 0x0a, 0x14, 0x53, 0x69, 0x74, 0x65, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0x58, 0x0a, 0x15, 0x53, 0x69, 0x74, 0x65, 0x53, 0x65, 0x74, 0x54, 0x61,
+	0x67, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x2b, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x22, 0x4e, 0x0a,
+	0x15, 0x53, 0x69, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x09, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x52, 0x09, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x22, 0x51, 0x0a,
+	0x1a, 0x53, 0x69, 0x74, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x73,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x22, 0x29, 0x0a, 0x17, 0x53, 0x69, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4d, 0x0a, 0x18, 0x53,
+	0x69, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x44, 0x69, 0x66, 0x66, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0x2c, 0x0a, 0x1a, 0x53, 0x69,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2d, 0x0a, 0x19, 0x53, 0x69, 0x74, 0x65,
+	0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x32, 0x0a, 0x1a, 0x53, 0x69, 0x74, 0x65, 0x50,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x43, 0x0a, 0x19, 0x53,
+	0x69, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x75,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0x30, 0x0a, 0x1c, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x74,
+	0x6f, 0x72, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x22, 0x31, 0x0a, 0x1b, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x53, 0x74, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x95, 0x01, 0x0a, 0x09, 0x53, 0x69, 0x74, 0x65, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x32, 0xe1, 0x40,
+	0x0a, 0x04, 0x53, 0x69, 0x74, 0x65, 0x12, 0x3a, 0x0a, 0x04, 0x4c, 0x6f, 0x61, 0x64, 0x12, 0x17,
+	0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x00, 0x12, 0x66, 0x0a, 0x15, 0x4c, 0x6f, 0x61, 0x64, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65,
+	0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x32, 0x2e, 0x61, 0x6d,
+	0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53,
+	0x69, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x64, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x50, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x50, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64,
+	0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x0a, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x12, 0x27, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65,
+	0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x28, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x7a, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x88, 0x01,
+	0x0a, 0x17, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x47, 0x72, 0x61, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x34, 0x2e, 0x61, 0x6d, 0x62, 0x69,
+	0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74,
+	0x65, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x47,
+	0x72, 0x61, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x35, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64,
+	0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x50,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7f, 0x0a, 0x14, 0x4e, 0x65, 0x69, 0x67,
+	0x68, 0x62, 0x6f, 0x72, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73,
+	0x12, 0x31, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72,
+	0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x4e, 0x65, 0x69, 0x67, 0x68,
+	0x62, 0x6f, 0x72, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x82, 0x01, 0x0a, 0x15, 0x4e, 0x65,
+	0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x47, 0x72, 0x61, 0x6e,
+	0x74, 0x65, 0x64, 0x12, 0x32, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x4e, 0x65, 0x69, 0x67, 0x68,
+	0x62, 0x6f, 0x72, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x4e,
+	0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x47, 0x72, 0x61,
+	0x6e, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x97,
+	0x01, 0x0a, 0x1c, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x50, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x12,
+	0x39, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64,
+	0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x50,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x47, 0x72,
+	0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x61, 0x6d, 0x62,
+	0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69,
+	0x74, 0x65, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x4e,
+	0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x47, 0x72, 0x61,
+	0x6e, 0x74, 0x12, 0x33, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x53, 0x65, 0x74, 0x4e, 0x65, 0x69,
+	0x67, 0x68, 0x62, 0x6f, 0x72, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x47, 0x72, 0x61, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x00, 0x12, 0x4b, 0x0a, 0x07, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x12, 0x17, 0x2e,
+	0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x25, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x53, 0x0a, 0x0b, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x17,
+	0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x29, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x50,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x12, 0x29, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64,
+	0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0c, 0x45, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x29, 0x2e, 0x61, 0x6d, 0x62,
+	0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69,
+	0x74, 0x65, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
+	0x12, 0x56, 0x0a, 0x0d, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x12, 0x2a, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x08, 0x53, 0x61, 0x76, 0x65,
+	0x50, 0x6f, 0x73, 0x74, 0x12, 0x25, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x53, 0x61, 0x76, 0x65,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x6d,
+	0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x0d, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x41,
+	0x6e, 0x64, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x2a, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x50,
+	0x6f, 0x73, 0x74, 0x73, 0x41, 0x6e, 0x64, 0x50, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x73,
+	0x41, 0x6e, 0x64, 0x50, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x59, 0x0a, 0x0e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x50,
+	0x6f, 0x73, 0x74, 0x73, 0x12, 0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x2c, 0x2e,
+	0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66,
+	0x2e, 0x53, 0x69, 0x74, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x50, 0x6f,
+	0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a,
+	0x0e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12,
+	0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64,
+	0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x2c, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65,
+	0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x50, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x0a, 0x50, 0x6f, 0x73, 0x74,
+	0x42, 0x79, 0x53, 0x6c, 0x75, 0x67, 0x12, 0x27, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6f,
+	0x73, 0x74, 0x42, 0x79, 0x53, 0x6c, 0x75, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x28, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64,
+	0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x53, 0x6c, 0x75,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x0b, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x28, 0x2e, 0x61, 0x6d, 0x62,
+	0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69,
+	0x74, 0x65, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x61, 0x0a, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12,
+	0x27, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64,
+	0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x73, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65,
+	0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x12, 0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x2a, 0x2e,
+	0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66,
+	0x2e, 0x53, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x08, 0x50,
+	0x6f, 0x73, 0x74, 0x42, 0x79, 0x49, 0x44, 0x12, 0x25, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x50,
+	0x6f, 0x73, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
+	0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65,
+	0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x49, 0x44, 0x12, 0x2b, 0x2e, 0x61, 0x6d, 0x62,
+	0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69,
+	0x74, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x49, 0x44,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x00, 0x12, 0x50, 0x0a, 0x0a, 0x53, 0x61, 0x76, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x41, 0x73,
+	0x12, 0x27, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x53, 0x61, 0x76, 0x65, 0x50, 0x6f, 0x73, 0x74,
+	0x41, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69,
+	0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x0d, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2a, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2b, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x67, 0x0a, 0x0c, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x29, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x61, 0x6d,
+	0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53,
+	0x69, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6f, 0x0a, 0x10, 0x50, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x66, 0x66, 0x12, 0x29, 0x2e,
+	0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66,
+	0x2e, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65,
+	0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x66, 0x66,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x13, 0x52, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x30, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x76,
+	0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x50, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x12, 0x2e, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x50, 0x6f, 0x73, 0x74, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x50, 0x6f, 0x73, 0x74, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x67, 0x0a, 0x0c, 0x50, 0x6f, 0x73, 0x74, 0x50, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x29, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6f,
+	0x73, 0x74, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2a, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x50, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x5e, 0x0a, 0x11, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x50, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x12, 0x2e, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x52, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
+	0x6f, 0x0a, 0x11, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79,
+	0x53, 0x6c, 0x75, 0x67, 0x12, 0x2e, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x50, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x53, 0x6c, 0x75, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74,
+	0x42, 0x79, 0x53, 0x6c, 0x75, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x68, 0x0a, 0x0c, 0x50, 0x61, 0x67, 0x65, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e,
+	0x12, 0x29, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x50, 0x61, 0x67, 0x65, 0x43, 0x68, 0x69, 0x6c,
+	0x64, 0x72, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x61, 0x6d,
+	0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53,
+	0x69, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x41, 0x6e, 0x64, 0x50, 0x61, 0x67, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x0b, 0x42, 0x72,
+	0x65, 0x61, 0x64, 0x63, 0x72, 0x75, 0x6d, 0x62, 0x73, 0x12, 0x28, 0x2e, 0x61, 0x6d, 0x62, 0x69,
+	0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74,
+	0x65, 0x42, 0x72, 0x65, 0x61, 0x64, 0x63, 0x72, 0x75, 0x6d, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x73,
+	0x41, 0x6e, 0x64, 0x50, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x47, 0x0a, 0x05, 0x4d, 0x65, 0x6e, 0x75, 0x73, 0x12, 0x17, 0x2e, 0x61, 0x6d,
+	0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x23, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x04, 0x4d,
+	0x65, 0x6e, 0x75, 0x12, 0x21, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x4d, 0x65,
+	0x6e, 0x75, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x08,
+	0x53, 0x61, 0x76, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x12, 0x25, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65,
+	0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65,
+	0x53, 0x61, 0x76, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64,
+	0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0a, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x12, 0x27, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65,
+	0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x8b, 0x01, 0x0a,
+	0x18, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x35, 0x2e, 0x61, 0x6d, 0x62, 0x69,
+	0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74,
+	0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x36, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4e, 0x65,
+	0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0b, 0x55, 0x73,
+	0x65, 0x72, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x12, 0x28, 0x2e, 0x61, 0x6d, 0x62, 0x69,
+	0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4e,
+	0x0a, 0x09, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x26, 0x2e, 0x61, 0x6d,
+	0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53,
+	0x69, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x76,
+	0x0a, 0x11, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x55,
+	0x73, 0x65, 0x72, 0x12, 0x2e, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f,
+	0x67, 0x6f, 0x75, 0x74, 0x12, 0x27, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0e, 0x4c, 0x6f, 0x67, 0x6f,
+	0x75, 0x74, 0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x2b, 0x2e, 0x61, 0x6d, 0x62,
+	0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69,
+	0x74, 0x65, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x00, 0x12, 0x67, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x29, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e,
+	0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66,
+	0x2e, 0x53, 0x69, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0a, 0x4c,
+	0x6f, 0x67, 0x6f, 0x75, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x27, 0x2e, 0x61, 0x6d, 0x62, 0x69,
+	0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74,
+	0x65, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x58, 0x0a,
+	0x07, 0x53, 0x65, 0x74, 0x43, 0x53, 0x52, 0x46, 0x12, 0x24, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65,
+	0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65,
+	0x53, 0x65, 0x74, 0x43, 0x53, 0x52, 0x46, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
+	0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65,
+	0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x53, 0x65, 0x74, 0x43, 0x53, 0x52, 0x46, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x04, 0x43, 0x53, 0x52, 0x46, 0x12,
+	0x21, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64,
+	0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x43, 0x53, 0x52, 0x46, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x43, 0x53, 0x52, 0x46, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x67, 0x0a, 0x0c, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x29, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65,
+	0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x5a, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x2c, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x53, 0x65, 0x74, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x60, 0x0a,
+	0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x2f, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
+	0x91, 0x01, 0x0a, 0x1a, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62,
+	0x6f, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x37,
+	0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65,
+	0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4e, 0x65, 0x69, 0x67,
+	0x68, 0x62, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x50,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x2d, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x53,
+	0x65, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x00, 0x12, 0x76, 0x0a, 0x11, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x2e, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x6f, 0x6f, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x6f, 0x6f, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7c, 0x0a, 0x13, 0x50, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x12, 0x30, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x31, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x0d, 0x50, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x2a, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65,
+	0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65,
+	0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x6c, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62,
+	0x6f, 0x72, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12,
+	0x35, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64,
+	0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x53, 0x65, 0x74, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62,
+	0x6f, 0x72, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x00, 0x12, 0x94, 0x01, 0x0a, 0x1b, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x50, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x12, 0x38, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f,
+	0x72, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x61, 0x6d,
+	0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53,
+	0x69, 0x74, 0x65, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x50, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x82, 0x01, 0x0a, 0x15, 0x4e, 0x65, 0x69,
+	0x67, 0x68, 0x62, 0x6f, 0x72, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x12, 0x32, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62,
+	0x6f, 0x72, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x4e, 0x65,
+	0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a,
+	0x0d, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x12, 0x2a,
+	0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65,
+	0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x54, 0x72, 0x75, 0x73,
+	0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x61, 0x6d, 0x62,
+	0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69,
+	0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x08, 0x53, 0x65, 0x74,
+	0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x25, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x53, 0x65, 0x74,
+	0x54, 0x69, 0x74, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61,
+	0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65,
+	0x12, 0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x64, 0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x23, 0x2e, 0x61, 0x6d, 0x62, 0x69,
+	0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74,
+	0x65, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x4e, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x26, 0x2e,
+	0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66,
+	0x2e, 0x53, 0x69, 0x74, 0x65, 0x53, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
+	0x12, 0x49, 0x0a, 0x06, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x17, 0x2e, 0x61, 0x6d, 0x62,
+	0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x24, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x06, 0x53,
+	0x65, 0x74, 0x55, 0x52, 0x4c, 0x12, 0x23, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x53, 0x65, 0x74,
+	0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x6d, 0x62,
+	0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x12, 0x17, 0x2e, 0x61,
+	0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x21, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x55, 0x52, 0x4c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x07, 0x46, 0x75,
+	0x6c, 0x6c, 0x55, 0x52, 0x4c, 0x12, 0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x25,
+	0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65,
+	0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x46, 0x75, 0x6c, 0x6c, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x07, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x12, 0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x25, 0x2e, 0x61, 0x6d,
+	0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53,
+	0x69, 0x74, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0a, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x12, 0x27, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x6d,
+	0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x12, 0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x25, 0x2e, 0x61, 0x6d, 0x62,
+	0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69,
+	0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x04, 0x54, 0x61, 0x67, 0x73, 0x12, 0x21, 0x2e, 0x61, 0x6d,
+	0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53,
+	0x69, 0x74, 0x65, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
+	0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65,
+	0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x0a, 0x54, 0x61, 0x67, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x12, 0x21, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x54, 0x61, 0x67,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x5e, 0x0a, 0x09, 0x54, 0x61, 0x67, 0x42, 0x79, 0x53, 0x6c, 0x75, 0x67, 0x12, 0x26,
+	0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65,
+	0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x54, 0x61, 0x67, 0x42, 0x79, 0x53, 0x6c, 0x75, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x54, 0x61,
+	0x67, 0x42, 0x79, 0x53, 0x6c, 0x75, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x4e, 0x0a, 0x09, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x54, 0x61, 0x67, 0x12, 0x26,
+	0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65,
+	0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x54, 0x61, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x00, 0x12, 0x4e, 0x0a, 0x09, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x54, 0x61, 0x67, 0x73, 0x12, 0x26,
+	0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65,
+	0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x54, 0x61, 0x67, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x00, 0x12, 0x4e, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x67, 0x12, 0x26,
+	0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65,
+	0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x00, 0x12, 0x50, 0x0a, 0x0a, 0x53, 0x65, 0x74, 0x54, 0x61, 0x67, 0x4d, 0x65, 0x74, 0x61, 0x12,
+	0x27, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64,
+	0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x53, 0x65, 0x74, 0x54, 0x61, 0x67, 0x4d, 0x65, 0x74,
+	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65,
+	0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x09, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73,
+	0x12, 0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x64, 0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x27, 0x2e, 0x61, 0x6d, 0x62, 0x69,
+	0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74,
+	0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x2c, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64,
+	0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x67, 0x0a, 0x0c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x44, 0x69, 0x66, 0x66, 0x12,
+	0x29, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64,
+	0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x44,
+	0x69, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x61, 0x6d, 0x62,
+	0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69,
+	0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x2c, 0x2e, 0x61, 0x6d,
+	0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53,
+	0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69,
+	0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x00, 0x12, 0x6d, 0x0a, 0x0e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x74,
+	0x6f, 0x72, 0x65, 0x47, 0x65, 0x74, 0x12, 0x2b, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x74, 0x6f,
+	0x72, 0x65, 0x50, 0x75, 0x74, 0x12, 0x2b, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x5e, 0x0a,
+	0x11, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x12, 0x2e, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x53, 0x74, 0x6f, 0x72, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x5b, 0x0a,
+	0x0f, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x64, 0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x2d, 0x2e, 0x61, 0x6d, 0x62, 0x69,
+	0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74,
+	0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x09, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x17, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x1b, 0x2e, 0x61, 0x6d, 0x62, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x64, 0x65, 0x66, 0x2e, 0x53, 0x69, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x30,
+	0x01, 0x42, 0x0d, 0x5a, 0x0b, 0x2e, 0x2f, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x64, 0x65, 0x66,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_site_proto_rawDescOnce sync.Once
+	file_site_proto_rawDescData = file_site_proto_rawDesc
+)
+
+func file_site_proto_rawDescGZIP() []byte {
+	file_site_proto_rawDescOnce.Do(func() {
+		file_site_proto_rawDescData = protoimpl.X.CompressGZIP(file_site_proto_rawDescData)
+	})
+	return file_site_proto_rawDescData
+}
+
+var file_site_proto_msgTypes = make([]protoimpl.MessageInfo, 118)
+var file_site_proto_goTypes = []interface{}{
+	(*SiteLoadSinglePluginPagesRequest)(nil),         // 0: ambient.protodef.SiteLoadSinglePluginPagesRequest
+	(*SiteAuthorizedRequest)(nil),                    // 1: ambient.protodef.SiteAuthorizedRequest
+	(*SiteAuthorizedResponse)(nil),                   // 2: ambient.protodef.SiteAuthorizedResponse
+	(*SiteNeighborPluginGrantListRequest)(nil),       // 3: ambient.protodef.SiteNeighborPluginGrantListRequest
+	(*SiteNeighborPluginGrantListResponse)(nil),      // 4: ambient.protodef.SiteNeighborPluginGrantListResponse
+	(*SiteNeighborPluginGrantsRequest)(nil),          // 5: ambient.protodef.SiteNeighborPluginGrantsRequest
+	(*SiteNeighborPluginGrantsResponse)(nil),         // 6: ambient.protodef.SiteNeighborPluginGrantsResponse
+	(*SiteNeighborPluginGrantedRequest)(nil),         // 7: ambient.protodef.SiteNeighborPluginGrantedRequest
+	(*SiteNeighborPluginGrantedResponse)(nil),        // 8: ambient.protodef.SiteNeighborPluginGrantedResponse
+	(*SiteNeighborPluginRequestedGrantRequest)(nil),  // 9: ambient.protodef.SiteNeighborPluginRequestedGrantRequest
+	(*SiteNeighborPluginRequestedGrantResponse)(nil), // 10: ambient.protodef.SiteNeighborPluginRequestedGrantResponse
+	(*SiteSetNeighborPluginGrantRequest)(nil),        // 11: ambient.protodef.SiteSetNeighborPluginGrantRequest
+	(*SitePluginsResponse)(nil),                      // 12: ambient.protodef.SitePluginsResponse
+	(*SitePluginNamesResponse)(nil),                  // 13: ambient.protodef.SitePluginNamesResponse
+	(*SiteDeletePluginRequest)(nil),                  // 14: ambient.protodef.SiteDeletePluginRequest
+	(*SiteEnablePluginRequest)(nil),                  // 15: ambient.protodef.SiteEnablePluginRequest
+	(*SiteDisablePluginRequest)(nil),                 // 16: ambient.protodef.SiteDisablePluginRequest
+	(*SiteSavePostRequest)(nil),                      // 17: ambient.protodef.SiteSavePostRequest
+	(*SitePostsAndPagesRequest)(nil),                 // 18: ambient.protodef.SitePostsAndPagesRequest
+	(*SitePostsAndPagesResponse)(nil),                // 19: ambient.protodef.SitePostsAndPagesResponse
+	(*SitePublishedPostsResponse)(nil),               // 20: ambient.protodef.SitePublishedPostsResponse
+	(*SitePublishedPagesResponse)(nil),               // 21: ambient.protodef.SitePublishedPagesResponse
+	(*SitePostBySlugRequest)(nil),                    // 22: ambient.protodef.SitePostBySlugRequest
+	(*SitePostBySlugResponse)(nil),                   // 23: ambient.protodef.SitePostBySlugResponse
+	(*SiteSearchPostsRequest)(nil),                   // 24: ambient.protodef.SiteSearchPostsRequest
+	(*SiteSearchPostsResponse)(nil),                  // 25: ambient.protodef.SiteSearchPostsResponse
+	(*SiteQueryPostsRequest)(nil),                    // 26: ambient.protodef.SiteQueryPostsRequest
+	(*SiteQueryPostsResponse)(nil),                   // 27: ambient.protodef.SiteQueryPostsResponse
+	(*SiteContentTypesResponse)(nil),                 // 28: ambient.protodef.SiteContentTypesResponse
+	(*SitePostByIDRequest)(nil),                      // 29: ambient.protodef.SitePostByIDRequest
+	(*SitePostByIDResponse)(nil),                     // 30: ambient.protodef.SitePostByIDResponse
+	(*SiteDeletePostByIDRequest)(nil),                // 31: ambient.protodef.SiteDeletePostByIDRequest
+	(*SiteSavePostAsRequest)(nil),                    // 32: ambient.protodef.SiteSavePostAsRequest
+	(*SitePostRevisionsRequest)(nil),                 // 33: ambient.protodef.SitePostRevisionsRequest
+	(*SitePostRevisionsResponse)(nil),                // 34: ambient.protodef.SitePostRevisionsResponse
+	(*SitePostRevisionRequest)(nil),                  // 35: ambient.protodef.SitePostRevisionRequest
+	(*SitePostRevisionResponse)(nil),                 // 36: ambient.protodef.SitePostRevisionResponse
+	(*SitePostRevisionDiffResponse)(nil),             // 37: ambient.protodef.SitePostRevisionDiffResponse
+	(*SiteRestorePostRevisionRequest)(nil),           // 38: ambient.protodef.SiteRestorePostRevisionRequest
+	(*SiteCreatePostPreviewRequest)(nil),             // 39: ambient.protodef.SiteCreatePostPreviewRequest
+	(*SiteCreatePostPreviewResponse)(nil),            // 40: ambient.protodef.SiteCreatePostPreviewResponse
+	(*SitePostPreviewsRequest)(nil),                  // 41: ambient.protodef.SitePostPreviewsRequest
+	(*SitePostPreviewsResponse)(nil),                 // 42: ambient.protodef.SitePostPreviewsResponse
+	(*SiteRevokePostPreviewRequest)(nil),             // 43: ambient.protodef.SiteRevokePostPreviewRequest
+	(*SitePreviewPostBySlugRequest)(nil),             // 44: ambient.protodef.SitePreviewPostBySlugRequest
+	(*SitePageChildrenRequest)(nil),                  // 45: ambient.protodef.SitePageChildrenRequest
+	(*SiteBreadcrumbsRequest)(nil),                   // 46: ambient.protodef.SiteBreadcrumbsRequest
+	(*SiteMenusResponse)(nil),                        // 47: ambient.protodef.SiteMenusResponse
+	(*SiteMenuRequest)(nil),                          // 48: ambient.protodef.SiteMenuRequest
+	(*SiteMenuResponse)(nil),                         // 49: ambient.protodef.SiteMenuResponse
+	(*SiteSaveMenuRequest)(nil),                      // 50: ambient.protodef.SiteSaveMenuRequest
+	(*SiteDeleteMenuRequest)(nil),                    // 51: ambient.protodef.SiteDeleteMenuRequest
+	(*SitePluginNeighborRoutesListRequest)(nil),      // 52: ambient.protodef.SitePluginNeighborRoutesListRequest
+	(*SitePluginNeighborRoutesListResponse)(nil),     // 53: ambient.protodef.SitePluginNeighborRoutesListResponse
+	(*SiteUserPersistRequest)(nil),                   // 54: ambient.protodef.SiteUserPersistRequest
+	(*SiteUserLoginRequest)(nil),                     // 55: ambient.protodef.SiteUserLoginRequest
+	(*SiteAuthenticatedUserRequest)(nil),             // 56: ambient.protodef.SiteAuthenticatedUserRequest
+	(*SiteAuthenticatedUserResponse)(nil),            // 57: ambient.protodef.SiteAuthenticatedUserResponse
+	(*SiteUserLogoutRequest)(nil),                    // 58: ambient.protodef.SiteUserLogoutRequest
+	(*SiteLogoutAllUsersRequest)(nil),                // 59: ambient.protodef.SiteLogoutAllUsersRequest
+	(*SiteUserSessionsRequest)(nil),                  // 60: ambient.protodef.SiteUserSessionsRequest
+	(*SiteUserSessionsResponse)(nil),                 // 61: ambient.protodef.SiteUserSessionsResponse
+	(*SiteLogoutUserRequest)(nil),                    // 62: ambient.protodef.SiteLogoutUserRequest
+	(*SiteSetCSRFRequest)(nil),                       // 63: ambient.protodef.SiteSetCSRFRequest
+	(*SiteSetCSRFResponse)(nil),                      // 64: ambient.protodef.SiteSetCSRFResponse
+	(*SiteCSRFRequest)(nil),                          // 65: ambient.protodef.SiteCSRFRequest
+	(*SiteCSRFResponse)(nil),                         // 66: ambient.protodef.SiteCSRFResponse
+	(*SiteSessionValueRequest)(nil),                  // 67: ambient.protodef.SiteSessionValueRequest
+	(*SiteSessionValueResponse)(nil),                 // 68: ambient.protodef.SiteSessionValueResponse
+	(*SiteSetSessionValueRequest)(nil),               // 69: ambient.protodef.SiteSetSessionValueRequest
+	(*SiteDeleteSessionValueRequest)(nil),            // 70: ambient.protodef.SiteDeleteSessionValueRequest
+	(*SitePluginNeighborSettingsListRequest)(nil),    // 71: ambient.protodef.SitePluginNeighborSettingsListRequest
+	(*SitePluginNeighborSettingsListResponse)(nil),   // 72: ambient.protodef.SitePluginNeighborSettingsListResponse
+	(*SiteSetPluginSettingRequest)(nil),              // 73: ambient.protodef.SiteSetPluginSettingRequest
+	(*SitePluginSettingBoolRequest)(nil),             // 74: ambient.protodef.SitePluginSettingBoolRequest
+	(*SitePluginSettingBoolResponse)(nil),            // 75: ambient.protodef.SitePluginSettingBoolResponse
+	(*SitePluginSettingStringRequest)(nil),           // 76: ambient.protodef.SitePluginSettingStringRequest
+	(*SitePluginSettingStringResponse)(nil),          // 77: ambient.protodef.SitePluginSettingStringResponse
+	(*SitePluginSettingRequest)(nil),                 // 78: ambient.protodef.SitePluginSettingRequest
+	(*SitePluginSettingResponse)(nil),                // 79: ambient.protodef.SitePluginSettingResponse
+	(*SiteSetNeighborPluginSettingRequest)(nil),      // 80: ambient.protodef.SiteSetNeighborPluginSettingRequest
+	(*SiteNeighborPluginSettingStringRequest)(nil),   // 81: ambient.protodef.SiteNeighborPluginSettingStringRequest
+	(*SiteNeighborPluginSettingStringResponse)(nil),  // 82: ambient.protodef.SiteNeighborPluginSettingStringResponse
+	(*SiteNeighborPluginSettingRequest)(nil),         // 83: ambient.protodef.SiteNeighborPluginSettingRequest
+	(*SiteNeighborPluginSettingResponse)(nil),        // 84: ambient.protodef.SiteNeighborPluginSettingResponse
+	(*SitePluginTrustedRequest)(nil),                 // 85: ambient.protodef.SitePluginTrustedRequest
+	(*SitePluginTrustedResponse)(nil),                // 86: ambient.protodef.SitePluginTrustedResponse
+	(*SiteSetTitleRequest)(nil),                      // 87: ambient.protodef.SiteSetTitleRequest
+	(*SiteTitleResponse)(nil),                        // 88: ambient.protodef.SiteTitleResponse
+	(*SiteSetSchemeRequest)(nil),                     // 89: ambient.protodef.SiteSetSchemeRequest
+	(*SiteSchemeResponse)(nil),                       // 90: ambient.protodef.SiteSchemeResponse
+	(*SiteSetURLRequest)(nil),                        // 91: ambient.protodef.SiteSetURLRequest
+	(*SiteURLResponse)(nil),                          // 92: ambient.protodef.SiteURLResponse
+	(*SiteFullURLResponse)(nil),                      // 93: ambient.protodef.SiteFullURLResponse
+	(*SiteUpdatedResponse)(nil),                      // 94: ambient.protodef.SiteUpdatedResponse
+	(*SiteSetContentRequest)(nil),                    // 95: ambient.protodef.SiteSetContentRequest
+	(*SiteContentResponse)(nil),                      // 96: ambient.protodef.SiteContentResponse
+	(*SiteTagsRequest)(nil),                          // 97: ambient.protodef.SiteTagsRequest
+	(*SiteTagsResponse)(nil),                         // 98: ambient.protodef.SiteTagsResponse
+	(*Tag)(nil),                                      // 99: ambient.protodef.Tag
+	(*SiteTagDetailsResponse)(nil),                   // 100: ambient.protodef.SiteTagDetailsResponse
+	(*SiteTagBySlugRequest)(nil),                     // 101: ambient.protodef.SiteTagBySlugRequest
+	(*SiteTagBySlugResponse)(nil),                    // 102: ambient.protodef.SiteTagBySlugResponse
+	(*SiteRenameTagRequest)(nil),                     // 103: ambient.protodef.SiteRenameTagRequest
+	(*SiteMergeTagsRequest)(nil),                     // 104: ambient.protodef.SiteMergeTagsRequest
+	(*SiteDeleteTagRequest)(nil),                     // 105: ambient.protodef.SiteDeleteTagRequest
+	(*SiteSetTagMetaRequest)(nil),                    // 106: ambient.protodef.SiteSetTagMetaRequest
+	(*SiteSnapshotsResponse)(nil),                    // 107: ambient.protodef.SiteSnapshotsResponse
+	(*SiteCreateSnapshotResponse)(nil),               // 108: ambient.protodef.SiteCreateSnapshotResponse
+	(*SiteSnapshotDiffRequest)(nil),                  // 109: ambient.protodef.SiteSnapshotDiffRequest
+	(*SiteSnapshotDiffResponse)(nil),                 // 110: ambient.protodef.SiteSnapshotDiffResponse
+	(*SiteRestoreSnapshotRequest)(nil),               // 111: ambient.protodef.SiteRestoreSnapshotRequest
+	(*SitePluginStoreGetRequest)(nil),                // 112: ambient.protodef.SitePluginStoreGetRequest
+	(*SitePluginStoreGetResponse)(nil),               // 113: ambient.protodef.SitePluginStoreGetResponse
+	(*SitePluginStorePutRequest)(nil),                // 114: ambient.protodef.SitePluginStorePutRequest
+	(*SitePluginStoreDeleteRequest)(nil),             // 115: ambient.protodef.SitePluginStoreDeleteRequest
+	(*SitePluginStoreListResponse)(nil),              // 116: ambient.protodef.SitePluginStoreListResponse
+	(*SiteEvent)(nil),                                // 117: ambient.protodef.SiteEvent
+	(*GrantRequest)(nil),                             // 118: ambient.protodef.GrantRequest
+	(*structpb.Struct)(nil),                          // 119: google.protobuf.Struct
+	(*anypb.Any)(nil),                                // 120: google.protobuf.Any
+	(*timestamppb.Timestamp)(nil),                    // 121: google.protobuf.Timestamp
+	(*Empty)(nil),                                    // 122: ambient.protodef.Empty
+}
+var file_site_proto_depIdxs = []int32{
+	118, // 0: ambient.protodef.SiteNeighborPluginGrantListResponse.grants:type_name -> ambient.protodef.GrantRequest
+	119, // 1: ambient.protodef.SiteNeighborPluginGrantsResponse.grants:type_name -> google.protobuf.Struct
+	119, // 2: ambient.protodef.SitePluginsResponse.plugindata:type_name -> google.protobuf.Struct
+	119, // 3: ambient.protodef.SiteSavePostRequest.post:type_name -> google.protobuf.Struct
+	119, // 4: ambient.protodef.SitePostsAndPagesResponse.postwithidlist:type_name -> google.protobuf.Struct
+	119, // 5: ambient.protodef.SitePublishedPostsResponse.posts:type_name -> google.protobuf.Struct
+	119, // 6: ambient.protodef.SitePublishedPagesResponse.posts:type_name -> google.protobuf.Struct
+	119, // 7: ambient.protodef.SitePostBySlugResponse.post:type_name -> google.protobuf.Struct
+	119, // 8: ambient.protodef.SiteSearchPostsResponse.results:type_name -> google.protobuf.Struct
+	119, // 9: ambient.protodef.SiteQueryPostsRequest.query:type_name -> google.protobuf.Struct
+	119, // 10: ambient.protodef.SiteQueryPostsResponse.result:type_name -> google.protobuf.Struct
+	119, // 11: ambient.protodef.SiteContentTypesResponse.contenttypes:type_name -> google.protobuf.Struct
+	119, // 12: ambient.protodef.SitePostByIDResponse.post:type_name -> google.protobuf.Struct
+	119, // 13: ambient.protodef.SiteSavePostAsRequest.post:type_name -> google.protobuf.Struct
+	119, // 14: ambient.protodef.SitePostRevisionsResponse.revisions:type_name -> google.protobuf.Struct
+	119, // 15: ambient.protodef.SitePostRevisionResponse.revision:type_name -> google.protobuf.Struct
+	119, // 16: ambient.protodef.SiteCreatePostPreviewResponse.preview:type_name -> google.protobuf.Struct
+	119, // 17: ambient.protodef.SitePostPreviewsResponse.previews:type_name -> google.protobuf.Struct
+	119, // 18: ambient.protodef.SiteMenusResponse.menus:type_name -> google.protobuf.Struct
+	119, // 19: ambient.protodef.SiteMenuResponse.menu:type_name -> google.protobuf.Struct
+	119, // 20: ambient.protodef.SiteSaveMenuRequest.menu:type_name -> google.protobuf.Struct
+	119, // 21: ambient.protodef.SitePluginNeighborRoutesListResponse.routes:type_name -> google.protobuf.Struct
+	119, // 22: ambient.protodef.SiteUserSessionsResponse.sessions:type_name -> google.protobuf.Struct
+	119, // 23: ambient.protodef.SitePluginNeighborSettingsListResponse.settings:type_name -> google.protobuf.Struct
+	120, // 24: ambient.protodef.SitePluginSettingResponse.value:type_name -> google.protobuf.Any
+	120, // 25: ambient.protodef.SiteNeighborPluginSettingResponse.value:type_name -> google.protobuf.Any
+	121, // 26: ambient.protodef.SiteUpdatedResponse.timestamp:type_name -> google.protobuf.Timestamp
+	99,  // 27: ambient.protodef.SiteTagsResponse.tags:type_name -> ambient.protodef.Tag
+	121, // 28: ambient.protodef.Tag.timestamp:type_name -> google.protobuf.Timestamp
+	119, // 29: ambient.protodef.SiteTagDetailsResponse.tags:type_name -> google.protobuf.Struct
+	119, // 30: ambient.protodef.SiteTagBySlugResponse.tag:type_name -> google.protobuf.Struct
+	119, // 31: ambient.protodef.SiteSetTagMetaRequest.meta:type_name -> google.protobuf.Struct
+	119, // 32: ambient.protodef.SiteSnapshotsResponse.snapshots:type_name -> google.protobuf.Struct
+	119, // 33: ambient.protodef.SiteCreateSnapshotResponse.snapshot:type_name -> google.protobuf.Struct
+	119, // 34: ambient.protodef.SiteSnapshotDiffResponse.changes:type_name -> google.protobuf.Struct
+	121, // 35: ambient.protodef.SiteEvent.timestamp:type_name -> google.protobuf.Timestamp
+	122, // 36: ambient.protodef.Site.Load:input_type -> ambient.protodef.Empty
+	0,   // 37: ambient.protodef.Site.LoadSinglePluginPages:input_type -> ambient.protodef.SiteLoadSinglePluginPagesRequest
+	1,   // 38: ambient.protodef.Site.Authorized:input_type -> ambient.protodef.SiteAuthorizedRequest
+	3,   // 39: ambient.protodef.Site.NeighborPluginGrantList:input_type -> ambient.protodef.SiteNeighborPluginGrantListRequest
+	5,   // 40: ambient.protodef.Site.NeighborPluginGrants:input_type -> ambient.protodef.SiteNeighborPluginGrantsRequest
+	7,   // 41: ambient.protodef.Site.NeighborPluginGranted:input_type -> ambient.protodef.SiteNeighborPluginGrantedRequest
+	9,   // 42: ambient.protodef.Site.NeighborPluginRequestedGrant:input_type -> ambient.protodef.SiteNeighborPluginRequestedGrantRequest
+	11,  // 43: ambient.protodef.Site.SetNeighborPluginGrant:input_type -> ambient.protodef.SiteSetNeighborPluginGrantRequest
+	122, // 44: ambient.protodef.Site.Plugins:input_type -> ambient.protodef.Empty
+	122, // 45: ambient.protodef.Site.PluginNames:input_type -> ambient.protodef.Empty
+	14,  // 46: ambient.protodef.Site.DeletePlugin:input_type -> ambient.protodef.SiteDeletePluginRequest
+	15,  // 47: ambient.protodef.Site.EnablePlugin:input_type -> ambient.protodef.SiteEnablePluginRequest
+	16,  // 48: ambient.protodef.Site.DisablePlugin:input_type -> ambient.protodef.SiteDisablePluginRequest
+	17,  // 49: ambient.protodef.Site.SavePost:input_type -> ambient.protodef.SiteSavePostRequest
+	18,  // 50: ambient.protodef.Site.PostsAndPages:input_type -> ambient.protodef.SitePostsAndPagesRequest
+	122, // 51: ambient.protodef.Site.PublishedPosts:input_type -> ambient.protodef.Empty
+	122, // 52: ambient.protodef.Site.PublishedPages:input_type -> ambient.protodef.Empty
+	22,  // 53: ambient.protodef.Site.PostBySlug:input_type -> ambient.protodef.SitePostBySlugRequest
+	24,  // 54: ambient.protodef.Site.SearchPosts:input_type -> ambient.protodef.SiteSearchPostsRequest
+	26,  // 55: ambient.protodef.Site.QueryPosts:input_type -> ambient.protodef.SiteQueryPostsRequest
+	122, // 56: ambient.protodef.Site.ContentTypes:input_type -> ambient.protodef.Empty
+	29,  // 57: ambient.protodef.Site.PostByID:input_type -> ambient.protodef.SitePostByIDRequest
+	31,  // 58: ambient.protodef.Site.DeletePostByID:input_type -> ambient.protodef.SiteDeletePostByIDRequest
+	32,  // 59: ambient.protodef.Site.SavePostAs:input_type -> ambient.protodef.SiteSavePostAsRequest
+	33,  // 60: ambient.protodef.Site.PostRevisions:input_type -> ambient.protodef.SitePostRevisionsRequest
+	35,  // 61: ambient.protodef.Site.PostRevision:input_type -> ambient.protodef.SitePostRevisionRequest
+	35,  // 62: ambient.protodef.Site.PostRevisionDiff:input_type -> ambient.protodef.SitePostRevisionRequest
+	38,  // 63: ambient.protodef.Site.RestorePostRevision:input_type -> ambient.protodef.SiteRestorePostRevisionRequest
+	39,  // 64: ambient.protodef.Site.CreatePostPreview:input_type -> ambient.protodef.SiteCreatePostPreviewRequest
+	41,  // 65: ambient.protodef.Site.PostPreviews:input_type -> ambient.protodef.SitePostPreviewsRequest
+	43,  // 66: ambient.protodef.Site.RevokePostPreview:input_type -> ambient.protodef.SiteRevokePostPreviewRequest
+	44,  // 67: ambient.protodef.Site.PreviewPostBySlug:input_type -> ambient.protodef.SitePreviewPostBySlugRequest
+	45,  // 68: ambient.protodef.Site.PageChildren:input_type -> ambient.protodef.SitePageChildrenRequest
+	46,  // 69: ambient.protodef.Site.Breadcrumbs:input_type -> ambient.protodef.SiteBreadcrumbsRequest
+	122, // 70: ambient.protodef.Site.Menus:input_type -> ambient.protodef.Empty
+	48,  // 71: ambient.protodef.Site.Menu:input_type -> ambient.protodef.SiteMenuRequest
+	50,  // 72: ambient.protodef.Site.SaveMenu:input_type -> ambient.protodef.SiteSaveMenuRequest
+	51,  // 73: ambient.protodef.Site.DeleteMenu:input_type -> ambient.protodef.SiteDeleteMenuRequest
+	52,  // 74: ambient.protodef.Site.PluginNeighborRoutesList:input_type -> ambient.protodef.SitePluginNeighborRoutesListRequest
+	54,  // 75: ambient.protodef.Site.UserPersist:input_type -> ambient.protodef.SiteUserPersistRequest
+	55,  // 76: ambient.protodef.Site.UserLogin:input_type -> ambient.protodef.SiteUserLoginRequest
+	56,  // 77: ambient.protodef.Site.AuthenticatedUser:input_type -> ambient.protodef.SiteAuthenticatedUserRequest
+	58,  // 78: ambient.protodef.Site.UserLogout:input_type -> ambient.protodef.SiteUserLogoutRequest
+	59,  // 79: ambient.protodef.Site.LogoutAllUsers:input_type -> ambient.protodef.SiteLogoutAllUsersRequest
+	60,  // 80: ambient.protodef.Site.UserSessions:input_type -> ambient.protodef.SiteUserSessionsRequest
+	62,  // 81: ambient.protodef.Site.LogoutUser:input_type -> ambient.protodef.SiteLogoutUserRequest
+	63,  // 82: ambient.protodef.Site.SetCSRF:input_type -> ambient.protodef.SiteSetCSRFRequest
+	65,  // 83: ambient.protodef.Site.CSRF:input_type -> ambient.protodef.SiteCSRFRequest
+	67,  // 84: ambient.protodef.Site.SessionValue:input_type -> ambient.protodef.SiteSessionValueRequest
+	69,  // 85: ambient.protodef.Site.SetSessionValue:input_type -> ambient.protodef.SiteSetSessionValueRequest
+	70,  // 86: ambient.protodef.Site.DeleteSessionValue:input_type -> ambient.protodef.SiteDeleteSessionValueRequest
+	71,  // 87: ambient.protodef.Site.PluginNeighborSettingsList:input_type -> ambient.protodef.SitePluginNeighborSettingsListRequest
+	73,  // 88: ambient.protodef.Site.SetPluginSetting:input_type -> ambient.protodef.SiteSetPluginSettingRequest
+	74,  // 89: ambient.protodef.Site.PluginSettingBool:input_type -> ambient.protodef.SitePluginSettingBoolRequest
+	76,  // 90: ambient.protodef.Site.PluginSettingString:input_type -> ambient.protodef.SitePluginSettingStringRequest
+	78,  // 91: ambient.protodef.Site.PluginSetting:input_type -> ambient.protodef.SitePluginSettingRequest
+	80,  // 92: ambient.protodef.Site.SetNeighborPluginSetting:input_type -> ambient.protodef.SiteSetNeighborPluginSettingRequest
+	81,  // 93: ambient.protodef.Site.NeighborPluginSettingString:input_type -> ambient.protodef.SiteNeighborPluginSettingStringRequest
+	83,  // 94: ambient.protodef.Site.NeighborPluginSetting:input_type -> ambient.protodef.SiteNeighborPluginSettingRequest
+	85,  // 95: ambient.protodef.Site.PluginTrusted:input_type -> ambient.protodef.SitePluginTrustedRequest
+	87,  // 96: ambient.protodef.Site.SetTitle:input_type -> ambient.protodef.SiteSetTitleRequest
+	122, // 97: ambient.protodef.Site.Title:input_type -> ambient.protodef.Empty
+	89,  // 98: ambient.protodef.Site.SetScheme:input_type -> ambient.protodef.SiteSetSchemeRequest
+	122, // 99: ambient.protodef.Site.Scheme:input_type -> ambient.protodef.Empty
+	91,  // 100: ambient.protodef.Site.SetURL:input_type -> ambient.protodef.SiteSetURLRequest
+	122, // 101: ambient.protodef.Site.URL:input_type -> ambient.protodef.Empty
+	122, // 102: ambient.protodef.Site.FullURL:input_type -> ambient.protodef.Empty
+	122, // 103: ambient.protodef.Site.Updated:input_type -> ambient.protodef.Empty
+	95,  // 104: ambient.protodef.Site.SetContent:input_type -> ambient.protodef.SiteSetContentRequest
+	122, // 105: ambient.protodef.Site.Content:input_type -> ambient.protodef.Empty
+	97,  // 106: ambient.protodef.Site.Tags:input_type -> ambient.protodef.SiteTagsRequest
+	97,  // 107: ambient.protodef.Site.TagDetails:input_type -> ambient.protodef.SiteTagsRequest
+	101, // 108: ambient.protodef.Site.TagBySlug:input_type -> ambient.protodef.SiteTagBySlugRequest
+	103, // 109: ambient.protodef.Site.RenameTag:input_type -> ambient.protodef.SiteRenameTagRequest
+	104, // 110: ambient.protodef.Site.MergeTags:input_type -> ambient.protodef.SiteMergeTagsRequest
+	105, // 111: ambient.protodef.Site.DeleteTag:input_type -> ambient.protodef.SiteDeleteTagRequest
+	106, // 112: ambient.protodef.Site.SetTagMeta:input_type -> ambient.protodef.SiteSetTagMetaRequest
+	122, // 113: ambient.protodef.Site.Snapshots:input_type -> ambient.protodef.Empty
+	122, // 114: ambient.protodef.Site.CreateSnapshot:input_type -> ambient.protodef.Empty
+	109, // 115: ambient.protodef.Site.SnapshotDiff:input_type -> ambient.protodef.SiteSnapshotDiffRequest
+	111, // 116: ambient.protodef.Site.RestoreSnapshot:input_type -> ambient.protodef.SiteRestoreSnapshotRequest
+	112, // 117: ambient.protodef.Site.PluginStoreGet:input_type -> ambient.protodef.SitePluginStoreGetRequest
+	114, // 118: ambient.protodef.Site.PluginStorePut:input_type -> ambient.protodef.SitePluginStorePutRequest
+	115, // 119: ambient.protodef.Site.PluginStoreDelete:input_type -> ambient.protodef.SitePluginStoreDeleteRequest
+	122, // 120: ambient.protodef.Site.PluginStoreList:input_type -> ambient.protodef.Empty
+	122, // 121: ambient.protodef.Site.Subscribe:input_type -> ambient.protodef.Empty
+	122, // 122: ambient.protodef.Site.Load:output_type -> ambient.protodef.Empty
+	122, // 123: ambient.protodef.Site.LoadSinglePluginPages:output_type -> ambient.protodef.Empty
+	2,   // 124: ambient.protodef.Site.Authorized:output_type -> ambient.protodef.SiteAuthorizedResponse
+	4,   // 125: ambient.protodef.Site.NeighborPluginGrantList:output_type -> ambient.protodef.SiteNeighborPluginGrantListResponse
+	6,   // 126: ambient.protodef.Site.NeighborPluginGrants:output_type -> ambient.protodef.SiteNeighborPluginGrantsResponse
+	8,   // 127: ambient.protodef.Site.NeighborPluginGranted:output_type -> ambient.protodef.SiteNeighborPluginGrantedResponse
+	10,  // 128: ambient.protodef.Site.NeighborPluginRequestedGrant:output_type -> ambient.protodef.SiteNeighborPluginRequestedGrantResponse
+	122, // 129: ambient.protodef.Site.SetNeighborPluginGrant:output_type -> ambient.protodef.Empty
+	12,  // 130: ambient.protodef.Site.Plugins:output_type -> ambient.protodef.SitePluginsResponse
+	13,  // 131: ambient.protodef.Site.PluginNames:output_type -> ambient.protodef.SitePluginNamesResponse
+	122, // 132: ambient.protodef.Site.DeletePlugin:output_type -> ambient.protodef.Empty
+	122, // 133: ambient.protodef.Site.EnablePlugin:output_type -> ambient.protodef.Empty
+	122, // 134: ambient.protodef.Site.DisablePlugin:output_type -> ambient.protodef.Empty
+	122, // 135: ambient.protodef.Site.SavePost:output_type -> ambient.protodef.Empty
+	19,  // 136: ambient.protodef.Site.PostsAndPages:output_type -> ambient.protodef.SitePostsAndPagesResponse
+	20,  // 137: ambient.protodef.Site.PublishedPosts:output_type -> ambient.protodef.SitePublishedPostsResponse
+	21,  // 138: ambient.protodef.Site.PublishedPages:output_type -> ambient.protodef.SitePublishedPagesResponse
+	23,  // 139: ambient.protodef.Site.PostBySlug:output_type -> ambient.protodef.SitePostBySlugResponse
+	25,  // 140: ambient.protodef.Site.SearchPosts:output_type -> ambient.protodef.SiteSearchPostsResponse
+	27,  // 141: ambient.protodef.Site.QueryPosts:output_type -> ambient.protodef.SiteQueryPostsResponse
+	28,  // 142: ambient.protodef.Site.ContentTypes:output_type -> ambient.protodef.SiteContentTypesResponse
+	30,  // 143: ambient.protodef.Site.PostByID:output_type -> ambient.protodef.SitePostByIDResponse
+	122, // 144: ambient.protodef.Site.DeletePostByID:output_type -> ambient.protodef.Empty
+	122, // 145: ambient.protodef.Site.SavePostAs:output_type -> ambient.protodef.Empty
+	34,  // 146: ambient.protodef.Site.PostRevisions:output_type -> ambient.protodef.SitePostRevisionsResponse
+	36,  // 147: ambient.protodef.Site.PostRevision:output_type -> ambient.protodef.SitePostRevisionResponse
+	37,  // 148: ambient.protodef.Site.PostRevisionDiff:output_type -> ambient.protodef.SitePostRevisionDiffResponse
+	122, // 149: ambient.protodef.Site.RestorePostRevision:output_type -> ambient.protodef.Empty
+	40,  // 150: ambient.protodef.Site.CreatePostPreview:output_type -> ambient.protodef.SiteCreatePostPreviewResponse
+	42,  // 151: ambient.protodef.Site.PostPreviews:output_type -> ambient.protodef.SitePostPreviewsResponse
+	122, // 152: ambient.protodef.Site.RevokePostPreview:output_type -> ambient.protodef.Empty
+	23,  // 153: ambient.protodef.Site.PreviewPostBySlug:output_type -> ambient.protodef.SitePostBySlugResponse
+	19,  // 154: ambient.protodef.Site.PageChildren:output_type -> ambient.protodef.SitePostsAndPagesResponse
+	19,  // 155: ambient.protodef.Site.Breadcrumbs:output_type -> ambient.protodef.SitePostsAndPagesResponse
+	47,  // 156: ambient.protodef.Site.Menus:output_type -> ambient.protodef.SiteMenusResponse
+	49,  // 157: ambient.protodef.Site.Menu:output_type -> ambient.protodef.SiteMenuResponse
+	122, // 158: ambient.protodef.Site.SaveMenu:output_type -> ambient.protodef.Empty
+	122, // 159: ambient.protodef.Site.DeleteMenu:output_type -> ambient.protodef.Empty
+	53,  // 160: ambient.protodef.Site.PluginNeighborRoutesList:output_type -> ambient.protodef.SitePluginNeighborRoutesListResponse
+	122, // 161: ambient.protodef.Site.UserPersist:output_type -> ambient.protodef.Empty
+	122, // 162: ambient.protodef.Site.UserLogin:output_type -> ambient.protodef.Empty
+	57,  // 163: ambient.protodef.Site.AuthenticatedUser:output_type -> ambient.protodef.SiteAuthenticatedUserResponse
+	122, // 164: ambient.protodef.Site.UserLogout:output_type -> ambient.protodef.Empty
+	122, // 165: ambient.protodef.Site.LogoutAllUsers:output_type -> ambient.protodef.Empty
+	61,  // 166: ambient.protodef.Site.UserSessions:output_type -> ambient.protodef.SiteUserSessionsResponse
+	122, // 167: ambient.protodef.Site.LogoutUser:output_type -> ambient.protodef.Empty
+	64,  // 168: ambient.protodef.Site.SetCSRF:output_type -> ambient.protodef.SiteSetCSRFResponse
+	66,  // 169: ambient.protodef.Site.CSRF:output_type -> ambient.protodef.SiteCSRFResponse
+	68,  // 170: ambient.protodef.Site.SessionValue:output_type -> ambient.protodef.SiteSessionValueResponse
+	122, // 171: ambient.protodef.Site.SetSessionValue:output_type -> ambient.protodef.Empty
+	122, // 172: ambient.protodef.Site.DeleteSessionValue:output_type -> ambient.protodef.Empty
+	72,  // 173: ambient.protodef.Site.PluginNeighborSettingsList:output_type -> ambient.protodef.SitePluginNeighborSettingsListResponse
+	122, // 174: ambient.protodef.Site.SetPluginSetting:output_type -> ambient.protodef.Empty
+	75,  // 175: ambient.protodef.Site.PluginSettingBool:output_type -> ambient.protodef.SitePluginSettingBoolResponse
+	77,  // 176: ambient.protodef.Site.PluginSettingString:output_type -> ambient.protodef.SitePluginSettingStringResponse
+	79,  // 177: ambient.protodef.Site.PluginSetting:output_type -> ambient.protodef.SitePluginSettingResponse
+	122, // 178: ambient.protodef.Site.SetNeighborPluginSetting:output_type -> ambient.protodef.Empty
+	82,  // 179: ambient.protodef.Site.NeighborPluginSettingString:output_type -> ambient.protodef.SiteNeighborPluginSettingStringResponse
+	84,  // 180: ambient.protodef.Site.NeighborPluginSetting:output_type -> ambient.protodef.SiteNeighborPluginSettingResponse
+	86,  // 181: ambient.protodef.Site.PluginTrusted:output_type -> ambient.protodef.SitePluginTrustedResponse
+	122, // 182: ambient.protodef.Site.SetTitle:output_type -> ambient.protodef.Empty
+	88,  // 183: ambient.protodef.Site.Title:output_type -> ambient.protodef.SiteTitleResponse
+	122, // 184: ambient.protodef.Site.SetScheme:output_type -> ambient.protodef.Empty
+	90,  // 185: ambient.protodef.Site.Scheme:output_type -> ambient.protodef.SiteSchemeResponse
+	122, // 186: ambient.protodef.Site.SetURL:output_type -> ambient.protodef.Empty
+	92,  // 187: ambient.protodef.Site.URL:output_type -> ambient.protodef.SiteURLResponse
+	93,  // 188: ambient.protodef.Site.FullURL:output_type -> ambient.protodef.SiteFullURLResponse
+	94,  // 189: ambient.protodef.Site.Updated:output_type -> ambient.protodef.SiteUpdatedResponse
+	122, // 190: ambient.protodef.Site.SetContent:output_type -> ambient.protodef.Empty
+	96,  // 191: ambient.protodef.Site.Content:output_type -> ambient.protodef.SiteContentResponse
+	98,  // 192: ambient.protodef.Site.Tags:output_type -> ambient.protodef.SiteTagsResponse
+	100, // 193: ambient.protodef.Site.TagDetails:output_type -> ambient.protodef.SiteTagDetailsResponse
+	102, // 194: ambient.protodef.Site.TagBySlug:output_type -> ambient.protodef.SiteTagBySlugResponse
+	122, // 195: ambient.protodef.Site.RenameTag:output_type -> ambient.protodef.Empty
+	122, // 196: ambient.protodef.Site.MergeTags:output_type -> ambient.protodef.Empty
+	122, // 197: ambient.protodef.Site.DeleteTag:output_type -> ambient.protodef.Empty
+	122, // 198: ambient.protodef.Site.SetTagMeta:output_type -> ambient.protodef.Empty
+	107, // 199: ambient.protodef.Site.Snapshots:output_type -> ambient.protodef.SiteSnapshotsResponse
+	108, // 200: ambient.protodef.Site.CreateSnapshot:output_type -> ambient.protodef.SiteCreateSnapshotResponse
+	110, // 201: ambient.protodef.Site.SnapshotDiff:output_type -> ambient.protodef.SiteSnapshotDiffResponse
+	122, // 202: ambient.protodef.Site.RestoreSnapshot:output_type -> ambient.protodef.Empty
+	113, // 203: ambient.protodef.Site.PluginStoreGet:output_type -> ambient.protodef.SitePluginStoreGetResponse
+	122, // 204: ambient.protodef.Site.PluginStorePut:output_type -> ambient.protodef.Empty
+	122, // 205: ambient.protodef.Site.PluginStoreDelete:output_type -> ambient.protodef.Empty
+	116, // 206: ambient.protodef.Site.PluginStoreList:output_type -> ambient.protodef.SitePluginStoreListResponse
+	117, // 207: ambient.protodef.Site.Subscribe:output_type -> ambient.protodef.SiteEvent
+	122, // [122:208] is the sub-list for method output_type
+	36,  // [36:122] is the sub-list for method input_type
+	36,  // [36:36] is the sub-list for extension type_name
+	36,  // [36:36] is the sub-list for extension extendee
+	0,   // [0:36] is the sub-list for field type_name
+}
+
+func init() { file_site_proto_init() }
+func file_site_proto_init() {
+	if File_site_proto != nil {
+		return
+	}
+	file_empty_proto_init()
+	file_plugin_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_site_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteLoadSinglePluginPagesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteAuthorizedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteAuthorizedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteNeighborPluginGrantListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteNeighborPluginGrantListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteNeighborPluginGrantsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteNeighborPluginGrantsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteNeighborPluginGrantedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteNeighborPluginGrantedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteNeighborPluginRequestedGrantRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteNeighborPluginRequestedGrantResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteSetNeighborPluginGrantRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SitePluginsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SitePluginNamesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteDeletePluginRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteEnablePluginRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteDisablePluginRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteSavePostRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SitePostsAndPagesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SitePostsAndPagesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SitePublishedPostsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SitePublishedPagesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SitePostBySlugRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SitePostBySlugResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteSearchPostsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteSearchPostsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteQueryPostsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteQueryPostsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteContentTypesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SitePostByIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SitePostByIDResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteDeletePostByIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteSavePostAsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SitePostRevisionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SitePostRevisionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SitePostRevisionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SitePostRevisionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SitePostRevisionDiffResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteRestorePostRevisionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteCreatePostPreviewRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteCreatePostPreviewResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SitePostPreviewsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SitePostPreviewsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteRevokePostPreviewRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SitePreviewPostBySlugRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SitePageChildrenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteBreadcrumbsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteMenusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteMenuRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteMenuResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteSaveMenuRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteDeleteMenuRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SitePluginNeighborRoutesListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SitePluginNeighborRoutesListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteUserPersistRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteUserLoginRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteAuthenticatedUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteAuthenticatedUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteUserLogoutRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteLogoutAllUsersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteUserSessionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteUserSessionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteLogoutUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteSetCSRFRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteSetCSRFResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteCSRFRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteCSRFResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteSessionValueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteSessionValueResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteSetSessionValueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteDeleteSessionValueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SitePluginNeighborSettingsListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SitePluginNeighborSettingsListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteSetPluginSettingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SitePluginSettingBoolRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SitePluginSettingBoolResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SitePluginSettingStringRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SitePluginSettingStringResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SitePluginSettingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SitePluginSettingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteSetNeighborPluginSettingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteNeighborPluginSettingStringRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteNeighborPluginSettingStringResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteNeighborPluginSettingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteNeighborPluginSettingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SitePluginTrustedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SitePluginTrustedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteSetTitleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteTitleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteSetSchemeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteSchemeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteSetURLRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteURLResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteFullURLResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteUpdatedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteSetContentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteContentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteTagsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteTagsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Tag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteTagDetailsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteTagBySlugRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteTagBySlugResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteRenameTagRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteMergeTagsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteDeleteTagRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteSetTagMetaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteSnapshotsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteCreateSnapshotResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteSnapshotDiffRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteSnapshotDiffResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteRestoreSnapshotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SitePluginStoreGetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SitePluginStoreGetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SitePluginStorePutRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SitePluginStoreDeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SitePluginStoreListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_site_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SiteEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_site_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   118,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_site_proto_goTypes,
+		DependencyIndexes: file_site_proto_depIdxs,
+		MessageInfos:      file_site_proto_msgTypes,
+	}.Build()
+	File_site_proto = out.File
+	file_site_proto_rawDesc = nil
+	file_site_proto_goTypes = nil
+	file_site_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// SiteClient is the client API for Site service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type SiteClient interface {
+	Load(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
+	LoadSinglePluginPages(ctx context.Context, in *SiteLoadSinglePluginPagesRequest, opts ...grpc.CallOption) (*Empty, error)
+	Authorized(ctx context.Context, in *SiteAuthorizedRequest, opts ...grpc.CallOption) (*SiteAuthorizedResponse, error)
+	NeighborPluginGrantList(ctx context.Context, in *SiteNeighborPluginGrantListRequest, opts ...grpc.CallOption) (*SiteNeighborPluginGrantListResponse, error)
+	NeighborPluginGrants(ctx context.Context, in *SiteNeighborPluginGrantsRequest, opts ...grpc.CallOption) (*SiteNeighborPluginGrantsResponse, error)
+	NeighborPluginGranted(ctx context.Context, in *SiteNeighborPluginGrantedRequest, opts ...grpc.CallOption) (*SiteNeighborPluginGrantedResponse, error)
+	NeighborPluginRequestedGrant(ctx context.Context, in *SiteNeighborPluginRequestedGrantRequest, opts ...grpc.CallOption) (*SiteNeighborPluginRequestedGrantResponse, error)
+	SetNeighborPluginGrant(ctx context.Context, in *SiteSetNeighborPluginGrantRequest, opts ...grpc.CallOption) (*Empty, error)
+	Plugins(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SitePluginsResponse, error)
+	PluginNames(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SitePluginNamesResponse, error)
+	DeletePlugin(ctx context.Context, in *SiteDeletePluginRequest, opts ...grpc.CallOption) (*Empty, error)
+	EnablePlugin(ctx context.Context, in *SiteEnablePluginRequest, opts ...grpc.CallOption) (*Empty, error)
+	DisablePlugin(ctx context.Context, in *SiteDisablePluginRequest, opts ...grpc.CallOption) (*Empty, error)
+	SavePost(ctx context.Context, in *SiteSavePostRequest, opts ...grpc.CallOption) (*Empty, error)
+	PostsAndPages(ctx context.Context, in *SitePostsAndPagesRequest, opts ...grpc.CallOption) (*SitePostsAndPagesResponse, error)
+	PublishedPosts(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SitePublishedPostsResponse, error)
+	PublishedPages(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SitePublishedPagesResponse, error)
+	PostBySlug(ctx context.Context, in *SitePostBySlugRequest, opts ...grpc.CallOption) (*SitePostBySlugResponse, error)
+	SearchPosts(ctx context.Context, in *SiteSearchPostsRequest, opts ...grpc.CallOption) (*SiteSearchPostsResponse, error)
+	QueryPosts(ctx context.Context, in *SiteQueryPostsRequest, opts ...grpc.CallOption) (*SiteQueryPostsResponse, error)
+	ContentTypes(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SiteContentTypesResponse, error)
+	PostByID(ctx context.Context, in *SitePostByIDRequest, opts ...grpc.CallOption) (*SitePostByIDResponse, error)
+	DeletePostByID(ctx context.Context, in *SiteDeletePostByIDRequest, opts ...grpc.CallOption) (*Empty, error)
+	SavePostAs(ctx context.Context, in *SiteSavePostAsRequest, opts ...grpc.CallOption) (*Empty, error)
+	PostRevisions(ctx context.Context, in *SitePostRevisionsRequest, opts ...grpc.CallOption) (*SitePostRevisionsResponse, error)
+	PostRevision(ctx context.Context, in *SitePostRevisionRequest, opts ...grpc.CallOption) (*SitePostRevisionResponse, error)
+	PostRevisionDiff(ctx context.Context, in *SitePostRevisionRequest, opts ...grpc.CallOption) (*SitePostRevisionDiffResponse, error)
+	RestorePostRevision(ctx context.Context, in *SiteRestorePostRevisionRequest, opts ...grpc.CallOption) (*Empty, error)
+	CreatePostPreview(ctx context.Context, in *SiteCreatePostPreviewRequest, opts ...grpc.CallOption) (*SiteCreatePostPreviewResponse, error)
+	PostPreviews(ctx context.Context, in *SitePostPreviewsRequest, opts ...grpc.CallOption) (*SitePostPreviewsResponse, error)
+	RevokePostPreview(ctx context.Context, in *SiteRevokePostPreviewRequest, opts ...grpc.CallOption) (*Empty, error)
+	PreviewPostBySlug(ctx context.Context, in *SitePreviewPostBySlugRequest, opts ...grpc.CallOption) (*SitePostBySlugResponse, error)
+	PageChildren(ctx context.Context, in *SitePageChildrenRequest, opts ...grpc.CallOption) (*SitePostsAndPagesResponse, error)
+	Breadcrumbs(ctx context.Context, in *SiteBreadcrumbsRequest, opts ...grpc.CallOption) (*SitePostsAndPagesResponse, error)
+	Menus(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SiteMenusResponse, error)
+	Menu(ctx context.Context, in *SiteMenuRequest, opts ...grpc.CallOption) (*SiteMenuResponse, error)
+	SaveMenu(ctx context.Context, in *SiteSaveMenuRequest, opts ...grpc.CallOption) (*Empty, error)
+	DeleteMenu(ctx context.Context, in *SiteDeleteMenuRequest, opts ...grpc.CallOption) (*Empty, error)
+	PluginNeighborRoutesList(ctx context.Context, in *SitePluginNeighborRoutesListRequest, opts ...grpc.CallOption) (*SitePluginNeighborRoutesListResponse, error)
+	UserPersist(ctx context.Context, in *SiteUserPersistRequest, opts ...grpc.CallOption) (*Empty, error)
+	UserLogin(ctx context.Context, in *SiteUserLoginRequest, opts ...grpc.CallOption) (*Empty, error)
+	AuthenticatedUser(ctx context.Context, in *SiteAuthenticatedUserRequest, opts ...grpc.CallOption) (*SiteAuthenticatedUserResponse, error)
+	UserLogout(ctx context.Context, in *SiteUserLogoutRequest, opts ...grpc.CallOption) (*Empty, error)
+	LogoutAllUsers(ctx context.Context, in *SiteLogoutAllUsersRequest, opts ...grpc.CallOption) (*Empty, error)
+	UserSessions(ctx context.Context, in *SiteUserSessionsRequest, opts ...grpc.CallOption) (*SiteUserSessionsResponse, error)
+	LogoutUser(ctx context.Context, in *SiteLogoutUserRequest, opts ...grpc.CallOption) (*Empty, error)
+	SetCSRF(ctx context.Context, in *SiteSetCSRFRequest, opts ...grpc.CallOption) (*SiteSetCSRFResponse, error)
+	CSRF(ctx context.Context, in *SiteCSRFRequest, opts ...grpc.CallOption) (*SiteCSRFResponse, error)
+	SessionValue(ctx context.Context, in *SiteSessionValueRequest, opts ...grpc.CallOption) (*SiteSessionValueResponse, error)
+	SetSessionValue(ctx context.Context, in *SiteSetSessionValueRequest, opts ...grpc.CallOption) (*Empty, error)
+	DeleteSessionValue(ctx context.Context, in *SiteDeleteSessionValueRequest, opts ...grpc.CallOption) (*Empty, error)
+	PluginNeighborSettingsList(ctx context.Context, in *SitePluginNeighborSettingsListRequest, opts ...grpc.CallOption) (*SitePluginNeighborSettingsListResponse, error)
+	SetPluginSetting(ctx context.Context, in *SiteSetPluginSettingRequest, opts ...grpc.CallOption) (*Empty, error)
+	PluginSettingBool(ctx context.Context, in *SitePluginSettingBoolRequest, opts ...grpc.CallOption) (*SitePluginSettingBoolResponse, error)
+	PluginSettingString(ctx context.Context, in *SitePluginSettingStringRequest, opts ...grpc.CallOption) (*SitePluginSettingStringResponse, error)
+	PluginSetting(ctx context.Context, in *SitePluginSettingRequest, opts ...grpc.CallOption) (*SitePluginSettingResponse, error)
+	SetNeighborPluginSetting(ctx context.Context, in *SiteSetNeighborPluginSettingRequest, opts ...grpc.CallOption) (*Empty, error)
+	NeighborPluginSettingString(ctx context.Context, in *SiteNeighborPluginSettingStringRequest, opts ...grpc.CallOption) (*SiteNeighborPluginSettingStringResponse, error)
+	NeighborPluginSetting(ctx context.Context, in *SiteNeighborPluginSettingRequest, opts ...grpc.CallOption) (*SiteNeighborPluginSettingResponse, error)
+	PluginTrusted(ctx context.Context, in *SitePluginTrustedRequest, opts ...grpc.CallOption) (*SitePluginTrustedResponse, error)
+	SetTitle(ctx context.Context, in *SiteSetTitleRequest, opts ...grpc.CallOption) (*Empty, error)
+	Title(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SiteTitleResponse, error)
+	SetScheme(ctx context.Context, in *SiteSetSchemeRequest, opts ...grpc.CallOption) (*Empty, error)
+	Scheme(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SiteSchemeResponse, error)
+	SetURL(ctx context.Context, in *SiteSetURLRequest, opts ...grpc.CallOption) (*Empty, error)
+	URL(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SiteURLResponse, error)
+	FullURL(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SiteFullURLResponse, error)
+	Updated(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SiteUpdatedResponse, error)
+	SetContent(ctx context.Context, in *SiteSetContentRequest, opts ...grpc.CallOption) (*Empty, error)
+	Content(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SiteContentResponse, error)
+	Tags(ctx context.Context, in *SiteTagsRequest, opts ...grpc.CallOption) (*SiteTagsResponse, error)
+	TagDetails(ctx context.Context, in *SiteTagsRequest, opts ...grpc.CallOption) (*SiteTagDetailsResponse, error)
+	TagBySlug(ctx context.Context, in *SiteTagBySlugRequest, opts ...grpc.CallOption) (*SiteTagBySlugResponse, error)
+	RenameTag(ctx context.Context, in *SiteRenameTagRequest, opts ...grpc.CallOption) (*Empty, error)
+	MergeTags(ctx context.Context, in *SiteMergeTagsRequest, opts ...grpc.CallOption) (*Empty, error)
+	DeleteTag(ctx context.Context, in *SiteDeleteTagRequest, opts ...grpc.CallOption) (*Empty, error)
+	SetTagMeta(ctx context.Context, in *SiteSetTagMetaRequest, opts ...grpc.CallOption) (*Empty, error)
+	Snapshots(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SiteSnapshotsResponse, error)
+	CreateSnapshot(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SiteCreateSnapshotResponse, error)
+	SnapshotDiff(ctx context.Context, in *SiteSnapshotDiffRequest, opts ...grpc.CallOption) (*SiteSnapshotDiffResponse, error)
+	RestoreSnapshot(ctx context.Context, in *SiteRestoreSnapshotRequest, opts ...grpc.CallOption) (*Empty, error)
+	PluginStoreGet(ctx context.Context, in *SitePluginStoreGetRequest, opts ...grpc.CallOption) (*SitePluginStoreGetResponse, error)
+	PluginStorePut(ctx context.Context, in *SitePluginStorePutRequest, opts ...grpc.CallOption) (*Empty, error)
+	PluginStoreDelete(ctx context.Context, in *SitePluginStoreDeleteRequest, opts ...grpc.CallOption) (*Empty, error)
+	PluginStoreList(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SitePluginStoreListResponse, error)
+	Subscribe(ctx context.Context, in *Empty, opts ...grpc.CallOption) (Site_SubscribeClient, error)
+}
+
+type siteClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSiteClient(cc grpc.ClientConnInterface) SiteClient {
+	return &siteClient{cc}
+}
+
+func (c *siteClient) Load(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/Load", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) LoadSinglePluginPages(ctx context.Context, in *SiteLoadSinglePluginPagesRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/LoadSinglePluginPages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) Authorized(ctx context.Context, in *SiteAuthorizedRequest, opts ...grpc.CallOption) (*SiteAuthorizedResponse, error) {
+	out := new(SiteAuthorizedResponse)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/Authorized", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) NeighborPluginGrantList(ctx context.Context, in *SiteNeighborPluginGrantListRequest, opts ...grpc.CallOption) (*SiteNeighborPluginGrantListResponse, error) {
+	out := new(SiteNeighborPluginGrantListResponse)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/NeighborPluginGrantList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) NeighborPluginGrants(ctx context.Context, in *SiteNeighborPluginGrantsRequest, opts ...grpc.CallOption) (*SiteNeighborPluginGrantsResponse, error) {
+	out := new(SiteNeighborPluginGrantsResponse)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/NeighborPluginGrants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) NeighborPluginGranted(ctx context.Context, in *SiteNeighborPluginGrantedRequest, opts ...grpc.CallOption) (*SiteNeighborPluginGrantedResponse, error) {
+	out := new(SiteNeighborPluginGrantedResponse)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/NeighborPluginGranted", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) NeighborPluginRequestedGrant(ctx context.Context, in *SiteNeighborPluginRequestedGrantRequest, opts ...grpc.CallOption) (*SiteNeighborPluginRequestedGrantResponse, error) {
+	out := new(SiteNeighborPluginRequestedGrantResponse)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/NeighborPluginRequestedGrant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) SetNeighborPluginGrant(ctx context.Context, in *SiteSetNeighborPluginGrantRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/SetNeighborPluginGrant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) Plugins(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SitePluginsResponse, error) {
+	out := new(SitePluginsResponse)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/Plugins", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) PluginNames(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SitePluginNamesResponse, error) {
+	out := new(SitePluginNamesResponse)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/PluginNames", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) DeletePlugin(ctx context.Context, in *SiteDeletePluginRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/DeletePlugin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) EnablePlugin(ctx context.Context, in *SiteEnablePluginRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/EnablePlugin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) DisablePlugin(ctx context.Context, in *SiteDisablePluginRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/DisablePlugin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) SavePost(ctx context.Context, in *SiteSavePostRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/SavePost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) PostsAndPages(ctx context.Context, in *SitePostsAndPagesRequest, opts ...grpc.CallOption) (*SitePostsAndPagesResponse, error) {
+	out := new(SitePostsAndPagesResponse)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/PostsAndPages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) PublishedPosts(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SitePublishedPostsResponse, error) {
+	out := new(SitePublishedPostsResponse)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/PublishedPosts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) PublishedPages(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SitePublishedPagesResponse, error) {
+	out := new(SitePublishedPagesResponse)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/PublishedPages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) PostBySlug(ctx context.Context, in *SitePostBySlugRequest, opts ...grpc.CallOption) (*SitePostBySlugResponse, error) {
+	out := new(SitePostBySlugResponse)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/PostBySlug", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) SearchPosts(ctx context.Context, in *SiteSearchPostsRequest, opts ...grpc.CallOption) (*SiteSearchPostsResponse, error) {
+	out := new(SiteSearchPostsResponse)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/SearchPosts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) QueryPosts(ctx context.Context, in *SiteQueryPostsRequest, opts ...grpc.CallOption) (*SiteQueryPostsResponse, error) {
+	out := new(SiteQueryPostsResponse)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/QueryPosts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) ContentTypes(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SiteContentTypesResponse, error) {
+	out := new(SiteContentTypesResponse)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/ContentTypes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) PostByID(ctx context.Context, in *SitePostByIDRequest, opts ...grpc.CallOption) (*SitePostByIDResponse, error) {
+	out := new(SitePostByIDResponse)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/PostByID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) DeletePostByID(ctx context.Context, in *SiteDeletePostByIDRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/DeletePostByID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) SavePostAs(ctx context.Context, in *SiteSavePostAsRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/SavePostAs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) PostRevisions(ctx context.Context, in *SitePostRevisionsRequest, opts ...grpc.CallOption) (*SitePostRevisionsResponse, error) {
+	out := new(SitePostRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/PostRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) PostRevision(ctx context.Context, in *SitePostRevisionRequest, opts ...grpc.CallOption) (*SitePostRevisionResponse, error) {
+	out := new(SitePostRevisionResponse)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/PostRevision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) PostRevisionDiff(ctx context.Context, in *SitePostRevisionRequest, opts ...grpc.CallOption) (*SitePostRevisionDiffResponse, error) {
+	out := new(SitePostRevisionDiffResponse)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/PostRevisionDiff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) RestorePostRevision(ctx context.Context, in *SiteRestorePostRevisionRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/RestorePostRevision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) CreatePostPreview(ctx context.Context, in *SiteCreatePostPreviewRequest, opts ...grpc.CallOption) (*SiteCreatePostPreviewResponse, error) {
+	out := new(SiteCreatePostPreviewResponse)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/CreatePostPreview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) PostPreviews(ctx context.Context, in *SitePostPreviewsRequest, opts ...grpc.CallOption) (*SitePostPreviewsResponse, error) {
+	out := new(SitePostPreviewsResponse)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/PostPreviews", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) RevokePostPreview(ctx context.Context, in *SiteRevokePostPreviewRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/RevokePostPreview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) PreviewPostBySlug(ctx context.Context, in *SitePreviewPostBySlugRequest, opts ...grpc.CallOption) (*SitePostBySlugResponse, error) {
+	out := new(SitePostBySlugResponse)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/PreviewPostBySlug", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) PageChildren(ctx context.Context, in *SitePageChildrenRequest, opts ...grpc.CallOption) (*SitePostsAndPagesResponse, error) {
+	out := new(SitePostsAndPagesResponse)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/PageChildren", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) Breadcrumbs(ctx context.Context, in *SiteBreadcrumbsRequest, opts ...grpc.CallOption) (*SitePostsAndPagesResponse, error) {
+	out := new(SitePostsAndPagesResponse)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/Breadcrumbs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) Menus(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SiteMenusResponse, error) {
+	out := new(SiteMenusResponse)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/Menus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) Menu(ctx context.Context, in *SiteMenuRequest, opts ...grpc.CallOption) (*SiteMenuResponse, error) {
+	out := new(SiteMenuResponse)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/Menu", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) SaveMenu(ctx context.Context, in *SiteSaveMenuRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/SaveMenu", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) DeleteMenu(ctx context.Context, in *SiteDeleteMenuRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/DeleteMenu", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) PluginNeighborRoutesList(ctx context.Context, in *SitePluginNeighborRoutesListRequest, opts ...grpc.CallOption) (*SitePluginNeighborRoutesListResponse, error) {
+	out := new(SitePluginNeighborRoutesListResponse)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/PluginNeighborRoutesList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) UserPersist(ctx context.Context, in *SiteUserPersistRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/UserPersist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) UserLogin(ctx context.Context, in *SiteUserLoginRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/UserLogin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) AuthenticatedUser(ctx context.Context, in *SiteAuthenticatedUserRequest, opts ...grpc.CallOption) (*SiteAuthenticatedUserResponse, error) {
+	out := new(SiteAuthenticatedUserResponse)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/AuthenticatedUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) UserLogout(ctx context.Context, in *SiteUserLogoutRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/UserLogout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) LogoutAllUsers(ctx context.Context, in *SiteLogoutAllUsersRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/LogoutAllUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) UserSessions(ctx context.Context, in *SiteUserSessionsRequest, opts ...grpc.CallOption) (*SiteUserSessionsResponse, error) {
+	out := new(SiteUserSessionsResponse)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/UserSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) LogoutUser(ctx context.Context, in *SiteLogoutUserRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/LogoutUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) SetCSRF(ctx context.Context, in *SiteSetCSRFRequest, opts ...grpc.CallOption) (*SiteSetCSRFResponse, error) {
+	out := new(SiteSetCSRFResponse)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/SetCSRF", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) CSRF(ctx context.Context, in *SiteCSRFRequest, opts ...grpc.CallOption) (*SiteCSRFResponse, error) {
+	out := new(SiteCSRFResponse)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/CSRF", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) SessionValue(ctx context.Context, in *SiteSessionValueRequest, opts ...grpc.CallOption) (*SiteSessionValueResponse, error) {
+	out := new(SiteSessionValueResponse)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/SessionValue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) SetSessionValue(ctx context.Context, in *SiteSetSessionValueRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/SetSessionValue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) DeleteSessionValue(ctx context.Context, in *SiteDeleteSessionValueRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/DeleteSessionValue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) PluginNeighborSettingsList(ctx context.Context, in *SitePluginNeighborSettingsListRequest, opts ...grpc.CallOption) (*SitePluginNeighborSettingsListResponse, error) {
+	out := new(SitePluginNeighborSettingsListResponse)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/PluginNeighborSettingsList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) SetPluginSetting(ctx context.Context, in *SiteSetPluginSettingRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/SetPluginSetting", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) PluginSettingBool(ctx context.Context, in *SitePluginSettingBoolRequest, opts ...grpc.CallOption) (*SitePluginSettingBoolResponse, error) {
+	out := new(SitePluginSettingBoolResponse)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/PluginSettingBool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) PluginSettingString(ctx context.Context, in *SitePluginSettingStringRequest, opts ...grpc.CallOption) (*SitePluginSettingStringResponse, error) {
+	out := new(SitePluginSettingStringResponse)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/PluginSettingString", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) PluginSetting(ctx context.Context, in *SitePluginSettingRequest, opts ...grpc.CallOption) (*SitePluginSettingResponse, error) {
+	out := new(SitePluginSettingResponse)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/PluginSetting", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) SetNeighborPluginSetting(ctx context.Context, in *SiteSetNeighborPluginSettingRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/SetNeighborPluginSetting", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) NeighborPluginSettingString(ctx context.Context, in *SiteNeighborPluginSettingStringRequest, opts ...grpc.CallOption) (*SiteNeighborPluginSettingStringResponse, error) {
+	out := new(SiteNeighborPluginSettingStringResponse)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/NeighborPluginSettingString", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) NeighborPluginSetting(ctx context.Context, in *SiteNeighborPluginSettingRequest, opts ...grpc.CallOption) (*SiteNeighborPluginSettingResponse, error) {
+	out := new(SiteNeighborPluginSettingResponse)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/NeighborPluginSetting", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) PluginTrusted(ctx context.Context, in *SitePluginTrustedRequest, opts ...grpc.CallOption) (*SitePluginTrustedResponse, error) {
+	out := new(SitePluginTrustedResponse)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/PluginTrusted", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) SetTitle(ctx context.Context, in *SiteSetTitleRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/SetTitle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) Title(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SiteTitleResponse, error) {
+	out := new(SiteTitleResponse)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/Title", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) SetScheme(ctx context.Context, in *SiteSetSchemeRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/SetScheme", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) Scheme(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SiteSchemeResponse, error) {
+	out := new(SiteSchemeResponse)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/Scheme", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) SetURL(ctx context.Context, in *SiteSetURLRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/SetURL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) URL(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SiteURLResponse, error) {
+	out := new(SiteURLResponse)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/URL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) FullURL(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SiteFullURLResponse, error) {
+	out := new(SiteFullURLResponse)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/FullURL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) Updated(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SiteUpdatedResponse, error) {
+	out := new(SiteUpdatedResponse)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/Updated", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) SetContent(ctx context.Context, in *SiteSetContentRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/SetContent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) Content(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SiteContentResponse, error) {
+	out := new(SiteContentResponse)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/Content", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) Tags(ctx context.Context, in *SiteTagsRequest, opts ...grpc.CallOption) (*SiteTagsResponse, error) {
+	out := new(SiteTagsResponse)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/Tags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) TagDetails(ctx context.Context, in *SiteTagsRequest, opts ...grpc.CallOption) (*SiteTagDetailsResponse, error) {
+	out := new(SiteTagDetailsResponse)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/TagDetails", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) TagBySlug(ctx context.Context, in *SiteTagBySlugRequest, opts ...grpc.CallOption) (*SiteTagBySlugResponse, error) {
+	out := new(SiteTagBySlugResponse)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/TagBySlug", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) RenameTag(ctx context.Context, in *SiteRenameTagRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/RenameTag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) MergeTags(ctx context.Context, in *SiteMergeTagsRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/MergeTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) DeleteTag(ctx context.Context, in *SiteDeleteTagRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/DeleteTag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) SetTagMeta(ctx context.Context, in *SiteSetTagMetaRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/SetTagMeta", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) Snapshots(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SiteSnapshotsResponse, error) {
+	out := new(SiteSnapshotsResponse)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/Snapshots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) CreateSnapshot(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SiteCreateSnapshotResponse, error) {
+	out := new(SiteCreateSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/CreateSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) SnapshotDiff(ctx context.Context, in *SiteSnapshotDiffRequest, opts ...grpc.CallOption) (*SiteSnapshotDiffResponse, error) {
+	out := new(SiteSnapshotDiffResponse)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/SnapshotDiff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) RestoreSnapshot(ctx context.Context, in *SiteRestoreSnapshotRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/RestoreSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) PluginStoreGet(ctx context.Context, in *SitePluginStoreGetRequest, opts ...grpc.CallOption) (*SitePluginStoreGetResponse, error) {
+	out := new(SitePluginStoreGetResponse)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/PluginStoreGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) PluginStorePut(ctx context.Context, in *SitePluginStorePutRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/PluginStorePut", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) PluginStoreDelete(ctx context.Context, in *SitePluginStoreDeleteRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/PluginStoreDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) PluginStoreList(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SitePluginStoreListResponse, error) {
+	out := new(SitePluginStoreListResponse)
+	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/PluginStoreList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *siteClient) Subscribe(ctx context.Context, in *Empty, opts ...grpc.CallOption) (Site_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Site_serviceDesc.Streams[0], "/ambient.protodef.Site/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &siteSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConnInterface
+type Site_SubscribeClient interface {
+	Recv() (*SiteEvent, error)
+	grpc.ClientStream
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion6
+type siteSubscribeClient struct {
+	grpc.ClientStream
+}
 
-// SiteClient is the client API for Site service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type SiteClient interface {
-	Load(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
-	LoadSinglePluginPages(ctx context.Context, in *SiteLoadSinglePluginPagesRequest, opts ...grpc.CallOption) (*Empty, error)
-	Authorized(ctx context.Context, in *SiteAuthorizedRequest, opts ...grpc.CallOption) (*SiteAuthorizedResponse, error)
-	NeighborPluginGrantList(ctx context.Context, in *SiteNeighborPluginGrantListRequest, opts ...grpc.CallOption) (*SiteNeighborPluginGrantListResponse, error)
-	NeighborPluginGrants(ctx context.Context, in *SiteNeighborPluginGrantsRequest, opts ...grpc.CallOption) (*SiteNeighborPluginGrantsResponse, error)
-	NeighborPluginGranted(ctx context.Context, in *SiteNeighborPluginGrantedRequest, opts ...grpc.CallOption) (*SiteNeighborPluginGrantedResponse, error)
-	NeighborPluginRequestedGrant(ctx context.Context, in *SiteNeighborPluginRequestedGrantRequest, opts ...grpc.CallOption) (*SiteNeighborPluginRequestedGrantResponse, error)
-	SetNeighborPluginGrant(ctx context.Context, in *SiteSetNeighborPluginGrantRequest, opts ...grpc.CallOption) (*Empty, error)
-	Plugins(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SitePluginsResponse, error)
-	PluginNames(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SitePluginNamesResponse, error)
-	DeletePlugin(ctx context.Context, in *SiteDeletePluginRequest, opts ...grpc.CallOption) (*Empty, error)
-	EnablePlugin(ctx context.Context, in *SiteEnablePluginRequest, opts ...grpc.CallOption) (*Empty, error)
-	DisablePlugin(ctx context.Context, in *SiteDisablePluginRequest, opts ...grpc.CallOption) (*Empty, error)
-	SavePost(ctx context.Context, in *SiteSavePostRequest, opts ...grpc.CallOption) (*Empty, error)
-	PostsAndPages(ctx context.Context, in *SitePostsAndPagesRequest, opts ...grpc.CallOption) (*SitePostsAndPagesResponse, error)
-	PublishedPosts(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SitePublishedPostsResponse, error)
-	PublishedPages(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SitePublishedPagesResponse, error)
-	PostBySlug(ctx context.Context, in *SitePostBySlugRequest, opts ...grpc.CallOption) (*SitePostBySlugResponse, error)
-	PostByID(ctx context.Context, in *SitePostByIDRequest, opts ...grpc.CallOption) (*SitePostByIDResponse, error)
-	DeletePostByID(ctx context.Context, in *SiteDeletePostByIDRequest, opts ...grpc.CallOption) (*Empty, error)
-	PluginNeighborRoutesList(ctx context.Context, in *SitePluginNeighborRoutesListRequest, opts ...grpc.CallOption) (*SitePluginNeighborRoutesListResponse, error)
-	UserPersist(ctx context.Context, in *SiteUserPersistRequest, opts ...grpc.CallOption) (*Empty, error)
-	UserLogin(ctx context.Context, in *SiteUserLoginRequest, opts ...grpc.CallOption) (*Empty, error)
-	AuthenticatedUser(ctx context.Context, in *SiteAuthenticatedUserRequest, opts ...grpc.CallOption) (*SiteAuthenticatedUserResponse, error)
-	UserLogout(ctx context.Context, in *SiteUserLogoutRequest, opts ...grpc.CallOption) (*Empty, error)
-	LogoutAllUsers(ctx context.Context, in *SiteLogoutAllUsersRequest, opts ...grpc.CallOption) (*Empty, error)
-	SetCSRF(ctx context.Context, in *SiteSetCSRFRequest, opts ...grpc.CallOption) (*SiteSetCSRFResponse, error)
-	CSRF(ctx context.Context, in *SiteCSRFRequest, opts ...grpc.CallOption) (*SiteCSRFResponse, error)
-	SessionValue(ctx context.Context, in *SiteSessionValueRequest, opts ...grpc.CallOption) (*SiteSessionValueResponse, error)
-	SetSessionValue(ctx context.Context, in *SiteSetSessionValueRequest, opts ...grpc.CallOption) (*Empty, error)
-	DeleteSessionValue(ctx context.Context, in *SiteDeleteSessionValueRequest, opts ...grpc.CallOption) (*Empty, error)
-	PluginNeighborSettingsList(ctx context.Context, in *SitePluginNeighborSettingsListRequest, opts ...grpc.CallOption) (*SitePluginNeighborSettingsListResponse, error)
-	SetPluginSetting(ctx context.Context, in *SiteSetPluginSettingRequest, opts ...grpc.CallOption) (*Empty, error)
-	PluginSettingBool(ctx context.Context, in *SitePluginSettingBoolRequest, opts ...grpc.CallOption) (*SitePluginSettingBoolResponse, error)
-	PluginSettingString(ctx context.Context, in *SitePluginSettingStringRequest, opts ...grpc.CallOption) (*SitePluginSettingStringResponse, error)
-	PluginSetting(ctx context.Context, in *SitePluginSettingRequest, opts ...grpc.CallOption) (*SitePluginSettingResponse, error)
-	SetNeighborPluginSetting(ctx context.Context, in *SiteSetNeighborPluginSettingRequest, opts ...grpc.CallOption) (*Empty, error)
-	NeighborPluginSettingString(ctx context.Context, in *SiteNeighborPluginSettingStringRequest, opts ...grpc.CallOption) (*SiteNeighborPluginSettingStringResponse, error)
-	NeighborPluginSetting(ctx context.Context, in *SiteNeighborPluginSettingRequest, opts ...grpc.CallOption) (*SiteNeighborPluginSettingResponse, error)
-	PluginTrusted(ctx context.Context, in *SitePluginTrustedRequest, opts ...grpc.CallOption) (*SitePluginTrustedResponse, error)
-	SetTitle(ctx context.Context, in *SiteSetTitleRequest, opts ...grpc.CallOption) (*Empty, error)
-	Title(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SiteTitleResponse, error)
-	SetScheme(ctx context.Context, in *SiteSetSchemeRequest, opts ...grpc.CallOption) (*Empty, error)
-	Scheme(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SiteSchemeResponse, error)
-	SetURL(ctx context.Context, in *SiteSetURLRequest, opts ...grpc.CallOption) (*Empty, error)
-	URL(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SiteURLResponse, error)
-	FullURL(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SiteFullURLResponse, error)
-	Updated(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SiteUpdatedResponse, error)
-	SetContent(ctx context.Context, in *SiteSetContentRequest, opts ...grpc.CallOption) (*Empty, error)
-	Content(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SiteContentResponse, error)
-	Tags(ctx context.Context, in *SiteTagsRequest, opts ...grpc.CallOption) (*SiteTagsResponse, error)
+func (x *siteSubscribeClient) Recv() (*SiteEvent, error) {
+	m := new(SiteEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
-type siteClient struct {
-	cc grpc.ClientConnInterface
+// SiteServer is the server API for Site service.
+type SiteServer interface {
+	Load(context.Context, *Empty) (*Empty, error)
+	LoadSinglePluginPages(context.Context, *SiteLoadSinglePluginPagesRequest) (*Empty, error)
+	Authorized(context.Context, *SiteAuthorizedRequest) (*SiteAuthorizedResponse, error)
+	NeighborPluginGrantList(context.Context, *SiteNeighborPluginGrantListRequest) (*SiteNeighborPluginGrantListResponse, error)
+	NeighborPluginGrants(context.Context, *SiteNeighborPluginGrantsRequest) (*SiteNeighborPluginGrantsResponse, error)
+	NeighborPluginGranted(context.Context, *SiteNeighborPluginGrantedRequest) (*SiteNeighborPluginGrantedResponse, error)
+	NeighborPluginRequestedGrant(context.Context, *SiteNeighborPluginRequestedGrantRequest) (*SiteNeighborPluginRequestedGrantResponse, error)
+	SetNeighborPluginGrant(context.Context, *SiteSetNeighborPluginGrantRequest) (*Empty, error)
+	Plugins(context.Context, *Empty) (*SitePluginsResponse, error)
+	PluginNames(context.Context, *Empty) (*SitePluginNamesResponse, error)
+	DeletePlugin(context.Context, *SiteDeletePluginRequest) (*Empty, error)
+	EnablePlugin(context.Context, *SiteEnablePluginRequest) (*Empty, error)
+	DisablePlugin(context.Context, *SiteDisablePluginRequest) (*Empty, error)
+	SavePost(context.Context, *SiteSavePostRequest) (*Empty, error)
+	PostsAndPages(context.Context, *SitePostsAndPagesRequest) (*SitePostsAndPagesResponse, error)
+	PublishedPosts(context.Context, *Empty) (*SitePublishedPostsResponse, error)
+	PublishedPages(context.Context, *Empty) (*SitePublishedPagesResponse, error)
+	PostBySlug(context.Context, *SitePostBySlugRequest) (*SitePostBySlugResponse, error)
+	SearchPosts(context.Context, *SiteSearchPostsRequest) (*SiteSearchPostsResponse, error)
+	QueryPosts(context.Context, *SiteQueryPostsRequest) (*SiteQueryPostsResponse, error)
+	ContentTypes(context.Context, *Empty) (*SiteContentTypesResponse, error)
+	PostByID(context.Context, *SitePostByIDRequest) (*SitePostByIDResponse, error)
+	DeletePostByID(context.Context, *SiteDeletePostByIDRequest) (*Empty, error)
+	SavePostAs(context.Context, *SiteSavePostAsRequest) (*Empty, error)
+	PostRevisions(context.Context, *SitePostRevisionsRequest) (*SitePostRevisionsResponse, error)
+	PostRevision(context.Context, *SitePostRevisionRequest) (*SitePostRevisionResponse, error)
+	PostRevisionDiff(context.Context, *SitePostRevisionRequest) (*SitePostRevisionDiffResponse, error)
+	RestorePostRevision(context.Context, *SiteRestorePostRevisionRequest) (*Empty, error)
+	CreatePostPreview(context.Context, *SiteCreatePostPreviewRequest) (*SiteCreatePostPreviewResponse, error)
+	PostPreviews(context.Context, *SitePostPreviewsRequest) (*SitePostPreviewsResponse, error)
+	RevokePostPreview(context.Context, *SiteRevokePostPreviewRequest) (*Empty, error)
+	PreviewPostBySlug(context.Context, *SitePreviewPostBySlugRequest) (*SitePostBySlugResponse, error)
+	PageChildren(context.Context, *SitePageChildrenRequest) (*SitePostsAndPagesResponse, error)
+	Breadcrumbs(context.Context, *SiteBreadcrumbsRequest) (*SitePostsAndPagesResponse, error)
+	Menus(context.Context, *Empty) (*SiteMenusResponse, error)
+	Menu(context.Context, *SiteMenuRequest) (*SiteMenuResponse, error)
+	SaveMenu(context.Context, *SiteSaveMenuRequest) (*Empty, error)
+	DeleteMenu(context.Context, *SiteDeleteMenuRequest) (*Empty, error)
+	PluginNeighborRoutesList(context.Context, *SitePluginNeighborRoutesListRequest) (*SitePluginNeighborRoutesListResponse, error)
+	UserPersist(context.Context, *SiteUserPersistRequest) (*Empty, error)
+	UserLogin(context.Context, *SiteUserLoginRequest) (*Empty, error)
+	AuthenticatedUser(context.Context, *SiteAuthenticatedUserRequest) (*SiteAuthenticatedUserResponse, error)
+	UserLogout(context.Context, *SiteUserLogoutRequest) (*Empty, error)
+	LogoutAllUsers(context.Context, *SiteLogoutAllUsersRequest) (*Empty, error)
+	UserSessions(context.Context, *SiteUserSessionsRequest) (*SiteUserSessionsResponse, error)
+	LogoutUser(context.Context, *SiteLogoutUserRequest) (*Empty, error)
+	SetCSRF(context.Context, *SiteSetCSRFRequest) (*SiteSetCSRFResponse, error)
+	CSRF(context.Context, *SiteCSRFRequest) (*SiteCSRFResponse, error)
+	SessionValue(context.Context, *SiteSessionValueRequest) (*SiteSessionValueResponse, error)
+	SetSessionValue(context.Context, *SiteSetSessionValueRequest) (*Empty, error)
+	DeleteSessionValue(context.Context, *SiteDeleteSessionValueRequest) (*Empty, error)
+	PluginNeighborSettingsList(context.Context, *SitePluginNeighborSettingsListRequest) (*SitePluginNeighborSettingsListResponse, error)
+	SetPluginSetting(context.Context, *SiteSetPluginSettingRequest) (*Empty, error)
+	PluginSettingBool(context.Context, *SitePluginSettingBoolRequest) (*SitePluginSettingBoolResponse, error)
+	PluginSettingString(context.Context, *SitePluginSettingStringRequest) (*SitePluginSettingStringResponse, error)
+	PluginSetting(context.Context, *SitePluginSettingRequest) (*SitePluginSettingResponse, error)
+	SetNeighborPluginSetting(context.Context, *SiteSetNeighborPluginSettingRequest) (*Empty, error)
+	NeighborPluginSettingString(context.Context, *SiteNeighborPluginSettingStringRequest) (*SiteNeighborPluginSettingStringResponse, error)
+	NeighborPluginSetting(context.Context, *SiteNeighborPluginSettingRequest) (*SiteNeighborPluginSettingResponse, error)
+	PluginTrusted(context.Context, *SitePluginTrustedRequest) (*SitePluginTrustedResponse, error)
+	SetTitle(context.Context, *SiteSetTitleRequest) (*Empty, error)
+	Title(context.Context, *Empty) (*SiteTitleResponse, error)
+	SetScheme(context.Context, *SiteSetSchemeRequest) (*Empty, error)
+	Scheme(context.Context, *Empty) (*SiteSchemeResponse, error)
+	SetURL(context.Context, *SiteSetURLRequest) (*Empty, error)
+	URL(context.Context, *Empty) (*SiteURLResponse, error)
+	FullURL(context.Context, *Empty) (*SiteFullURLResponse, error)
+	Updated(context.Context, *Empty) (*SiteUpdatedResponse, error)
+	SetContent(context.Context, *SiteSetContentRequest) (*Empty, error)
+	Content(context.Context, *Empty) (*SiteContentResponse, error)
+	Tags(context.Context, *SiteTagsRequest) (*SiteTagsResponse, error)
+	TagDetails(context.Context, *SiteTagsRequest) (*SiteTagDetailsResponse, error)
+	TagBySlug(context.Context, *SiteTagBySlugRequest) (*SiteTagBySlugResponse, error)
+	RenameTag(context.Context, *SiteRenameTagRequest) (*Empty, error)
+	MergeTags(context.Context, *SiteMergeTagsRequest) (*Empty, error)
+	DeleteTag(context.Context, *SiteDeleteTagRequest) (*Empty, error)
+	SetTagMeta(context.Context, *SiteSetTagMetaRequest) (*Empty, error)
+	Snapshots(context.Context, *Empty) (*SiteSnapshotsResponse, error)
+	CreateSnapshot(context.Context, *Empty) (*SiteCreateSnapshotResponse, error)
+	SnapshotDiff(context.Context, *SiteSnapshotDiffRequest) (*SiteSnapshotDiffResponse, error)
+	RestoreSnapshot(context.Context, *SiteRestoreSnapshotRequest) (*Empty, error)
+	PluginStoreGet(context.Context, *SitePluginStoreGetRequest) (*SitePluginStoreGetResponse, error)
+	PluginStorePut(context.Context, *SitePluginStorePutRequest) (*Empty, error)
+	PluginStoreDelete(context.Context, *SitePluginStoreDeleteRequest) (*Empty, error)
+	PluginStoreList(context.Context, *Empty) (*SitePluginStoreListResponse, error)
+	Subscribe(*Empty, Site_SubscribeServer) error
+}
+
+// UnimplementedSiteServer can be embedded to have forward compatible implementations.
+type UnimplementedSiteServer struct {
+}
+
+func (*UnimplementedSiteServer) Load(context.Context, *Empty) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Load not implemented")
+}
+func (*UnimplementedSiteServer) LoadSinglePluginPages(context.Context, *SiteLoadSinglePluginPagesRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoadSinglePluginPages not implemented")
+}
+func (*UnimplementedSiteServer) Authorized(context.Context, *SiteAuthorizedRequest) (*SiteAuthorizedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Authorized not implemented")
+}
+func (*UnimplementedSiteServer) NeighborPluginGrantList(context.Context, *SiteNeighborPluginGrantListRequest) (*SiteNeighborPluginGrantListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NeighborPluginGrantList not implemented")
+}
+func (*UnimplementedSiteServer) NeighborPluginGrants(context.Context, *SiteNeighborPluginGrantsRequest) (*SiteNeighborPluginGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NeighborPluginGrants not implemented")
+}
+func (*UnimplementedSiteServer) NeighborPluginGranted(context.Context, *SiteNeighborPluginGrantedRequest) (*SiteNeighborPluginGrantedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NeighborPluginGranted not implemented")
+}
+func (*UnimplementedSiteServer) NeighborPluginRequestedGrant(context.Context, *SiteNeighborPluginRequestedGrantRequest) (*SiteNeighborPluginRequestedGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NeighborPluginRequestedGrant not implemented")
+}
+func (*UnimplementedSiteServer) SetNeighborPluginGrant(context.Context, *SiteSetNeighborPluginGrantRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNeighborPluginGrant not implemented")
+}
+func (*UnimplementedSiteServer) Plugins(context.Context, *Empty) (*SitePluginsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Plugins not implemented")
+}
+func (*UnimplementedSiteServer) PluginNames(context.Context, *Empty) (*SitePluginNamesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PluginNames not implemented")
+}
+func (*UnimplementedSiteServer) DeletePlugin(context.Context, *SiteDeletePluginRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePlugin not implemented")
+}
+func (*UnimplementedSiteServer) EnablePlugin(context.Context, *SiteEnablePluginRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnablePlugin not implemented")
+}
+func (*UnimplementedSiteServer) DisablePlugin(context.Context, *SiteDisablePluginRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisablePlugin not implemented")
+}
+func (*UnimplementedSiteServer) SavePost(context.Context, *SiteSavePostRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SavePost not implemented")
+}
+func (*UnimplementedSiteServer) PostsAndPages(context.Context, *SitePostsAndPagesRequest) (*SitePostsAndPagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostsAndPages not implemented")
+}
+func (*UnimplementedSiteServer) PublishedPosts(context.Context, *Empty) (*SitePublishedPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishedPosts not implemented")
+}
+func (*UnimplementedSiteServer) PublishedPages(context.Context, *Empty) (*SitePublishedPagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishedPages not implemented")
+}
+func (*UnimplementedSiteServer) PostBySlug(context.Context, *SitePostBySlugRequest) (*SitePostBySlugResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostBySlug not implemented")
+}
+func (*UnimplementedSiteServer) SearchPosts(context.Context, *SiteSearchPostsRequest) (*SiteSearchPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchPosts not implemented")
+}
+func (*UnimplementedSiteServer) QueryPosts(context.Context, *SiteQueryPostsRequest) (*SiteQueryPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPosts not implemented")
+}
+func (*UnimplementedSiteServer) ContentTypes(context.Context, *Empty) (*SiteContentTypesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContentTypes not implemented")
+}
+func (*UnimplementedSiteServer) PostByID(context.Context, *SitePostByIDRequest) (*SitePostByIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostByID not implemented")
+}
+func (*UnimplementedSiteServer) DeletePostByID(context.Context, *SiteDeletePostByIDRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePostByID not implemented")
+}
+func (*UnimplementedSiteServer) SavePostAs(context.Context, *SiteSavePostAsRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SavePostAs not implemented")
+}
+func (*UnimplementedSiteServer) PostRevisions(context.Context, *SitePostRevisionsRequest) (*SitePostRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostRevisions not implemented")
+}
+func (*UnimplementedSiteServer) PostRevision(context.Context, *SitePostRevisionRequest) (*SitePostRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostRevision not implemented")
+}
+func (*UnimplementedSiteServer) PostRevisionDiff(context.Context, *SitePostRevisionRequest) (*SitePostRevisionDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostRevisionDiff not implemented")
+}
+func (*UnimplementedSiteServer) RestorePostRevision(context.Context, *SiteRestorePostRevisionRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestorePostRevision not implemented")
+}
+func (*UnimplementedSiteServer) CreatePostPreview(context.Context, *SiteCreatePostPreviewRequest) (*SiteCreatePostPreviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePostPreview not implemented")
+}
+func (*UnimplementedSiteServer) PostPreviews(context.Context, *SitePostPreviewsRequest) (*SitePostPreviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostPreviews not implemented")
+}
+func (*UnimplementedSiteServer) RevokePostPreview(context.Context, *SiteRevokePostPreviewRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokePostPreview not implemented")
+}
+func (*UnimplementedSiteServer) PreviewPostBySlug(context.Context, *SitePreviewPostBySlugRequest) (*SitePostBySlugResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewPostBySlug not implemented")
+}
+func (*UnimplementedSiteServer) PageChildren(context.Context, *SitePageChildrenRequest) (*SitePostsAndPagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PageChildren not implemented")
+}
+func (*UnimplementedSiteServer) Breadcrumbs(context.Context, *SiteBreadcrumbsRequest) (*SitePostsAndPagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Breadcrumbs not implemented")
+}
+func (*UnimplementedSiteServer) Menus(context.Context, *Empty) (*SiteMenusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Menus not implemented")
+}
+func (*UnimplementedSiteServer) Menu(context.Context, *SiteMenuRequest) (*SiteMenuResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Menu not implemented")
+}
+func (*UnimplementedSiteServer) SaveMenu(context.Context, *SiteSaveMenuRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveMenu not implemented")
+}
+func (*UnimplementedSiteServer) DeleteMenu(context.Context, *SiteDeleteMenuRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMenu not implemented")
+}
+func (*UnimplementedSiteServer) PluginNeighborRoutesList(context.Context, *SitePluginNeighborRoutesListRequest) (*SitePluginNeighborRoutesListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PluginNeighborRoutesList not implemented")
+}
+func (*UnimplementedSiteServer) UserPersist(context.Context, *SiteUserPersistRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserPersist not implemented")
+}
+func (*UnimplementedSiteServer) UserLogin(context.Context, *SiteUserLoginRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserLogin not implemented")
+}
+func (*UnimplementedSiteServer) AuthenticatedUser(context.Context, *SiteAuthenticatedUserRequest) (*SiteAuthenticatedUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthenticatedUser not implemented")
+}
+func (*UnimplementedSiteServer) UserLogout(context.Context, *SiteUserLogoutRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserLogout not implemented")
+}
+func (*UnimplementedSiteServer) LogoutAllUsers(context.Context, *SiteLogoutAllUsersRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogoutAllUsers not implemented")
+}
+func (*UnimplementedSiteServer) UserSessions(context.Context, *SiteUserSessionsRequest) (*SiteUserSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserSessions not implemented")
+}
+func (*UnimplementedSiteServer) LogoutUser(context.Context, *SiteLogoutUserRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogoutUser not implemented")
+}
+func (*UnimplementedSiteServer) SetCSRF(context.Context, *SiteSetCSRFRequest) (*SiteSetCSRFResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCSRF not implemented")
+}
+func (*UnimplementedSiteServer) CSRF(context.Context, *SiteCSRFRequest) (*SiteCSRFResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CSRF not implemented")
+}
+func (*UnimplementedSiteServer) SessionValue(context.Context, *SiteSessionValueRequest) (*SiteSessionValueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SessionValue not implemented")
+}
+func (*UnimplementedSiteServer) SetSessionValue(context.Context, *SiteSetSessionValueRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSessionValue not implemented")
+}
+func (*UnimplementedSiteServer) DeleteSessionValue(context.Context, *SiteDeleteSessionValueRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSessionValue not implemented")
+}
+func (*UnimplementedSiteServer) PluginNeighborSettingsList(context.Context, *SitePluginNeighborSettingsListRequest) (*SitePluginNeighborSettingsListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PluginNeighborSettingsList not implemented")
+}
+func (*UnimplementedSiteServer) SetPluginSetting(context.Context, *SiteSetPluginSettingRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPluginSetting not implemented")
+}
+func (*UnimplementedSiteServer) PluginSettingBool(context.Context, *SitePluginSettingBoolRequest) (*SitePluginSettingBoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PluginSettingBool not implemented")
+}
+func (*UnimplementedSiteServer) PluginSettingString(context.Context, *SitePluginSettingStringRequest) (*SitePluginSettingStringResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PluginSettingString not implemented")
+}
+func (*UnimplementedSiteServer) PluginSetting(context.Context, *SitePluginSettingRequest) (*SitePluginSettingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PluginSetting not implemented")
+}
+func (*UnimplementedSiteServer) SetNeighborPluginSetting(context.Context, *SiteSetNeighborPluginSettingRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNeighborPluginSetting not implemented")
 }
-
-func NewSiteClient(cc grpc.ClientConnInterface) SiteClient {
-	return &siteClient{cc}
+func (*UnimplementedSiteServer) NeighborPluginSettingString(context.Context, *SiteNeighborPluginSettingStringRequest) (*SiteNeighborPluginSettingStringResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NeighborPluginSettingString not implemented")
 }
-
-func (c *siteClient) Load(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
-	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/Load", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (*UnimplementedSiteServer) NeighborPluginSetting(context.Context, *SiteNeighborPluginSettingRequest) (*SiteNeighborPluginSettingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NeighborPluginSetting not implemented")
 }
-
-func (c *siteClient) LoadSinglePluginPages(ctx context.Context, in *SiteLoadSinglePluginPagesRequest, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
-	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/LoadSinglePluginPages", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (*UnimplementedSiteServer) PluginTrusted(context.Context, *SitePluginTrustedRequest) (*SitePluginTrustedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PluginTrusted not implemented")
 }
-
-func (c *siteClient) Authorized(ctx context.Context, in *SiteAuthorizedRequest, opts ...grpc.CallOption) (*SiteAuthorizedResponse, error) {
-	out := new(SiteAuthorizedResponse)
-	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/Authorized", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (*UnimplementedSiteServer) SetTitle(context.Context, *SiteSetTitleRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTitle not implemented")
 }
-
-func (c *siteClient) NeighborPluginGrantList(ctx context.Context, in *SiteNeighborPluginGrantListRequest, opts ...grpc.CallOption) (*SiteNeighborPluginGrantListResponse, error) {
-	out := new(SiteNeighborPluginGrantListResponse)
-	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/NeighborPluginGrantList", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (*UnimplementedSiteServer) Title(context.Context, *Empty) (*SiteTitleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Title not implemented")
 }
-
-func (c *siteClient) NeighborPluginGrants(ctx context.Context, in *SiteNeighborPluginGrantsRequest, opts ...grpc.CallOption) (*SiteNeighborPluginGrantsResponse, error) {
-	out := new(SiteNeighborPluginGrantsResponse)
-	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/NeighborPluginGrants", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (*UnimplementedSiteServer) SetScheme(context.Context, *SiteSetSchemeRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetScheme not implemented")
 }
-
-func (c *siteClient) NeighborPluginGranted(ctx context.Context, in *SiteNeighborPluginGrantedRequest, opts ...grpc.CallOption) (*SiteNeighborPluginGrantedResponse, error) {
-	out := new(SiteNeighborPluginGrantedResponse)
-	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/NeighborPluginGranted", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (*UnimplementedSiteServer) Scheme(context.Context, *Empty) (*SiteSchemeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Scheme not implemented")
 }
-
-func (c *siteClient) NeighborPluginRequestedGrant(ctx context.Context, in *SiteNeighborPluginRequestedGrantRequest, opts ...grpc.CallOption) (*SiteNeighborPluginRequestedGrantResponse, error) {
-	out := new(SiteNeighborPluginRequestedGrantResponse)
-	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/NeighborPluginRequestedGrant", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (*UnimplementedSiteServer) SetURL(context.Context, *SiteSetURLRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetURL not implemented")
 }
-
-func (c *siteClient) SetNeighborPluginGrant(ctx context.Context, in *SiteSetNeighborPluginGrantRequest, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
-	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/SetNeighborPluginGrant", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (*UnimplementedSiteServer) URL(context.Context, *Empty) (*SiteURLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method URL not implemented")
 }
-
-func (c *siteClient) Plugins(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SitePluginsResponse, error) {
-	out := new(SitePluginsResponse)
-	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/Plugins", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (*UnimplementedSiteServer) FullURL(context.Context, *Empty) (*SiteFullURLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FullURL not implemented")
 }
-
-func (c *siteClient) PluginNames(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SitePluginNamesResponse, error) {
-	out := new(SitePluginNamesResponse)
-	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/PluginNames", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (*UnimplementedSiteServer) Updated(context.Context, *Empty) (*SiteUpdatedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Updated not implemented")
 }
-
-func (c *siteClient) DeletePlugin(ctx context.Context, in *SiteDeletePluginRequest, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
-	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/DeletePlugin", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (*UnimplementedSiteServer) SetContent(context.Context, *SiteSetContentRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetContent not implemented")
 }
-
-func (c *siteClient) EnablePlugin(ctx context.Context, in *SiteEnablePluginRequest, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
-	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/EnablePlugin", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (*UnimplementedSiteServer) Content(context.Context, *Empty) (*SiteContentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Content not implemented")
 }
-
-func (c *siteClient) DisablePlugin(ctx context.Context, in *SiteDisablePluginRequest, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
-	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/DisablePlugin", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (*UnimplementedSiteServer) Tags(context.Context, *SiteTagsRequest) (*SiteTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Tags not implemented")
 }
-
-func (c *siteClient) SavePost(ctx context.Context, in *SiteSavePostRequest, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
-	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/SavePost", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (*UnimplementedSiteServer) TagDetails(context.Context, *SiteTagsRequest) (*SiteTagDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TagDetails not implemented")
 }
-
-func (c *siteClient) PostsAndPages(ctx context.Context, in *SitePostsAndPagesRequest, opts ...grpc.CallOption) (*SitePostsAndPagesResponse, error) {
-	out := new(SitePostsAndPagesResponse)
-	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/PostsAndPages", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (*UnimplementedSiteServer) TagBySlug(context.Context, *SiteTagBySlugRequest) (*SiteTagBySlugResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TagBySlug not implemented")
 }
-
-func (c *siteClient) PublishedPosts(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SitePublishedPostsResponse, error) {
-	out := new(SitePublishedPostsResponse)
-	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/PublishedPosts", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (*UnimplementedSiteServer) RenameTag(context.Context, *SiteRenameTagRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameTag not implemented")
 }
-
-func (c *siteClient) PublishedPages(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SitePublishedPagesResponse, error) {
-	out := new(SitePublishedPagesResponse)
-	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/PublishedPages", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (*UnimplementedSiteServer) MergeTags(context.Context, *SiteMergeTagsRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeTags not implemented")
 }
-
-func (c *siteClient) PostBySlug(ctx context.Context, in *SitePostBySlugRequest, opts ...grpc.CallOption) (*SitePostBySlugResponse, error) {
-	out := new(SitePostBySlugResponse)
-	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/PostBySlug", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (*UnimplementedSiteServer) DeleteTag(context.Context, *SiteDeleteTagRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTag not implemented")
 }
-
-func (c *siteClient) PostByID(ctx context.Context, in *SitePostByIDRequest, opts ...grpc.CallOption) (*SitePostByIDResponse, error) {
-	out := new(SitePostByIDResponse)
-	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/PostByID", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (*UnimplementedSiteServer) SetTagMeta(context.Context, *SiteSetTagMetaRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTagMeta not implemented")
 }
-
-func (c *siteClient) DeletePostByID(ctx context.Context, in *SiteDeletePostByIDRequest, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
-	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/DeletePostByID", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (*UnimplementedSiteServer) Snapshots(context.Context, *Empty) (*SiteSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Snapshots not implemented")
 }
-
-func (c *siteClient) PluginNeighborRoutesList(ctx context.Context, in *SitePluginNeighborRoutesListRequest, opts ...grpc.CallOption) (*SitePluginNeighborRoutesListResponse, error) {
-	out := new(SitePluginNeighborRoutesListResponse)
-	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/PluginNeighborRoutesList", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (*UnimplementedSiteServer) CreateSnapshot(context.Context, *Empty) (*SiteCreateSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSnapshot not implemented")
 }
-
-func (c *siteClient) UserPersist(ctx context.Context, in *SiteUserPersistRequest, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
-	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/UserPersist", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (*UnimplementedSiteServer) SnapshotDiff(context.Context, *SiteSnapshotDiffRequest) (*SiteSnapshotDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SnapshotDiff not implemented")
 }
-
-func (c *siteClient) UserLogin(ctx context.Context, in *SiteUserLoginRequest, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
-	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/UserLogin", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (*UnimplementedSiteServer) RestoreSnapshot(context.Context, *SiteRestoreSnapshotRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreSnapshot not implemented")
 }
-
-func (c *siteClient) AuthenticatedUser(ctx context.Context, in *SiteAuthenticatedUserRequest, opts ...grpc.CallOption) (*SiteAuthenticatedUserResponse, error) {
-	out := new(SiteAuthenticatedUserResponse)
-	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/AuthenticatedUser", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (*UnimplementedSiteServer) PluginStoreGet(context.Context, *SitePluginStoreGetRequest) (*SitePluginStoreGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PluginStoreGet not implemented")
 }
-
-func (c *siteClient) UserLogout(ctx context.Context, in *SiteUserLogoutRequest, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
-	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/UserLogout", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (*UnimplementedSiteServer) PluginStorePut(context.Context, *SitePluginStorePutRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PluginStorePut not implemented")
 }
-
-func (c *siteClient) LogoutAllUsers(ctx context.Context, in *SiteLogoutAllUsersRequest, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
-	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/LogoutAllUsers", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (*UnimplementedSiteServer) PluginStoreDelete(context.Context, *SitePluginStoreDeleteRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PluginStoreDelete not implemented")
 }
-
-func (c *siteClient) SetCSRF(ctx context.Context, in *SiteSetCSRFRequest, opts ...grpc.CallOption) (*SiteSetCSRFResponse, error) {
-	out := new(SiteSetCSRFResponse)
-	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/SetCSRF", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (*UnimplementedSiteServer) PluginStoreList(context.Context, *Empty) (*SitePluginStoreListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PluginStoreList not implemented")
 }
-
-func (c *siteClient) CSRF(ctx context.Context, in *SiteCSRFRequest, opts ...grpc.CallOption) (*SiteCSRFResponse, error) {
-	out := new(SiteCSRFResponse)
-	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/CSRF", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (*UnimplementedSiteServer) Subscribe(*Empty, Site_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
 }
 
-func (c *siteClient) SessionValue(ctx context.Context, in *SiteSessionValueRequest, opts ...grpc.CallOption) (*SiteSessionValueResponse, error) {
-	out := new(SiteSessionValueResponse)
-	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/SessionValue", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func RegisterSiteServer(s *grpc.Server, srv SiteServer) {
+	s.RegisterService(&_Site_serviceDesc, srv)
 }
 
-func (c *siteClient) SetSessionValue(ctx context.Context, in *SiteSetSessionValueRequest, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
-	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/SetSessionValue", in, out, opts...)
-	if err != nil {
+func _Site_Load_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-func (c *siteClient) DeleteSessionValue(ctx context.Context, in *SiteDeleteSessionValueRequest, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
-	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/DeleteSessionValue", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if interceptor == nil {
+		return srv.(SiteServer).Load(ctx, in)
 	}
-	return out, nil
-}
-
-func (c *siteClient) PluginNeighborSettingsList(ctx context.Context, in *SitePluginNeighborSettingsListRequest, opts ...grpc.CallOption) (*SitePluginNeighborSettingsListResponse, error) {
-	out := new(SitePluginNeighborSettingsListResponse)
-	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/PluginNeighborSettingsList", in, out, opts...)
-	if err != nil {
-		return nil, err
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ambient.protodef.Site/Load",
 	}
-	return out, nil
-}
-
-func (c *siteClient) SetPluginSetting(ctx context.Context, in *SiteSetPluginSettingRequest, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
-	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/SetPluginSetting", in, out, opts...)
-	if err != nil {
-		return nil, err
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteServer).Load(ctx, req.(*Empty))
 	}
-	return out, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *siteClient) PluginSettingBool(ctx context.Context, in *SitePluginSettingBoolRequest, opts ...grpc.CallOption) (*SitePluginSettingBoolResponse, error) {
-	out := new(SitePluginSettingBoolResponse)
-	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/PluginSettingBool", in, out, opts...)
-	if err != nil {
+func _Site_LoadSinglePluginPages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SiteLoadSinglePluginPagesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-func (c *siteClient) PluginSettingString(ctx context.Context, in *SitePluginSettingStringRequest, opts ...grpc.CallOption) (*SitePluginSettingStringResponse, error) {
-	out := new(SitePluginSettingStringResponse)
-	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/PluginSettingString", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if interceptor == nil {
+		return srv.(SiteServer).LoadSinglePluginPages(ctx, in)
 	}
-	return out, nil
-}
-
-func (c *siteClient) PluginSetting(ctx context.Context, in *SitePluginSettingRequest, opts ...grpc.CallOption) (*SitePluginSettingResponse, error) {
-	out := new(SitePluginSettingResponse)
-	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/PluginSetting", in, out, opts...)
-	if err != nil {
-		return nil, err
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ambient.protodef.Site/LoadSinglePluginPages",
 	}
-	return out, nil
-}
-
-func (c *siteClient) SetNeighborPluginSetting(ctx context.Context, in *SiteSetNeighborPluginSettingRequest, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
-	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/SetNeighborPluginSetting", in, out, opts...)
-	if err != nil {
-		return nil, err
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteServer).LoadSinglePluginPages(ctx, req.(*SiteLoadSinglePluginPagesRequest))
 	}
-	return out, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *siteClient) NeighborPluginSettingString(ctx context.Context, in *SiteNeighborPluginSettingStringRequest, opts ...grpc.CallOption) (*SiteNeighborPluginSettingStringResponse, error) {
-	out := new(SiteNeighborPluginSettingStringResponse)
-	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/NeighborPluginSettingString", in, out, opts...)
-	if err != nil {
+func _Site_Authorized_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SiteAuthorizedRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(SiteServer).Authorized(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ambient.protodef.Site/Authorized",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteServer).Authorized(ctx, req.(*SiteAuthorizedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *siteClient) NeighborPluginSetting(ctx context.Context, in *SiteNeighborPluginSettingRequest, opts ...grpc.CallOption) (*SiteNeighborPluginSettingResponse, error) {
-	out := new(SiteNeighborPluginSettingResponse)
-	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/NeighborPluginSetting", in, out, opts...)
-	if err != nil {
+func _Site_NeighborPluginGrantList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SiteNeighborPluginGrantListRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(SiteServer).NeighborPluginGrantList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ambient.protodef.Site/NeighborPluginGrantList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteServer).NeighborPluginGrantList(ctx, req.(*SiteNeighborPluginGrantListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *siteClient) PluginTrusted(ctx context.Context, in *SitePluginTrustedRequest, opts ...grpc.CallOption) (*SitePluginTrustedResponse, error) {
-	out := new(SitePluginTrustedResponse)
-	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/PluginTrusted", in, out, opts...)
-	if err != nil {
+func _Site_NeighborPluginGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SiteNeighborPluginGrantsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(SiteServer).NeighborPluginGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ambient.protodef.Site/NeighborPluginGrants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteServer).NeighborPluginGrants(ctx, req.(*SiteNeighborPluginGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *siteClient) SetTitle(ctx context.Context, in *SiteSetTitleRequest, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
-	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/SetTitle", in, out, opts...)
-	if err != nil {
+func _Site_NeighborPluginGranted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SiteNeighborPluginGrantedRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(SiteServer).NeighborPluginGranted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ambient.protodef.Site/NeighborPluginGranted",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteServer).NeighborPluginGranted(ctx, req.(*SiteNeighborPluginGrantedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *siteClient) Title(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SiteTitleResponse, error) {
-	out := new(SiteTitleResponse)
-	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/Title", in, out, opts...)
-	if err != nil {
+func _Site_NeighborPluginRequestedGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SiteNeighborPluginRequestedGrantRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(SiteServer).NeighborPluginRequestedGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ambient.protodef.Site/NeighborPluginRequestedGrant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteServer).NeighborPluginRequestedGrant(ctx, req.(*SiteNeighborPluginRequestedGrantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *siteClient) SetScheme(ctx context.Context, in *SiteSetSchemeRequest, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
-	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/SetScheme", in, out, opts...)
-	if err != nil {
+func _Site_SetNeighborPluginGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SiteSetNeighborPluginGrantRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(SiteServer).SetNeighborPluginGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ambient.protodef.Site/SetNeighborPluginGrant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteServer).SetNeighborPluginGrant(ctx, req.(*SiteSetNeighborPluginGrantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *siteClient) Scheme(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SiteSchemeResponse, error) {
-	out := new(SiteSchemeResponse)
-	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/Scheme", in, out, opts...)
-	if err != nil {
+func _Site_Plugins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(SiteServer).Plugins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ambient.protodef.Site/Plugins",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteServer).Plugins(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *siteClient) SetURL(ctx context.Context, in *SiteSetURLRequest, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
-	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/SetURL", in, out, opts...)
-	if err != nil {
+func _Site_PluginNames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(SiteServer).PluginNames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ambient.protodef.Site/PluginNames",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteServer).PluginNames(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *siteClient) URL(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SiteURLResponse, error) {
-	out := new(SiteURLResponse)
-	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/URL", in, out, opts...)
-	if err != nil {
+func _Site_DeletePlugin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SiteDeletePluginRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(SiteServer).DeletePlugin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ambient.protodef.Site/DeletePlugin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteServer).DeletePlugin(ctx, req.(*SiteDeletePluginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *siteClient) FullURL(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SiteFullURLResponse, error) {
-	out := new(SiteFullURLResponse)
-	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/FullURL", in, out, opts...)
-	if err != nil {
+func _Site_EnablePlugin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SiteEnablePluginRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(SiteServer).EnablePlugin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ambient.protodef.Site/EnablePlugin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteServer).EnablePlugin(ctx, req.(*SiteEnablePluginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *siteClient) Updated(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SiteUpdatedResponse, error) {
-	out := new(SiteUpdatedResponse)
-	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/Updated", in, out, opts...)
-	if err != nil {
+func _Site_DisablePlugin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SiteDisablePluginRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(SiteServer).DisablePlugin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ambient.protodef.Site/DisablePlugin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteServer).DisablePlugin(ctx, req.(*SiteDisablePluginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *siteClient) SetContent(ctx context.Context, in *SiteSetContentRequest, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
-	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/SetContent", in, out, opts...)
-	if err != nil {
+func _Site_SavePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SiteSavePostRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(SiteServer).SavePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ambient.protodef.Site/SavePost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteServer).SavePost(ctx, req.(*SiteSavePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *siteClient) Content(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SiteContentResponse, error) {
-	out := new(SiteContentResponse)
-	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/Content", in, out, opts...)
-	if err != nil {
+func _Site_PostsAndPages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SitePostsAndPagesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(SiteServer).PostsAndPages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ambient.protodef.Site/PostsAndPages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteServer).PostsAndPages(ctx, req.(*SitePostsAndPagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *siteClient) Tags(ctx context.Context, in *SiteTagsRequest, opts ...grpc.CallOption) (*SiteTagsResponse, error) {
-	out := new(SiteTagsResponse)
-	err := c.cc.Invoke(ctx, "/ambient.protodef.Site/Tags", in, out, opts...)
-	if err != nil {
+func _Site_PublishedPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// SiteServer is the server API for Site service.
-type SiteServer interface {
-	Load(context.Context, *Empty) (*Empty, error)
-	LoadSinglePluginPages(context.Context, *SiteLoadSinglePluginPagesRequest) (*Empty, error)
-	Authorized(context.Context, *SiteAuthorizedRequest) (*SiteAuthorizedResponse, error)
-	NeighborPluginGrantList(context.Context, *SiteNeighborPluginGrantListRequest) (*SiteNeighborPluginGrantListResponse, error)
-	NeighborPluginGrants(context.Context, *SiteNeighborPluginGrantsRequest) (*SiteNeighborPluginGrantsResponse, error)
-	NeighborPluginGranted(context.Context, *SiteNeighborPluginGrantedRequest) (*SiteNeighborPluginGrantedResponse, error)
-	NeighborPluginRequestedGrant(context.Context, *SiteNeighborPluginRequestedGrantRequest) (*SiteNeighborPluginRequestedGrantResponse, error)
-	SetNeighborPluginGrant(context.Context, *SiteSetNeighborPluginGrantRequest) (*Empty, error)
-	Plugins(context.Context, *Empty) (*SitePluginsResponse, error)
-	PluginNames(context.Context, *Empty) (*SitePluginNamesResponse, error)
-	DeletePlugin(context.Context, *SiteDeletePluginRequest) (*Empty, error)
-	EnablePlugin(context.Context, *SiteEnablePluginRequest) (*Empty, error)
-	DisablePlugin(context.Context, *SiteDisablePluginRequest) (*Empty, error)
-	SavePost(context.Context, *SiteSavePostRequest) (*Empty, error)
-	PostsAndPages(context.Context, *SitePostsAndPagesRequest) (*SitePostsAndPagesResponse, error)
-	PublishedPosts(context.Context, *Empty) (*SitePublishedPostsResponse, error)
-	PublishedPages(context.Context, *Empty) (*SitePublishedPagesResponse, error)
-	PostBySlug(context.Context, *SitePostBySlugRequest) (*SitePostBySlugResponse, error)
-	PostByID(context.Context, *SitePostByIDRequest) (*SitePostByIDResponse, error)
-	DeletePostByID(context.Context, *SiteDeletePostByIDRequest) (*Empty, error)
-	PluginNeighborRoutesList(context.Context, *SitePluginNeighborRoutesListRequest) (*SitePluginNeighborRoutesListResponse, error)
-	UserPersist(context.Context, *SiteUserPersistRequest) (*Empty, error)
-	UserLogin(context.Context, *SiteUserLoginRequest) (*Empty, error)
-	AuthenticatedUser(context.Context, *SiteAuthenticatedUserRequest) (*SiteAuthenticatedUserResponse, error)
-	UserLogout(context.Context, *SiteUserLogoutRequest) (*Empty, error)
-	LogoutAllUsers(context.Context, *SiteLogoutAllUsersRequest) (*Empty, error)
-	SetCSRF(context.Context, *SiteSetCSRFRequest) (*SiteSetCSRFResponse, error)
-	CSRF(context.Context, *SiteCSRFRequest) (*SiteCSRFResponse, error)
-	SessionValue(context.Context, *SiteSessionValueRequest) (*SiteSessionValueResponse, error)
-	SetSessionValue(context.Context, *SiteSetSessionValueRequest) (*Empty, error)
-	DeleteSessionValue(context.Context, *SiteDeleteSessionValueRequest) (*Empty, error)
-	PluginNeighborSettingsList(context.Context, *SitePluginNeighborSettingsListRequest) (*SitePluginNeighborSettingsListResponse, error)
-	SetPluginSetting(context.Context, *SiteSetPluginSettingRequest) (*Empty, error)
-	PluginSettingBool(context.Context, *SitePluginSettingBoolRequest) (*SitePluginSettingBoolResponse, error)
-	PluginSettingString(context.Context, *SitePluginSettingStringRequest) (*SitePluginSettingStringResponse, error)
-	PluginSetting(context.Context, *SitePluginSettingRequest) (*SitePluginSettingResponse, error)
-	SetNeighborPluginSetting(context.Context, *SiteSetNeighborPluginSettingRequest) (*Empty, error)
-	NeighborPluginSettingString(context.Context, *SiteNeighborPluginSettingStringRequest) (*SiteNeighborPluginSettingStringResponse, error)
-	NeighborPluginSetting(context.Context, *SiteNeighborPluginSettingRequest) (*SiteNeighborPluginSettingResponse, error)
-	PluginTrusted(context.Context, *SitePluginTrustedRequest) (*SitePluginTrustedResponse, error)
-	SetTitle(context.Context, *SiteSetTitleRequest) (*Empty, error)
-	Title(context.Context, *Empty) (*SiteTitleResponse, error)
-	SetScheme(context.Context, *SiteSetSchemeRequest) (*Empty, error)
-	Scheme(context.Context, *Empty) (*SiteSchemeResponse, error)
-	SetURL(context.Context, *SiteSetURLRequest) (*Empty, error)
-	URL(context.Context, *Empty) (*SiteURLResponse, error)
-	FullURL(context.Context, *Empty) (*SiteFullURLResponse, error)
-	Updated(context.Context, *Empty) (*SiteUpdatedResponse, error)
-	SetContent(context.Context, *SiteSetContentRequest) (*Empty, error)
-	Content(context.Context, *Empty) (*SiteContentResponse, error)
-	Tags(context.Context, *SiteTagsRequest) (*SiteTagsResponse, error)
-}
-
-// UnimplementedSiteServer can be embedded to have forward compatible implementations.
-type UnimplementedSiteServer struct {
-}
-
-func (*UnimplementedSiteServer) Load(context.Context, *Empty) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Load not implemented")
-}
-func (*UnimplementedSiteServer) LoadSinglePluginPages(context.Context, *SiteLoadSinglePluginPagesRequest) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LoadSinglePluginPages not implemented")
-}
-func (*UnimplementedSiteServer) Authorized(context.Context, *SiteAuthorizedRequest) (*SiteAuthorizedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Authorized not implemented")
-}
-func (*UnimplementedSiteServer) NeighborPluginGrantList(context.Context, *SiteNeighborPluginGrantListRequest) (*SiteNeighborPluginGrantListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NeighborPluginGrantList not implemented")
-}
-func (*UnimplementedSiteServer) NeighborPluginGrants(context.Context, *SiteNeighborPluginGrantsRequest) (*SiteNeighborPluginGrantsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NeighborPluginGrants not implemented")
-}
-func (*UnimplementedSiteServer) NeighborPluginGranted(context.Context, *SiteNeighborPluginGrantedRequest) (*SiteNeighborPluginGrantedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NeighborPluginGranted not implemented")
-}
-func (*UnimplementedSiteServer) NeighborPluginRequestedGrant(context.Context, *SiteNeighborPluginRequestedGrantRequest) (*SiteNeighborPluginRequestedGrantResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NeighborPluginRequestedGrant not implemented")
-}
-func (*UnimplementedSiteServer) SetNeighborPluginGrant(context.Context, *SiteSetNeighborPluginGrantRequest) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetNeighborPluginGrant not implemented")
-}
-func (*UnimplementedSiteServer) Plugins(context.Context, *Empty) (*SitePluginsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Plugins not implemented")
-}
-func (*UnimplementedSiteServer) PluginNames(context.Context, *Empty) (*SitePluginNamesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PluginNames not implemented")
-}
-func (*UnimplementedSiteServer) DeletePlugin(context.Context, *SiteDeletePluginRequest) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeletePlugin not implemented")
-}
-func (*UnimplementedSiteServer) EnablePlugin(context.Context, *SiteEnablePluginRequest) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EnablePlugin not implemented")
-}
-func (*UnimplementedSiteServer) DisablePlugin(context.Context, *SiteDisablePluginRequest) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DisablePlugin not implemented")
-}
-func (*UnimplementedSiteServer) SavePost(context.Context, *SiteSavePostRequest) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SavePost not implemented")
-}
-func (*UnimplementedSiteServer) PostsAndPages(context.Context, *SitePostsAndPagesRequest) (*SitePostsAndPagesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PostsAndPages not implemented")
-}
-func (*UnimplementedSiteServer) PublishedPosts(context.Context, *Empty) (*SitePublishedPostsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PublishedPosts not implemented")
-}
-func (*UnimplementedSiteServer) PublishedPages(context.Context, *Empty) (*SitePublishedPagesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PublishedPages not implemented")
-}
-func (*UnimplementedSiteServer) PostBySlug(context.Context, *SitePostBySlugRequest) (*SitePostBySlugResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PostBySlug not implemented")
-}
-func (*UnimplementedSiteServer) PostByID(context.Context, *SitePostByIDRequest) (*SitePostByIDResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PostByID not implemented")
-}
-func (*UnimplementedSiteServer) DeletePostByID(context.Context, *SiteDeletePostByIDRequest) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeletePostByID not implemented")
-}
-func (*UnimplementedSiteServer) PluginNeighborRoutesList(context.Context, *SitePluginNeighborRoutesListRequest) (*SitePluginNeighborRoutesListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PluginNeighborRoutesList not implemented")
-}
-func (*UnimplementedSiteServer) UserPersist(context.Context, *SiteUserPersistRequest) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UserPersist not implemented")
-}
-func (*UnimplementedSiteServer) UserLogin(context.Context, *SiteUserLoginRequest) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UserLogin not implemented")
-}
-func (*UnimplementedSiteServer) AuthenticatedUser(context.Context, *SiteAuthenticatedUserRequest) (*SiteAuthenticatedUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AuthenticatedUser not implemented")
-}
-func (*UnimplementedSiteServer) UserLogout(context.Context, *SiteUserLogoutRequest) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UserLogout not implemented")
-}
-func (*UnimplementedSiteServer) LogoutAllUsers(context.Context, *SiteLogoutAllUsersRequest) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LogoutAllUsers not implemented")
-}
-func (*UnimplementedSiteServer) SetCSRF(context.Context, *SiteSetCSRFRequest) (*SiteSetCSRFResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetCSRF not implemented")
-}
-func (*UnimplementedSiteServer) CSRF(context.Context, *SiteCSRFRequest) (*SiteCSRFResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CSRF not implemented")
-}
-func (*UnimplementedSiteServer) SessionValue(context.Context, *SiteSessionValueRequest) (*SiteSessionValueResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SessionValue not implemented")
-}
-func (*UnimplementedSiteServer) SetSessionValue(context.Context, *SiteSetSessionValueRequest) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetSessionValue not implemented")
-}
-func (*UnimplementedSiteServer) DeleteSessionValue(context.Context, *SiteDeleteSessionValueRequest) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteSessionValue not implemented")
-}
-func (*UnimplementedSiteServer) PluginNeighborSettingsList(context.Context, *SitePluginNeighborSettingsListRequest) (*SitePluginNeighborSettingsListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PluginNeighborSettingsList not implemented")
-}
-func (*UnimplementedSiteServer) SetPluginSetting(context.Context, *SiteSetPluginSettingRequest) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetPluginSetting not implemented")
-}
-func (*UnimplementedSiteServer) PluginSettingBool(context.Context, *SitePluginSettingBoolRequest) (*SitePluginSettingBoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PluginSettingBool not implemented")
+	if interceptor == nil {
+		return srv.(SiteServer).PublishedPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ambient.protodef.Site/PublishedPosts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteServer).PublishedPosts(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedSiteServer) PluginSettingString(context.Context, *SitePluginSettingStringRequest) (*SitePluginSettingStringResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PluginSettingString not implemented")
+
+func _Site_PublishedPages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SiteServer).PublishedPages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ambient.protodef.Site/PublishedPages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteServer).PublishedPages(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedSiteServer) PluginSetting(context.Context, *SitePluginSettingRequest) (*SitePluginSettingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PluginSetting not implemented")
+
+func _Site_PostBySlug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SitePostBySlugRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SiteServer).PostBySlug(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ambient.protodef.Site/PostBySlug",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteServer).PostBySlug(ctx, req.(*SitePostBySlugRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedSiteServer) SetNeighborPluginSetting(context.Context, *SiteSetNeighborPluginSettingRequest) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetNeighborPluginSetting not implemented")
+
+func _Site_SearchPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SiteSearchPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SiteServer).SearchPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ambient.protodef.Site/SearchPosts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteServer).SearchPosts(ctx, req.(*SiteSearchPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedSiteServer) NeighborPluginSettingString(context.Context, *SiteNeighborPluginSettingStringRequest) (*SiteNeighborPluginSettingStringResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NeighborPluginSettingString not implemented")
+
+func _Site_QueryPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SiteQueryPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SiteServer).QueryPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ambient.protodef.Site/QueryPosts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteServer).QueryPosts(ctx, req.(*SiteQueryPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedSiteServer) NeighborPluginSetting(context.Context, *SiteNeighborPluginSettingRequest) (*SiteNeighborPluginSettingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NeighborPluginSetting not implemented")
+
+func _Site_ContentTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SiteServer).ContentTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ambient.protodef.Site/ContentTypes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteServer).ContentTypes(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedSiteServer) PluginTrusted(context.Context, *SitePluginTrustedRequest) (*SitePluginTrustedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PluginTrusted not implemented")
+
+func _Site_PostByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SitePostByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SiteServer).PostByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ambient.protodef.Site/PostByID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteServer).PostByID(ctx, req.(*SitePostByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedSiteServer) SetTitle(context.Context, *SiteSetTitleRequest) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetTitle not implemented")
+
+func _Site_DeletePostByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SiteDeletePostByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SiteServer).DeletePostByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ambient.protodef.Site/DeletePostByID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteServer).DeletePostByID(ctx, req.(*SiteDeletePostByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedSiteServer) Title(context.Context, *Empty) (*SiteTitleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Title not implemented")
+
+func _Site_SavePostAs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SiteSavePostAsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SiteServer).SavePostAs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ambient.protodef.Site/SavePostAs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteServer).SavePostAs(ctx, req.(*SiteSavePostAsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedSiteServer) SetScheme(context.Context, *SiteSetSchemeRequest) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetScheme not implemented")
+
+func _Site_PostRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SitePostRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SiteServer).PostRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ambient.protodef.Site/PostRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteServer).PostRevisions(ctx, req.(*SitePostRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedSiteServer) Scheme(context.Context, *Empty) (*SiteSchemeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Scheme not implemented")
+
+func _Site_PostRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SitePostRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SiteServer).PostRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ambient.protodef.Site/PostRevision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteServer).PostRevision(ctx, req.(*SitePostRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedSiteServer) SetURL(context.Context, *SiteSetURLRequest) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetURL not implemented")
+
+func _Site_PostRevisionDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SitePostRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SiteServer).PostRevisionDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ambient.protodef.Site/PostRevisionDiff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteServer).PostRevisionDiff(ctx, req.(*SitePostRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedSiteServer) URL(context.Context, *Empty) (*SiteURLResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method URL not implemented")
+
+func _Site_RestorePostRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SiteRestorePostRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SiteServer).RestorePostRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ambient.protodef.Site/RestorePostRevision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteServer).RestorePostRevision(ctx, req.(*SiteRestorePostRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedSiteServer) FullURL(context.Context, *Empty) (*SiteFullURLResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FullURL not implemented")
+
+func _Site_CreatePostPreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SiteCreatePostPreviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SiteServer).CreatePostPreview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ambient.protodef.Site/CreatePostPreview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteServer).CreatePostPreview(ctx, req.(*SiteCreatePostPreviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedSiteServer) Updated(context.Context, *Empty) (*SiteUpdatedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Updated not implemented")
+
+func _Site_PostPreviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SitePostPreviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SiteServer).PostPreviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ambient.protodef.Site/PostPreviews",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteServer).PostPreviews(ctx, req.(*SitePostPreviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedSiteServer) SetContent(context.Context, *SiteSetContentRequest) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetContent not implemented")
+
+func _Site_RevokePostPreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SiteRevokePostPreviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SiteServer).RevokePostPreview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ambient.protodef.Site/RevokePostPreview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteServer).RevokePostPreview(ctx, req.(*SiteRevokePostPreviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedSiteServer) Content(context.Context, *Empty) (*SiteContentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Content not implemented")
+
+func _Site_PreviewPostBySlug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SitePreviewPostBySlugRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SiteServer).PreviewPostBySlug(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ambient.protodef.Site/PreviewPostBySlug",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteServer).PreviewPostBySlug(ctx, req.(*SitePreviewPostBySlugRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedSiteServer) Tags(context.Context, *SiteTagsRequest) (*SiteTagsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Tags not implemented")
+
+func _Site_PageChildren_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SitePageChildrenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SiteServer).PageChildren(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ambient.protodef.Site/PageChildren",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteServer).PageChildren(ctx, req.(*SitePageChildrenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterSiteServer(s *grpc.Server, srv SiteServer) {
-	s.RegisterService(&_Site_serviceDesc, srv)
+func _Site_Breadcrumbs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SiteBreadcrumbsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SiteServer).Breadcrumbs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ambient.protodef.Site/Breadcrumbs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SiteServer).Breadcrumbs(ctx, req.(*SiteBreadcrumbsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Site_Load_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Site_Menus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SiteServer).Load(ctx, in)
+		return srv.(SiteServer).Menus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ambient.protodef.Site/Load",
+		FullMethod: "/ambient.protodef.Site/Menus",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SiteServer).Load(ctx, req.(*Empty))
+		return srv.(SiteServer).Menus(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Site_LoadSinglePluginPages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SiteLoadSinglePluginPagesRequest)
+func _Site_Menu_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SiteMenuRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SiteServer).LoadSinglePluginPages(ctx, in)
+		return srv.(SiteServer).Menu(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ambient.protodef.Site/LoadSinglePluginPages",
+		FullMethod: "/ambient.protodef.Site/Menu",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SiteServer).LoadSinglePluginPages(ctx, req.(*SiteLoadSinglePluginPagesRequest))
+		return srv.(SiteServer).Menu(ctx, req.(*SiteMenuRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Site_Authorized_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SiteAuthorizedRequest)
+func _Site_SaveMenu_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SiteSaveMenuRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SiteServer).Authorized(ctx, in)
+		return srv.(SiteServer).SaveMenu(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ambient.protodef.Site/Authorized",
+		FullMethod: "/ambient.protodef.Site/SaveMenu",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SiteServer).Authorized(ctx, req.(*SiteAuthorizedRequest))
+		return srv.(SiteServer).SaveMenu(ctx, req.(*SiteSaveMenuRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Site_NeighborPluginGrantList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SiteNeighborPluginGrantListRequest)
+func _Site_DeleteMenu_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SiteDeleteMenuRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SiteServer).NeighborPluginGrantList(ctx, in)
+		return srv.(SiteServer).DeleteMenu(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ambient.protodef.Site/NeighborPluginGrantList",
+		FullMethod: "/ambient.protodef.Site/DeleteMenu",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SiteServer).NeighborPluginGrantList(ctx, req.(*SiteNeighborPluginGrantListRequest))
+		return srv.(SiteServer).DeleteMenu(ctx, req.(*SiteDeleteMenuRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Site_NeighborPluginGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SiteNeighborPluginGrantsRequest)
+func _Site_PluginNeighborRoutesList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SitePluginNeighborRoutesListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SiteServer).NeighborPluginGrants(ctx, in)
+		return srv.(SiteServer).PluginNeighborRoutesList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ambient.protodef.Site/NeighborPluginGrants",
+		FullMethod: "/ambient.protodef.Site/PluginNeighborRoutesList",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SiteServer).NeighborPluginGrants(ctx, req.(*SiteNeighborPluginGrantsRequest))
+		return srv.(SiteServer).PluginNeighborRoutesList(ctx, req.(*SitePluginNeighborRoutesListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Site_NeighborPluginGranted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SiteNeighborPluginGrantedRequest)
+func _Site_UserPersist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SiteUserPersistRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SiteServer).NeighborPluginGranted(ctx, in)
+		return srv.(SiteServer).UserPersist(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ambient.protodef.Site/NeighborPluginGranted",
+		FullMethod: "/ambient.protodef.Site/UserPersist",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SiteServer).NeighborPluginGranted(ctx, req.(*SiteNeighborPluginGrantedRequest))
+		return srv.(SiteServer).UserPersist(ctx, req.(*SiteUserPersistRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Site_NeighborPluginRequestedGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SiteNeighborPluginRequestedGrantRequest)
+func _Site_UserLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SiteUserLoginRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SiteServer).NeighborPluginRequestedGrant(ctx, in)
+		return srv.(SiteServer).UserLogin(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ambient.protodef.Site/NeighborPluginRequestedGrant",
+		FullMethod: "/ambient.protodef.Site/UserLogin",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SiteServer).NeighborPluginRequestedGrant(ctx, req.(*SiteNeighborPluginRequestedGrantRequest))
+		return srv.(SiteServer).UserLogin(ctx, req.(*SiteUserLoginRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Site_SetNeighborPluginGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SiteSetNeighborPluginGrantRequest)
+func _Site_AuthenticatedUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SiteAuthenticatedUserRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SiteServer).SetNeighborPluginGrant(ctx, in)
+		return srv.(SiteServer).AuthenticatedUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ambient.protodef.Site/SetNeighborPluginGrant",
+		FullMethod: "/ambient.protodef.Site/AuthenticatedUser",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SiteServer).SetNeighborPluginGrant(ctx, req.(*SiteSetNeighborPluginGrantRequest))
+		return srv.(SiteServer).AuthenticatedUser(ctx, req.(*SiteAuthenticatedUserRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Site_Plugins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _Site_UserLogout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SiteUserLogoutRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SiteServer).Plugins(ctx, in)
+		return srv.(SiteServer).UserLogout(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ambient.protodef.Site/Plugins",
+		FullMethod: "/ambient.protodef.Site/UserLogout",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SiteServer).Plugins(ctx, req.(*Empty))
+		return srv.(SiteServer).UserLogout(ctx, req.(*SiteUserLogoutRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Site_PluginNames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _Site_LogoutAllUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SiteLogoutAllUsersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SiteServer).PluginNames(ctx, in)
+		return srv.(SiteServer).LogoutAllUsers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ambient.protodef.Site/PluginNames",
+		FullMethod: "/ambient.protodef.Site/LogoutAllUsers",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SiteServer).PluginNames(ctx, req.(*Empty))
+		return srv.(SiteServer).LogoutAllUsers(ctx, req.(*SiteLogoutAllUsersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Site_DeletePlugin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SiteDeletePluginRequest)
+func _Site_UserSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SiteUserSessionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SiteServer).DeletePlugin(ctx, in)
+		return srv.(SiteServer).UserSessions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ambient.protodef.Site/DeletePlugin",
+		FullMethod: "/ambient.protodef.Site/UserSessions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SiteServer).DeletePlugin(ctx, req.(*SiteDeletePluginRequest))
+		return srv.(SiteServer).UserSessions(ctx, req.(*SiteUserSessionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Site_EnablePlugin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SiteEnablePluginRequest)
+func _Site_LogoutUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SiteLogoutUserRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SiteServer).EnablePlugin(ctx, in)
+		return srv.(SiteServer).LogoutUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ambient.protodef.Site/EnablePlugin",
+		FullMethod: "/ambient.protodef.Site/LogoutUser",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SiteServer).EnablePlugin(ctx, req.(*SiteEnablePluginRequest))
+		return srv.(SiteServer).LogoutUser(ctx, req.(*SiteLogoutUserRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Site_DisablePlugin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SiteDisablePluginRequest)
+func _Site_SetCSRF_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SiteSetCSRFRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SiteServer).DisablePlugin(ctx, in)
+		return srv.(SiteServer).SetCSRF(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ambient.protodef.Site/DisablePlugin",
+		FullMethod: "/ambient.protodef.Site/SetCSRF",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SiteServer).DisablePlugin(ctx, req.(*SiteDisablePluginRequest))
+		return srv.(SiteServer).SetCSRF(ctx, req.(*SiteSetCSRFRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Site_SavePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SiteSavePostRequest)
+func _Site_CSRF_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SiteCSRFRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SiteServer).SavePost(ctx, in)
+		return srv.(SiteServer).CSRF(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ambient.protodef.Site/SavePost",
+		FullMethod: "/ambient.protodef.Site/CSRF",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SiteServer).SavePost(ctx, req.(*SiteSavePostRequest))
+		return srv.(SiteServer).CSRF(ctx, req.(*SiteCSRFRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Site_PostsAndPages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SitePostsAndPagesRequest)
+func _Site_SessionValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SiteSessionValueRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SiteServer).PostsAndPages(ctx, in)
+		return srv.(SiteServer).SessionValue(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ambient.protodef.Site/PostsAndPages",
+		FullMethod: "/ambient.protodef.Site/SessionValue",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SiteServer).PostsAndPages(ctx, req.(*SitePostsAndPagesRequest))
+		return srv.(SiteServer).SessionValue(ctx, req.(*SiteSessionValueRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Site_PublishedPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _Site_SetSessionValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SiteSetSessionValueRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SiteServer).PublishedPosts(ctx, in)
+		return srv.(SiteServer).SetSessionValue(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ambient.protodef.Site/PublishedPosts",
+		FullMethod: "/ambient.protodef.Site/SetSessionValue",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SiteServer).PublishedPosts(ctx, req.(*Empty))
+		return srv.(SiteServer).SetSessionValue(ctx, req.(*SiteSetSessionValueRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Site_PublishedPages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _Site_DeleteSessionValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SiteDeleteSessionValueRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SiteServer).PublishedPages(ctx, in)
+		return srv.(SiteServer).DeleteSessionValue(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ambient.protodef.Site/PublishedPages",
+		FullMethod: "/ambient.protodef.Site/DeleteSessionValue",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SiteServer).PublishedPages(ctx, req.(*Empty))
+		return srv.(SiteServer).DeleteSessionValue(ctx, req.(*SiteDeleteSessionValueRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Site_PostBySlug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SitePostBySlugRequest)
+func _Site_PluginNeighborSettingsList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SitePluginNeighborSettingsListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SiteServer).PostBySlug(ctx, in)
+		return srv.(SiteServer).PluginNeighborSettingsList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ambient.protodef.Site/PostBySlug",
+		FullMethod: "/ambient.protodef.Site/PluginNeighborSettingsList",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SiteServer).PostBySlug(ctx, req.(*SitePostBySlugRequest))
+		return srv.(SiteServer).PluginNeighborSettingsList(ctx, req.(*SitePluginNeighborSettingsListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Site_PostByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SitePostByIDRequest)
+func _Site_SetPluginSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SiteSetPluginSettingRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SiteServer).PostByID(ctx, in)
+		return srv.(SiteServer).SetPluginSetting(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ambient.protodef.Site/PostByID",
+		FullMethod: "/ambient.protodef.Site/SetPluginSetting",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SiteServer).PostByID(ctx, req.(*SitePostByIDRequest))
+		return srv.(SiteServer).SetPluginSetting(ctx, req.(*SiteSetPluginSettingRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Site_DeletePostByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SiteDeletePostByIDRequest)
+func _Site_PluginSettingBool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SitePluginSettingBoolRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SiteServer).DeletePostByID(ctx, in)
+		return srv.(SiteServer).PluginSettingBool(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ambient.protodef.Site/DeletePostByID",
+		FullMethod: "/ambient.protodef.Site/PluginSettingBool",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SiteServer).DeletePostByID(ctx, req.(*SiteDeletePostByIDRequest))
+		return srv.(SiteServer).PluginSettingBool(ctx, req.(*SitePluginSettingBoolRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Site_PluginNeighborRoutesList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SitePluginNeighborRoutesListRequest)
+func _Site_PluginSettingString_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SitePluginSettingStringRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SiteServer).PluginNeighborRoutesList(ctx, in)
+		return srv.(SiteServer).PluginSettingString(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ambient.protodef.Site/PluginNeighborRoutesList",
+		FullMethod: "/ambient.protodef.Site/PluginSettingString",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SiteServer).PluginNeighborRoutesList(ctx, req.(*SitePluginNeighborRoutesListRequest))
+		return srv.(SiteServer).PluginSettingString(ctx, req.(*SitePluginSettingStringRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Site_UserPersist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SiteUserPersistRequest)
+func _Site_PluginSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SitePluginSettingRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SiteServer).UserPersist(ctx, in)
+		return srv.(SiteServer).PluginSetting(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ambient.protodef.Site/UserPersist",
+		FullMethod: "/ambient.protodef.Site/PluginSetting",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SiteServer).UserPersist(ctx, req.(*SiteUserPersistRequest))
+		return srv.(SiteServer).PluginSetting(ctx, req.(*SitePluginSettingRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Site_UserLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SiteUserLoginRequest)
+func _Site_SetNeighborPluginSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SiteSetNeighborPluginSettingRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SiteServer).UserLogin(ctx, in)
+		return srv.(SiteServer).SetNeighborPluginSetting(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ambient.protodef.Site/UserLogin",
+		FullMethod: "/ambient.protodef.Site/SetNeighborPluginSetting",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SiteServer).UserLogin(ctx, req.(*SiteUserLoginRequest))
+		return srv.(SiteServer).SetNeighborPluginSetting(ctx, req.(*SiteSetNeighborPluginSettingRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Site_AuthenticatedUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SiteAuthenticatedUserRequest)
+func _Site_NeighborPluginSettingString_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SiteNeighborPluginSettingStringRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SiteServer).AuthenticatedUser(ctx, in)
+		return srv.(SiteServer).NeighborPluginSettingString(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ambient.protodef.Site/AuthenticatedUser",
+		FullMethod: "/ambient.protodef.Site/NeighborPluginSettingString",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SiteServer).AuthenticatedUser(ctx, req.(*SiteAuthenticatedUserRequest))
+		return srv.(SiteServer).NeighborPluginSettingString(ctx, req.(*SiteNeighborPluginSettingStringRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Site_UserLogout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SiteUserLogoutRequest)
+func _Site_NeighborPluginSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SiteNeighborPluginSettingRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SiteServer).UserLogout(ctx, in)
+		return srv.(SiteServer).NeighborPluginSetting(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ambient.protodef.Site/UserLogout",
+		FullMethod: "/ambient.protodef.Site/NeighborPluginSetting",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SiteServer).UserLogout(ctx, req.(*SiteUserLogoutRequest))
+		return srv.(SiteServer).NeighborPluginSetting(ctx, req.(*SiteNeighborPluginSettingRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Site_LogoutAllUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SiteLogoutAllUsersRequest)
+func _Site_PluginTrusted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SitePluginTrustedRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SiteServer).LogoutAllUsers(ctx, in)
+		return srv.(SiteServer).PluginTrusted(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ambient.protodef.Site/LogoutAllUsers",
+		FullMethod: "/ambient.protodef.Site/PluginTrusted",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SiteServer).LogoutAllUsers(ctx, req.(*SiteLogoutAllUsersRequest))
+		return srv.(SiteServer).PluginTrusted(ctx, req.(*SitePluginTrustedRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Site_SetCSRF_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SiteSetCSRFRequest)
+func _Site_SetTitle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SiteSetTitleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SiteServer).SetCSRF(ctx, in)
+		return srv.(SiteServer).SetTitle(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ambient.protodef.Site/SetCSRF",
+		FullMethod: "/ambient.protodef.Site/SetTitle",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SiteServer).SetCSRF(ctx, req.(*SiteSetCSRFRequest))
+		return srv.(SiteServer).SetTitle(ctx, req.(*SiteSetTitleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Site_CSRF_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SiteCSRFRequest)
+func _Site_Title_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SiteServer).CSRF(ctx, in)
+		return srv.(SiteServer).Title(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ambient.protodef.Site/CSRF",
+		FullMethod: "/ambient.protodef.Site/Title",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SiteServer).CSRF(ctx, req.(*SiteCSRFRequest))
+		return srv.(SiteServer).Title(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Site_SessionValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SiteSessionValueRequest)
+func _Site_SetScheme_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SiteSetSchemeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SiteServer).SessionValue(ctx, in)
+		return srv.(SiteServer).SetScheme(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ambient.protodef.Site/SessionValue",
+		FullMethod: "/ambient.protodef.Site/SetScheme",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SiteServer).SessionValue(ctx, req.(*SiteSessionValueRequest))
+		return srv.(SiteServer).SetScheme(ctx, req.(*SiteSetSchemeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Site_SetSessionValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SiteSetSessionValueRequest)
+func _Site_Scheme_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SiteServer).SetSessionValue(ctx, in)
+		return srv.(SiteServer).Scheme(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ambient.protodef.Site/SetSessionValue",
+		FullMethod: "/ambient.protodef.Site/Scheme",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SiteServer).SetSessionValue(ctx, req.(*SiteSetSessionValueRequest))
+		return srv.(SiteServer).Scheme(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Site_DeleteSessionValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SiteDeleteSessionValueRequest)
+func _Site_SetURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SiteSetURLRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SiteServer).DeleteSessionValue(ctx, in)
+		return srv.(SiteServer).SetURL(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ambient.protodef.Site/DeleteSessionValue",
+		FullMethod: "/ambient.protodef.Site/SetURL",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SiteServer).DeleteSessionValue(ctx, req.(*SiteDeleteSessionValueRequest))
+		return srv.(SiteServer).SetURL(ctx, req.(*SiteSetURLRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Site_PluginNeighborSettingsList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SitePluginNeighborSettingsListRequest)
+func _Site_URL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SiteServer).PluginNeighborSettingsList(ctx, in)
+		return srv.(SiteServer).URL(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ambient.protodef.Site/PluginNeighborSettingsList",
+		FullMethod: "/ambient.protodef.Site/URL",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SiteServer).PluginNeighborSettingsList(ctx, req.(*SitePluginNeighborSettingsListRequest))
+		return srv.(SiteServer).URL(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Site_SetPluginSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SiteSetPluginSettingRequest)
+func _Site_FullURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SiteServer).SetPluginSetting(ctx, in)
+		return srv.(SiteServer).FullURL(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ambient.protodef.Site/SetPluginSetting",
+		FullMethod: "/ambient.protodef.Site/FullURL",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SiteServer).SetPluginSetting(ctx, req.(*SiteSetPluginSettingRequest))
+		return srv.(SiteServer).FullURL(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Site_PluginSettingBool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SitePluginSettingBoolRequest)
+func _Site_Updated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SiteServer).PluginSettingBool(ctx, in)
+		return srv.(SiteServer).Updated(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ambient.protodef.Site/PluginSettingBool",
+		FullMethod: "/ambient.protodef.Site/Updated",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SiteServer).PluginSettingBool(ctx, req.(*SitePluginSettingBoolRequest))
+		return srv.(SiteServer).Updated(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Site_PluginSettingString_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SitePluginSettingStringRequest)
+func _Site_SetContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SiteSetContentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SiteServer).PluginSettingString(ctx, in)
+		return srv.(SiteServer).SetContent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ambient.protodef.Site/PluginSettingString",
+		FullMethod: "/ambient.protodef.Site/SetContent",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SiteServer).PluginSettingString(ctx, req.(*SitePluginSettingStringRequest))
+		return srv.(SiteServer).SetContent(ctx, req.(*SiteSetContentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Site_PluginSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SitePluginSettingRequest)
+func _Site_Content_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SiteServer).PluginSetting(ctx, in)
+		return srv.(SiteServer).Content(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ambient.protodef.Site/PluginSetting",
+		FullMethod: "/ambient.protodef.Site/Content",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SiteServer).PluginSetting(ctx, req.(*SitePluginSettingRequest))
+		return srv.(SiteServer).Content(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Site_SetNeighborPluginSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SiteSetNeighborPluginSettingRequest)
+func _Site_Tags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SiteTagsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SiteServer).SetNeighborPluginSetting(ctx, in)
+		return srv.(SiteServer).Tags(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ambient.protodef.Site/SetNeighborPluginSetting",
+		FullMethod: "/ambient.protodef.Site/Tags",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SiteServer).SetNeighborPluginSetting(ctx, req.(*SiteSetNeighborPluginSettingRequest))
+		return srv.(SiteServer).Tags(ctx, req.(*SiteTagsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Site_NeighborPluginSettingString_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SiteNeighborPluginSettingStringRequest)
+func _Site_TagDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SiteTagsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SiteServer).NeighborPluginSettingString(ctx, in)
+		return srv.(SiteServer).TagDetails(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ambient.protodef.Site/NeighborPluginSettingString",
+		FullMethod: "/ambient.protodef.Site/TagDetails",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SiteServer).NeighborPluginSettingString(ctx, req.(*SiteNeighborPluginSettingStringRequest))
+		return srv.(SiteServer).TagDetails(ctx, req.(*SiteTagsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Site_NeighborPluginSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SiteNeighborPluginSettingRequest)
+func _Site_TagBySlug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SiteTagBySlugRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SiteServer).NeighborPluginSetting(ctx, in)
+		return srv.(SiteServer).TagBySlug(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ambient.protodef.Site/NeighborPluginSetting",
+		FullMethod: "/ambient.protodef.Site/TagBySlug",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SiteServer).NeighborPluginSetting(ctx, req.(*SiteNeighborPluginSettingRequest))
+		return srv.(SiteServer).TagBySlug(ctx, req.(*SiteTagBySlugRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Site_PluginTrusted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SitePluginTrustedRequest)
+func _Site_RenameTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SiteRenameTagRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SiteServer).PluginTrusted(ctx, in)
+		return srv.(SiteServer).RenameTag(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ambient.protodef.Site/PluginTrusted",
+		FullMethod: "/ambient.protodef.Site/RenameTag",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SiteServer).PluginTrusted(ctx, req.(*SitePluginTrustedRequest))
+		return srv.(SiteServer).RenameTag(ctx, req.(*SiteRenameTagRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Site_SetTitle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SiteSetTitleRequest)
+func _Site_MergeTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SiteMergeTagsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SiteServer).SetTitle(ctx, in)
+		return srv.(SiteServer).MergeTags(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ambient.protodef.Site/SetTitle",
+		FullMethod: "/ambient.protodef.Site/MergeTags",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SiteServer).SetTitle(ctx, req.(*SiteSetTitleRequest))
+		return srv.(SiteServer).MergeTags(ctx, req.(*SiteMergeTagsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Site_Title_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _Site_DeleteTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SiteDeleteTagRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SiteServer).Title(ctx, in)
+		return srv.(SiteServer).DeleteTag(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ambient.protodef.Site/Title",
+		FullMethod: "/ambient.protodef.Site/DeleteTag",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SiteServer).Title(ctx, req.(*Empty))
+		return srv.(SiteServer).DeleteTag(ctx, req.(*SiteDeleteTagRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Site_SetScheme_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SiteSetSchemeRequest)
+func _Site_SetTagMeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SiteSetTagMetaRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SiteServer).SetScheme(ctx, in)
+		return srv.(SiteServer).SetTagMeta(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ambient.protodef.Site/SetScheme",
+		FullMethod: "/ambient.protodef.Site/SetTagMeta",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SiteServer).SetScheme(ctx, req.(*SiteSetSchemeRequest))
+		return srv.(SiteServer).SetTagMeta(ctx, req.(*SiteSetTagMetaRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Site_Scheme_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Site_Snapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SiteServer).Scheme(ctx, in)
+		return srv.(SiteServer).Snapshots(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ambient.protodef.Site/Scheme",
+		FullMethod: "/ambient.protodef.Site/Snapshots",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SiteServer).Scheme(ctx, req.(*Empty))
+		return srv.(SiteServer).Snapshots(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Site_SetURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SiteSetURLRequest)
+func _Site_CreateSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SiteServer).SetURL(ctx, in)
+		return srv.(SiteServer).CreateSnapshot(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ambient.protodef.Site/SetURL",
+		FullMethod: "/ambient.protodef.Site/CreateSnapshot",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SiteServer).SetURL(ctx, req.(*SiteSetURLRequest))
+		return srv.(SiteServer).CreateSnapshot(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Site_URL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _Site_SnapshotDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SiteSnapshotDiffRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SiteServer).URL(ctx, in)
+		return srv.(SiteServer).SnapshotDiff(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ambient.protodef.Site/URL",
+		FullMethod: "/ambient.protodef.Site/SnapshotDiff",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SiteServer).URL(ctx, req.(*Empty))
+		return srv.(SiteServer).SnapshotDiff(ctx, req.(*SiteSnapshotDiffRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Site_FullURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _Site_RestoreSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SiteRestoreSnapshotRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SiteServer).FullURL(ctx, in)
+		return srv.(SiteServer).RestoreSnapshot(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ambient.protodef.Site/FullURL",
+		FullMethod: "/ambient.protodef.Site/RestoreSnapshot",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SiteServer).FullURL(ctx, req.(*Empty))
+		return srv.(SiteServer).RestoreSnapshot(ctx, req.(*SiteRestoreSnapshotRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Site_Updated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _Site_PluginStoreGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SitePluginStoreGetRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SiteServer).Updated(ctx, in)
+		return srv.(SiteServer).PluginStoreGet(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ambient.protodef.Site/Updated",
+		FullMethod: "/ambient.protodef.Site/PluginStoreGet",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SiteServer).Updated(ctx, req.(*Empty))
+		return srv.(SiteServer).PluginStoreGet(ctx, req.(*SitePluginStoreGetRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Site_SetContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SiteSetContentRequest)
+func _Site_PluginStorePut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SitePluginStorePutRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SiteServer).SetContent(ctx, in)
+		return srv.(SiteServer).PluginStorePut(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ambient.protodef.Site/SetContent",
+		FullMethod: "/ambient.protodef.Site/PluginStorePut",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SiteServer).SetContent(ctx, req.(*SiteSetContentRequest))
+		return srv.(SiteServer).PluginStorePut(ctx, req.(*SitePluginStorePutRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Site_Content_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _Site_PluginStoreDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SitePluginStoreDeleteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SiteServer).Content(ctx, in)
+		return srv.(SiteServer).PluginStoreDelete(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ambient.protodef.Site/Content",
+		FullMethod: "/ambient.protodef.Site/PluginStoreDelete",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SiteServer).Content(ctx, req.(*Empty))
+		return srv.(SiteServer).PluginStoreDelete(ctx, req.(*SitePluginStoreDeleteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Site_Tags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SiteTagsRequest)
+func _Site_PluginStoreList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SiteServer).Tags(ctx, in)
+		return srv.(SiteServer).PluginStoreList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ambient.protodef.Site/Tags",
+		FullMethod: "/ambient.protodef.Site/PluginStoreList",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SiteServer).Tags(ctx, req.(*SiteTagsRequest))
+		return srv.(SiteServer).PluginStoreList(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Site_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SiteServer).Subscribe(m, &siteSubscribeServer{stream})
+}
+
+type Site_SubscribeServer interface {
+	Send(*SiteEvent) error
+	grpc.ServerStream
+}
+
+type siteSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *siteSubscribeServer) Send(m *SiteEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _Site_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ambient.protodef.Site",
 	HandlerType: (*SiteServer)(nil),
@@ -7061,6 +11645,18 @@ var _Site_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PostBySlug",
 			Handler:    _Site_PostBySlug_Handler,
 		},
+		{
+			MethodName: "SearchPosts",
+			Handler:    _Site_SearchPosts_Handler,
+		},
+		{
+			MethodName: "QueryPosts",
+			Handler:    _Site_QueryPosts_Handler,
+		},
+		{
+			MethodName: "ContentTypes",
+			Handler:    _Site_ContentTypes_Handler,
+		},
 		{
 			MethodName: "PostByID",
 			Handler:    _Site_PostByID_Handler,
@@ -7069,6 +11665,66 @@ var _Site_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeletePostByID",
 			Handler:    _Site_DeletePostByID_Handler,
 		},
+		{
+			MethodName: "SavePostAs",
+			Handler:    _Site_SavePostAs_Handler,
+		},
+		{
+			MethodName: "PostRevisions",
+			Handler:    _Site_PostRevisions_Handler,
+		},
+		{
+			MethodName: "PostRevision",
+			Handler:    _Site_PostRevision_Handler,
+		},
+		{
+			MethodName: "PostRevisionDiff",
+			Handler:    _Site_PostRevisionDiff_Handler,
+		},
+		{
+			MethodName: "RestorePostRevision",
+			Handler:    _Site_RestorePostRevision_Handler,
+		},
+		{
+			MethodName: "CreatePostPreview",
+			Handler:    _Site_CreatePostPreview_Handler,
+		},
+		{
+			MethodName: "PostPreviews",
+			Handler:    _Site_PostPreviews_Handler,
+		},
+		{
+			MethodName: "RevokePostPreview",
+			Handler:    _Site_RevokePostPreview_Handler,
+		},
+		{
+			MethodName: "PreviewPostBySlug",
+			Handler:    _Site_PreviewPostBySlug_Handler,
+		},
+		{
+			MethodName: "PageChildren",
+			Handler:    _Site_PageChildren_Handler,
+		},
+		{
+			MethodName: "Breadcrumbs",
+			Handler:    _Site_Breadcrumbs_Handler,
+		},
+		{
+			MethodName: "Menus",
+			Handler:    _Site_Menus_Handler,
+		},
+		{
+			MethodName: "Menu",
+			Handler:    _Site_Menu_Handler,
+		},
+		{
+			MethodName: "SaveMenu",
+			Handler:    _Site_SaveMenu_Handler,
+		},
+		{
+			MethodName: "DeleteMenu",
+			Handler:    _Site_DeleteMenu_Handler,
+		},
 		{
 			MethodName: "PluginNeighborRoutesList",
 			Handler:    _Site_PluginNeighborRoutesList_Handler,
@@ -7093,6 +11749,14 @@ var _Site_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LogoutAllUsers",
 			Handler:    _Site_LogoutAllUsers_Handler,
 		},
+		{
+			MethodName: "UserSessions",
+			Handler:    _Site_UserSessions_Handler,
+		},
+		{
+			MethodName: "LogoutUser",
+			Handler:    _Site_LogoutUser_Handler,
+		},
 		{
 			MethodName: "SetCSRF",
 			Handler:    _Site_SetCSRF_Handler,
@@ -7193,7 +11857,69 @@ var _Site_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Tags",
 			Handler:    _Site_Tags_Handler,
 		},
+		{
+			MethodName: "TagDetails",
+			Handler:    _Site_TagDetails_Handler,
+		},
+		{
+			MethodName: "TagBySlug",
+			Handler:    _Site_TagBySlug_Handler,
+		},
+		{
+			MethodName: "RenameTag",
+			Handler:    _Site_RenameTag_Handler,
+		},
+		{
+			MethodName: "MergeTags",
+			Handler:    _Site_MergeTags_Handler,
+		},
+		{
+			MethodName: "DeleteTag",
+			Handler:    _Site_DeleteTag_Handler,
+		},
+		{
+			MethodName: "SetTagMeta",
+			Handler:    _Site_SetTagMeta_Handler,
+		},
+		{
+			MethodName: "Snapshots",
+			Handler:    _Site_Snapshots_Handler,
+		},
+		{
+			MethodName: "CreateSnapshot",
+			Handler:    _Site_CreateSnapshot_Handler,
+		},
+		{
+			MethodName: "SnapshotDiff",
+			Handler:    _Site_SnapshotDiff_Handler,
+		},
+		{
+			MethodName: "RestoreSnapshot",
+			Handler:    _Site_RestoreSnapshot_Handler,
+		},
+		{
+			MethodName: "PluginStoreGet",
+			Handler:    _Site_PluginStoreGet_Handler,
+		},
+		{
+			MethodName: "PluginStorePut",
+			Handler:    _Site_PluginStorePut_Handler,
+		},
+		{
+			MethodName: "PluginStoreDelete",
+			Handler:    _Site_PluginStoreDelete_Handler,
+		},
+		{
+			MethodName: "PluginStoreList",
+			Handler:    _Site_PluginStoreList_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _Site_Subscribe_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "site.proto",
 }
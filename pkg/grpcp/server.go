@@ -200,6 +200,23 @@ func (m *GRPCServer) Settings() []ambient.Setting {
 	return arr
 }
 
+// ContentTypes handler. It's called before the plugin is enabled so the
+// errors are not logged.
+func (m *GRPCServer) ContentTypes() []ambient.ContentType {
+	resp, err := m.client.ContentTypes(context.Background(), &protodef.Empty{})
+	if err != nil {
+		return nil
+	}
+
+	arr := make([]ambient.ContentType, 0)
+	err = ProtobufStructToArray(resp.Contenttypes, &arr)
+	if err != nil {
+		return nil
+	}
+
+	return arr
+}
+
 // GrantRequests handler.
 func (m *GRPCServer) GrantRequests() []ambient.GrantRequest {
 	resp, err := m.client.GrantRequests(context.Background(), &protodef.Empty{})
@@ -2,11 +2,13 @@ package grpcp
 
 import (
 	"errors"
+	"time"
 
 	"github.com/ambientkit/ambient"
 	"github.com/ambientkit/ambient/pkg/grpcp/grpcsafe"
 	"github.com/ambientkit/ambient/pkg/grpcp/protodef"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -230,6 +232,54 @@ func (m *GRPCSiteServer) PostBySlug(ctx context.Context, req *protodef.SitePostB
 	}, err
 }
 
+// SearchPosts handler.
+func (m *GRPCSiteServer) SearchPosts(ctx context.Context, req *protodef.SiteSearchPostsRequest) (
+	resp *protodef.SiteSearchPostsResponse, err error) {
+	results, err := m.Impl.SearchPosts(req.Query, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return &protodef.SiteSearchPostsResponse{}, err
+	}
+
+	r, err := ObjectToProtobufStruct(results)
+	return &protodef.SiteSearchPostsResponse{
+		Results: r,
+	}, err
+}
+
+// QueryPosts handler.
+func (m *GRPCSiteServer) QueryPosts(ctx context.Context, req *protodef.SiteQueryPostsRequest) (
+	resp *protodef.SiteQueryPostsResponse, err error) {
+	q := ambient.PostQuery{}
+	err = ProtobufStructToObject(req.Query, &q)
+	if err != nil {
+		return &protodef.SiteQueryPostsResponse{}, err
+	}
+
+	result, err := m.Impl.QueryPosts(q)
+	if err != nil {
+		return &protodef.SiteQueryPostsResponse{}, err
+	}
+
+	r, err := ObjectToProtobufStruct(result)
+	return &protodef.SiteQueryPostsResponse{
+		Result: r,
+	}, err
+}
+
+// ContentTypes handler.
+func (m *GRPCSiteServer) ContentTypes(ctx context.Context, req *protodef.Empty) (
+	resp *protodef.SiteContentTypesResponse, err error) {
+	types, err := m.Impl.ContentTypes()
+	if err != nil {
+		return &protodef.SiteContentTypesResponse{}, err
+	}
+
+	arr, err := ArrayToProtobufStruct(types)
+	return &protodef.SiteContentTypesResponse{
+		Contenttypes: arr,
+	}, err
+}
+
 // PostByID handler.
 func (m *GRPCSiteServer) PostByID(ctx context.Context, req *protodef.SitePostByIDRequest) (
 	resp *protodef.SitePostByIDResponse, err error) {
@@ -255,6 +305,216 @@ func (m *GRPCSiteServer) DeletePostByID(ctx context.Context, req *protodef.SiteD
 	return &protodef.Empty{}, nil
 }
 
+// SavePostAs handler.
+func (m *GRPCSiteServer) SavePostAs(ctx context.Context, req *protodef.SiteSavePostAsRequest) (
+	resp *protodef.Empty, err error) {
+	c := m.reqmap.Load(req.Requestid)
+	if c == nil {
+		return &protodef.Empty{}, errors.New("could not find request")
+	}
+
+	post := ambient.Post{}
+	err = ProtobufStructToObject(req.Post, &post)
+	if err != nil {
+		return &protodef.Empty{}, err
+	}
+
+	err = m.Impl.SavePostAs(c.Request, req.Id, post)
+	return &protodef.Empty{}, err
+}
+
+// PostRevisions handler.
+func (m *GRPCSiteServer) PostRevisions(ctx context.Context, req *protodef.SitePostRevisionsRequest) (
+	resp *protodef.SitePostRevisionsResponse, err error) {
+	revisions, err := m.Impl.PostRevisions(req.Id)
+	if err != nil {
+		return &protodef.SitePostRevisionsResponse{}, err
+	}
+
+	arr, err := ArrayToProtobufStruct(revisions)
+	return &protodef.SitePostRevisionsResponse{
+		Revisions: arr,
+	}, err
+}
+
+// PostRevision handler.
+func (m *GRPCSiteServer) PostRevision(ctx context.Context, req *protodef.SitePostRevisionRequest) (
+	resp *protodef.SitePostRevisionResponse, err error) {
+	revision, err := m.Impl.PostRevision(req.Id, req.Revisionid)
+	if err != nil {
+		return &protodef.SitePostRevisionResponse{}, err
+	}
+
+	p, err := ObjectToProtobufStruct(revision)
+	return &protodef.SitePostRevisionResponse{
+		Revision: p,
+	}, err
+}
+
+// PostRevisionDiff handler.
+func (m *GRPCSiteServer) PostRevisionDiff(ctx context.Context, req *protodef.SitePostRevisionRequest) (
+	resp *protodef.SitePostRevisionDiffResponse, err error) {
+	diff, err := m.Impl.PostRevisionDiff(req.Id, req.Revisionid)
+	return &protodef.SitePostRevisionDiffResponse{
+		Diff: diff,
+	}, err
+}
+
+// RestorePostRevision handler.
+func (m *GRPCSiteServer) RestorePostRevision(ctx context.Context, req *protodef.SiteRestorePostRevisionRequest) (
+	resp *protodef.Empty, err error) {
+	c := m.reqmap.Load(req.Requestid)
+	if c == nil {
+		return &protodef.Empty{}, errors.New("could not find request")
+	}
+
+	err = m.Impl.RestorePostRevision(c.Request, req.Id, req.Revisionid)
+	return &protodef.Empty{}, err
+}
+
+// CreatePostPreview handler.
+func (m *GRPCSiteServer) CreatePostPreview(ctx context.Context, req *protodef.SiteCreatePostPreviewRequest) (
+	resp *protodef.SiteCreatePostPreviewResponse, err error) {
+	c := m.reqmap.Load(req.Requestid)
+	if c == nil {
+		return &protodef.SiteCreatePostPreviewResponse{}, errors.New("could not find request")
+	}
+
+	preview, err := m.Impl.CreatePostPreview(c.Request, req.Id, time.Duration(req.Expires))
+	if err != nil {
+		return &protodef.SiteCreatePostPreviewResponse{}, err
+	}
+
+	p, err := ObjectToProtobufStruct(preview)
+	return &protodef.SiteCreatePostPreviewResponse{
+		Preview: p,
+	}, err
+}
+
+// PostPreviews handler.
+func (m *GRPCSiteServer) PostPreviews(ctx context.Context, req *protodef.SitePostPreviewsRequest) (
+	resp *protodef.SitePostPreviewsResponse, err error) {
+	previews, err := m.Impl.PostPreviews(req.Id)
+	if err != nil {
+		return &protodef.SitePostPreviewsResponse{}, err
+	}
+
+	arr, err := ArrayToProtobufStruct(previews)
+	return &protodef.SitePostPreviewsResponse{
+		Previews: arr,
+	}, err
+}
+
+// RevokePostPreview handler.
+func (m *GRPCSiteServer) RevokePostPreview(ctx context.Context, req *protodef.SiteRevokePostPreviewRequest) (
+	resp *protodef.Empty, err error) {
+	err = m.Impl.RevokePostPreview(req.Id, req.Previewid)
+	return &protodef.Empty{}, err
+}
+
+// PreviewPostBySlug handler.
+func (m *GRPCSiteServer) PreviewPostBySlug(ctx context.Context, req *protodef.SitePreviewPostBySlugRequest) (
+	resp *protodef.SitePostBySlugResponse, err error) {
+	c := m.reqmap.Load(req.Requestid)
+	if c == nil {
+		return &protodef.SitePostBySlugResponse{}, errors.New("could not find request")
+	}
+
+	post, err := m.Impl.PreviewPostBySlug(c.Request, req.Slug)
+	if err != nil {
+		return &protodef.SitePostBySlugResponse{}, err
+	}
+
+	p, err := ObjectToProtobufStruct(post)
+	return &protodef.SitePostBySlugResponse{
+		Post: p,
+	}, err
+}
+
+// PageChildren handler.
+func (m *GRPCSiteServer) PageChildren(ctx context.Context, req *protodef.SitePageChildrenRequest) (
+	resp *protodef.SitePostsAndPagesResponse, err error) {
+	posts, err := m.Impl.PageChildren(req.Id)
+	if err != nil {
+		return &protodef.SitePostsAndPagesResponse{}, err
+	}
+
+	p, err := ArrayToProtobufStruct(posts)
+	return &protodef.SitePostsAndPagesResponse{
+		Postwithidlist: p,
+	}, err
+}
+
+// Breadcrumbs handler.
+func (m *GRPCSiteServer) Breadcrumbs(ctx context.Context, req *protodef.SiteBreadcrumbsRequest) (
+	resp *protodef.SitePostsAndPagesResponse, err error) {
+	posts, err := m.Impl.Breadcrumbs(req.Id)
+	if err != nil {
+		return &protodef.SitePostsAndPagesResponse{}, err
+	}
+
+	p, err := ArrayToProtobufStruct(posts)
+	return &protodef.SitePostsAndPagesResponse{
+		Postwithidlist: p,
+	}, err
+}
+
+// Menus handler.
+func (m *GRPCSiteServer) Menus(ctx context.Context, req *protodef.Empty) (
+	resp *protodef.SiteMenusResponse, err error) {
+	menus, err := m.Impl.Menus()
+	if err != nil {
+		return &protodef.SiteMenusResponse{}, err
+	}
+
+	arr, err := ArrayToProtobufStruct(menus)
+	return &protodef.SiteMenusResponse{
+		Menus: arr,
+	}, err
+}
+
+// Menu handler.
+func (m *GRPCSiteServer) Menu(ctx context.Context, req *protodef.SiteMenuRequest) (
+	resp *protodef.SiteMenuResponse, err error) {
+	menu, err := m.Impl.Menu(req.Name)
+	if err != nil {
+		return &protodef.SiteMenuResponse{}, err
+	}
+
+	p, err := ObjectToProtobufStruct(menu)
+	return &protodef.SiteMenuResponse{
+		Menu: p,
+	}, err
+}
+
+// SaveMenu handler.
+func (m *GRPCSiteServer) SaveMenu(ctx context.Context, req *protodef.SiteSaveMenuRequest) (
+	resp *protodef.Empty, err error) {
+	menu := ambient.Menu{}
+	err = ProtobufStructToObject(req.Menu, &menu)
+	if err != nil {
+		return &protodef.Empty{}, err
+	}
+
+	err = m.Impl.SaveMenu(menu)
+	if err != nil {
+		return &protodef.Empty{}, err
+	}
+
+	return &protodef.Empty{}, nil
+}
+
+// DeleteMenu handler.
+func (m *GRPCSiteServer) DeleteMenu(ctx context.Context, req *protodef.SiteDeleteMenuRequest) (
+	resp *protodef.Empty, err error) {
+	err = m.Impl.DeleteMenu(req.Name)
+	if err != nil {
+		return &protodef.Empty{}, err
+	}
+
+	return &protodef.Empty{}, nil
+}
+
 // PluginNeighborRoutesList handler.
 func (m *GRPCSiteServer) PluginNeighborRoutesList(ctx context.Context, req *protodef.SitePluginNeighborRoutesListRequest) (
 	resp *protodef.SitePluginNeighborRoutesListResponse, err error) {
@@ -332,6 +592,35 @@ func (m *GRPCSiteServer) LogoutAllUsers(ctx context.Context, req *protodef.SiteL
 	return &protodef.Empty{}, err
 }
 
+// UserSessions handler.
+func (m *GRPCSiteServer) UserSessions(ctx context.Context, req *protodef.SiteUserSessionsRequest) (resp *protodef.SiteUserSessionsResponse, err error) {
+	c := m.reqmap.Load(req.Requestid)
+	if c == nil {
+		return &protodef.SiteUserSessionsResponse{}, errors.New("could not find request")
+	}
+
+	sessions, err := m.Impl.UserSessions(c.Request, req.Username)
+	if err != nil {
+		return &protodef.SiteUserSessionsResponse{}, err
+	}
+
+	arr, err := ArrayToProtobufStruct(sessions)
+	return &protodef.SiteUserSessionsResponse{
+		Sessions: arr,
+	}, err
+}
+
+// LogoutUser handler.
+func (m *GRPCSiteServer) LogoutUser(ctx context.Context, req *protodef.SiteLogoutUserRequest) (resp *protodef.Empty, err error) {
+	c := m.reqmap.Load(req.Requestid)
+	if c == nil {
+		return &protodef.Empty{}, errors.New("could not find request")
+	}
+
+	err = m.Impl.LogoutUser(c.Request, req.Username)
+	return &protodef.Empty{}, err
+}
+
 // SetCSRF handler.
 func (m *GRPCSiteServer) SetCSRF(ctx context.Context, req *protodef.SiteSetCSRFRequest) (resp *protodef.SiteSetCSRFResponse, err error) {
 	c := m.reqmap.Load(req.Requestid)
@@ -657,3 +946,199 @@ func (m *GRPCSiteServer) Tags(ctx context.Context, req *protodef.SiteTagsRequest
 		Tags: tags,
 	}, nil
 }
+
+// TagDetails handler.
+func (m *GRPCSiteServer) TagDetails(ctx context.Context, req *protodef.SiteTagsRequest) (resp *protodef.SiteTagDetailsResponse, err error) {
+	tags, err := m.Impl.TagDetails(req.Onlypublished)
+	if err != nil {
+		return &protodef.SiteTagDetailsResponse{}, err
+	}
+
+	arr, err := ArrayToProtobufStruct(tags)
+	return &protodef.SiteTagDetailsResponse{
+		Tags: arr,
+	}, err
+}
+
+// TagBySlug handler.
+func (m *GRPCSiteServer) TagBySlug(ctx context.Context, req *protodef.SiteTagBySlugRequest) (resp *protodef.SiteTagBySlugResponse, err error) {
+	tag, err := m.Impl.TagBySlug(req.Slug, req.Onlypublished)
+	if err != nil {
+		return &protodef.SiteTagBySlugResponse{}, err
+	}
+
+	t, err := ObjectToProtobufStruct(tag)
+	return &protodef.SiteTagBySlugResponse{
+		Tag: t,
+	}, err
+}
+
+// RenameTag handler.
+func (m *GRPCSiteServer) RenameTag(ctx context.Context, req *protodef.SiteRenameTagRequest) (resp *protodef.Empty, err error) {
+	err = m.Impl.RenameTag(req.Name, req.Newname)
+	if err != nil {
+		return &protodef.Empty{}, err
+	}
+
+	return &protodef.Empty{}, nil
+}
+
+// MergeTags handler.
+func (m *GRPCSiteServer) MergeTags(ctx context.Context, req *protodef.SiteMergeTagsRequest) (resp *protodef.Empty, err error) {
+	err = m.Impl.MergeTags(req.Name, req.Into)
+	if err != nil {
+		return &protodef.Empty{}, err
+	}
+
+	return &protodef.Empty{}, nil
+}
+
+// DeleteTag handler.
+func (m *GRPCSiteServer) DeleteTag(ctx context.Context, req *protodef.SiteDeleteTagRequest) (resp *protodef.Empty, err error) {
+	err = m.Impl.DeleteTag(req.Name)
+	if err != nil {
+		return &protodef.Empty{}, err
+	}
+
+	return &protodef.Empty{}, nil
+}
+
+// SetTagMeta handler.
+func (m *GRPCSiteServer) SetTagMeta(ctx context.Context, req *protodef.SiteSetTagMetaRequest) (resp *protodef.Empty, err error) {
+	meta := ambient.TagMeta{}
+	err = ProtobufStructToObject(req.Meta, &meta)
+	if err != nil {
+		return &protodef.Empty{}, err
+	}
+
+	err = m.Impl.SetTagMeta(req.Name, meta)
+	if err != nil {
+		return &protodef.Empty{}, err
+	}
+
+	return &protodef.Empty{}, nil
+}
+
+// Snapshots handler.
+func (m *GRPCSiteServer) Snapshots(ctx context.Context, req *protodef.Empty) (resp *protodef.SiteSnapshotsResponse, err error) {
+	snapshots, err := m.Impl.Snapshots()
+	if err != nil {
+		return &protodef.SiteSnapshotsResponse{}, err
+	}
+
+	arr, err := ArrayToProtobufStruct(snapshots)
+	return &protodef.SiteSnapshotsResponse{
+		Snapshots: arr,
+	}, err
+}
+
+// CreateSnapshot handler.
+func (m *GRPCSiteServer) CreateSnapshot(ctx context.Context, req *protodef.Empty) (resp *protodef.SiteCreateSnapshotResponse, err error) {
+	snapshot, err := m.Impl.CreateSnapshot()
+	if err != nil {
+		return &protodef.SiteCreateSnapshotResponse{}, err
+	}
+
+	s, err := ObjectToProtobufStruct(snapshot)
+	return &protodef.SiteCreateSnapshotResponse{
+		Snapshot: s,
+	}, err
+}
+
+// SnapshotDiff handler.
+func (m *GRPCSiteServer) SnapshotDiff(ctx context.Context, req *protodef.SiteSnapshotDiffRequest) (resp *protodef.SiteSnapshotDiffResponse, err error) {
+	changes, err := m.Impl.SnapshotDiff(req.Id)
+	if err != nil {
+		return &protodef.SiteSnapshotDiffResponse{}, err
+	}
+
+	arr, err := ArrayToProtobufStruct(changes)
+	return &protodef.SiteSnapshotDiffResponse{
+		Changes: arr,
+	}, err
+}
+
+// RestoreSnapshot handler.
+func (m *GRPCSiteServer) RestoreSnapshot(ctx context.Context, req *protodef.SiteRestoreSnapshotRequest) (resp *protodef.Empty, err error) {
+	err = m.Impl.RestoreSnapshot(req.Id)
+	if err != nil {
+		return &protodef.Empty{}, err
+	}
+
+	return &protodef.Empty{}, nil
+}
+
+// PluginStoreGet handler.
+func (m *GRPCSiteServer) PluginStoreGet(ctx context.Context, req *protodef.SitePluginStoreGetRequest) (resp *protodef.SitePluginStoreGetResponse, err error) {
+	value, err := m.Impl.PluginStoreGet(req.Key)
+	if err != nil {
+		return &protodef.SitePluginStoreGetResponse{}, err
+	}
+
+	return &protodef.SitePluginStoreGetResponse{
+		Value: value,
+	}, nil
+}
+
+// PluginStorePut handler.
+func (m *GRPCSiteServer) PluginStorePut(ctx context.Context, req *protodef.SitePluginStorePutRequest) (resp *protodef.Empty, err error) {
+	err = m.Impl.PluginStorePut(req.Key, req.Value)
+	return &protodef.Empty{}, err
+}
+
+// PluginStoreDelete handler.
+func (m *GRPCSiteServer) PluginStoreDelete(ctx context.Context, req *protodef.SitePluginStoreDeleteRequest) (resp *protodef.Empty, err error) {
+	err = m.Impl.PluginStoreDelete(req.Key)
+	return &protodef.Empty{}, err
+}
+
+// PluginStoreList handler.
+func (m *GRPCSiteServer) PluginStoreList(ctx context.Context, req *protodef.Empty) (resp *protodef.SitePluginStoreListResponse, err error) {
+	keys, err := m.Impl.PluginStoreList()
+	if err != nil {
+		return &protodef.SitePluginStoreListResponse{
+			Keys: make([]string, 0),
+		}, err
+	}
+
+	return &protodef.SitePluginStoreListResponse{
+		Keys: keys,
+	}, nil
+}
+
+// Subscribe handler.
+func (m *GRPCSiteServer) Subscribe(req *protodef.Empty, stream protodef.Site_SubscribeServer) error {
+	events, unsubscribe, err := m.Impl.Subscribe()
+	if err != nil {
+		return err
+	}
+	defer unsubscribe()
+
+	// Let the client know the subscription started.
+	err = stream.SendHeader(metadata.MD{})
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case e, ok := <-events:
+			if !ok {
+				return nil
+			}
+
+			err = stream.Send(&protodef.SiteEvent{
+				Type:      string(e.Type),
+				Id:        e.ID,
+				Plugin:    e.Plugin,
+				Name:      e.Name,
+				Timestamp: timestamppb.New(e.Time),
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,159 @@
+// Package keyring provides storage encryption with AES-GCM that supports more
+// than one key. The ID of the key is written in the header of the ciphertext
+// so data can be decrypted with any known key while new data is always
+// encrypted with the primary key.
+package keyring
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/ambientkit/ambient"
+)
+
+// magic starts every header.
+var magic = []byte{0x00, 'a', 'k', 'r'}
+
+var (
+	// ErrUnknownKey is returned when the data was encrypted with a key that is
+	// not in the keyring.
+	ErrUnknownKey = errors.New("keyring: unknown key")
+	// ErrNoHeader is returned when the data was not encrypted by a keyring and
+	// there is no fallback.
+	ErrNoHeader = errors.New("keyring: missing header")
+)
+
+// Key is a secret with an ID. The secret must be 16, 24, or 32 bytes to
+// select AES-128, AES-192, or AES-256.
+type Key struct {
+	ID     string
+	Secret []byte
+}
+
+// Keyring encrypts with the primary key and decrypts with any key.
+type Keyring struct {
+	primary  string
+	keys     map[string]cipher.AEAD
+	fallback ambient.StorageEncryption
+}
+
+// New returns a keyring that encrypts with the primary key. The other keys
+// are only used to decrypt.
+func New(primary Key, keys ...Key) (*Keyring, error) {
+	k := &Keyring{
+		primary: primary.ID,
+		keys:    make(map[string]cipher.AEAD),
+	}
+
+	for _, key := range append([]Key{primary}, keys...) {
+		if len(key.ID) == 0 || len(key.ID) > 255 {
+			return nil, fmt.Errorf("keyring: key ID must be between 1 and 255 characters: %v", key.ID)
+		} else if _, found := k.keys[key.ID]; found {
+			return nil, fmt.Errorf("keyring: duplicate key ID: %v", key.ID)
+		}
+
+		block, err := aes.NewCipher(key.Secret)
+		if err != nil {
+			return nil, fmt.Errorf("keyring: invalid key (%v): %v", key.ID, err.Error())
+		}
+
+		gcm, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, err
+		}
+
+		k.keys[key.ID] = gcm
+	}
+
+	return k, nil
+}
+
+// WithFallback sets the encryption used to decrypt data that was written
+// before the keyring, like data from a previous StorageEncryption.
+func (k *Keyring) WithFallback(es ambient.StorageEncryption) *Keyring {
+	k.fallback = es
+	return k
+}
+
+// PrimaryID returns the ID of the key used to encrypt.
+func (k *Keyring) PrimaryID() string {
+	return k.primary
+}
+
+// Encrypt encrypts the data with the primary key.
+func (k *Keyring) Encrypt(data []byte) ([]byte, error) {
+	gcm := k.keys[k.primary]
+
+	header := make([]byte, 0, len(magic)+1+len(k.primary))
+	header = append(header, magic...)
+	header = append(header, byte(len(k.primary)))
+	header = append(header, k.primary...)
+
+	nonce := make([]byte, gcm.NonceSize())
+	_, err := io.ReadFull(rand.Reader, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]byte, 0, len(header)+len(nonce)+len(data)+gcm.Overhead())
+	out = append(out, header...)
+	out = append(out, nonce...)
+
+	// The header is authenticated so the key ID can't be changed.
+	return gcm.Seal(out, nonce, data, header), nil
+}
+
+// Decrypt decrypts the data with the key from the header.
+func (k *Keyring) Decrypt(enc []byte) ([]byte, error) {
+	ID, header, err := parseHeader(enc)
+	if errors.Is(err, ErrNoHeader) && k.fallback != nil {
+		return k.fallback.Decrypt(enc)
+	} else if err != nil {
+		return nil, err
+	}
+
+	gcm, found := k.keys[ID]
+	if !found {
+		return nil, fmt.Errorf("%w: %v", ErrUnknownKey, ID)
+	}
+
+	rest := enc[len(header):]
+	if len(rest) < gcm.NonceSize() {
+		return nil, fmt.Errorf("keyring: ciphertext is too short")
+	}
+
+	return gcm.Open(nil, rest[:gcm.NonceSize()], rest[gcm.NonceSize():], header)
+}
+
+// KeyID returns the ID of the key used to encrypt the data.
+func (k *Keyring) KeyID(enc []byte) (string, error) {
+	ID, _, err := parseHeader(enc)
+	return ID, err
+}
+
+// NeedsRotation returns true if the data was not encrypted with the primary
+// key.
+func (k *Keyring) NeedsRotation(enc []byte) bool {
+	ID, err := k.KeyID(enc)
+	return err != nil || ID != k.primary
+}
+
+// parseHeader returns the key ID and the header bytes.
+func parseHeader(enc []byte) (string, []byte, error) {
+	if !bytes.HasPrefix(enc, magic) {
+		return "", nil, ErrNoHeader
+	}
+
+	rest := enc[len(magic):]
+	if len(rest) < 1 || len(rest) < 1+int(rest[0]) {
+		return "", nil, fmt.Errorf("keyring: header is too short")
+	}
+
+	size := len(magic) + 1 + int(rest[0])
+	return string(rest[1 : 1+int(rest[0])]), enc[:size], nil
+}
@@ -0,0 +1,47 @@
+package keyring_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/ambientkit/ambient/pkg/keyring"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyring(t *testing.T) {
+	oldKey := keyring.Key{ID: "2021", Secret: bytes.Repeat([]byte("a"), 32)}
+	newKey := keyring.Key{ID: "2022", Secret: bytes.Repeat([]byte("b"), 32)}
+
+	oldRing, err := keyring.New(oldKey)
+	assert.NoError(t, err)
+	enc, err := oldRing.Encrypt([]byte("secret"))
+	assert.NoError(t, err)
+
+	// The new primary key can still decrypt with the old key.
+	ring, err := keyring.New(newKey, oldKey)
+	assert.NoError(t, err)
+	assert.True(t, ring.NeedsRotation(enc))
+	dec, err := ring.Decrypt(enc)
+	assert.NoError(t, err)
+	assert.Equal(t, "secret", string(dec))
+
+	enc, err = ring.Encrypt(dec)
+	assert.NoError(t, err)
+	assert.False(t, ring.NeedsRotation(enc))
+	ID, err := ring.KeyID(enc)
+	assert.NoError(t, err)
+	assert.Equal(t, "2022", ID)
+
+	// The old keyring doesn't know the new key.
+	_, err = oldRing.Decrypt(enc)
+	assert.True(t, errors.Is(err, keyring.ErrUnknownKey))
+
+	// Changing the key ID in the header should fail.
+	tampered := bytes.Replace(enc, []byte("2022"), []byte("2021"), 1)
+	_, err = ring.Decrypt(tampered)
+	assert.Error(t, err)
+
+	_, err = ring.Decrypt([]byte("plain"))
+	assert.True(t, errors.Is(err, keyring.ErrNoHeader))
+}
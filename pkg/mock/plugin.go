@@ -9,8 +9,9 @@ type Plugin struct {
 	pluginName    string
 	pluginVersion string
 
-	MockGrants []ambient.GrantRequest
-	MockRoutes func(p *ambient.PluginBase)
+	MockGrants       []ambient.GrantRequest
+	MockRoutes       func(p *ambient.PluginBase)
+	MockContentTypes []ambient.ContentType
 }
 
 // NewPlugin returns a new mock plugin.
@@ -42,3 +43,8 @@ func (p *Plugin) GrantRequests() []ambient.GrantRequest {
 func (p *Plugin) Routes() {
 	p.MockRoutes(p.PluginBase)
 }
+
+// ContentTypes returns a list of content types and custom fields for posts.
+func (p *Plugin) ContentTypes() []ambient.ContentType {
+	return p.MockContentTypes
+}
@@ -0,0 +1,393 @@
+package replica
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/ambientkit/ambient"
+)
+
+// item is a key in a collection of a keyed store.
+type item struct {
+	collection string
+	key        string
+}
+
+// keyedStore is a single keyed copy of the data and the items it missed.
+type keyedStore struct {
+	store
+	keyed  ambient.KeyedDataStorer
+	missed map[item]bool
+}
+
+// KeyedStorer writes each item to every store and reads from the first
+// healthy store. It satisfies ambient.KeyedDataStorer. The items a store
+// missed are recorded as they happen. If the collections are set, Check and
+// Repair also compare every item in them so a new mirror is filled in and the
+// divergence is found again after a restart.
+type KeyedStorer struct {
+	log         ambient.Logger
+	stores      []*keyedStore
+	collections func(ks ambient.KeyedDataStorer) ([]string, error)
+
+	m sync.Mutex
+}
+
+// NewKeyed returns a keyed storer that writes to the primary and the mirrors.
+// The mirrors are named mirror1, mirror2, and so on.
+func NewKeyed(log ambient.Logger, primary ambient.KeyedDataStorer, mirrors ...ambient.KeyedDataStorer) *KeyedStorer {
+	r := &KeyedStorer{
+		log: log,
+	}
+
+	for i, ks := range append([]ambient.KeyedDataStorer{primary}, mirrors...) {
+		name := "primary"
+		if i > 0 {
+			name = fmt.Sprintf("mirror%v", i)
+		}
+
+		r.stores = append(r.stores, &keyedStore{
+			store:  store{name: name, healthy: true},
+			keyed:  ks,
+			missed: make(map[item]bool),
+		})
+	}
+
+	return r
+}
+
+// SetCollections sets the function that lists the collections of a store so
+// Check and Repair can compare every item in them.
+func (r *KeyedStorer) SetCollections(fn func(ks ambient.KeyedDataStorer) ([]string, error)) {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	r.collections = fn
+}
+
+// Commit writes the data to the primary and then to each of the mirrors.
+// Returns an error if the primary fails so the mirrors are not ahead of it.
+func (r *KeyedStorer) Commit(collection string, key string, b []byte) error {
+	return r.write(item{collection: collection, key: key}, func(ks ambient.KeyedDataStorer) error {
+		return ks.Commit(collection, key, b)
+	})
+}
+
+// Delete removes the key from the primary and then from each of the mirrors.
+// Returns an error if the primary fails so the mirrors are not ahead of it.
+func (r *KeyedStorer) Delete(collection string, key string) error {
+	return r.write(item{collection: collection, key: key}, func(ks ambient.KeyedDataStorer) error {
+		return ks.Delete(collection, key)
+	})
+}
+
+// Find returns the data from the first healthy store.
+func (r *KeyedStorer) Find(collection string, key string) ([]byte, bool, error) {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	var b []byte
+	var found bool
+	err := r.read(func(ks ambient.KeyedDataStorer) (err error) {
+		b, found, err = ks.Find(collection, key)
+		return err
+	})
+
+	return b, found, err
+}
+
+// Keys returns the keys in the collection from the first healthy store.
+func (r *KeyedStorer) Keys(collection string) ([]string, error) {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	var keys []string
+	err := r.read(func(ks ambient.KeyedDataStorer) (err error) {
+		keys, err = ks.Keys(collection)
+		return err
+	})
+
+	return keys, err
+}
+
+// Divergence returns the stores that failed or missed a write since they were
+// last repaired.
+func (r *KeyedStorer) Divergence() []ambient.ReplicaDivergence {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	return r.divergence()
+}
+
+// Check compares the items in the collections and the items each store
+// missed with the first store that has every write. The stores that don't
+// match are recorded as divergent and the divergence of the stores that match
+// is cleared.
+func (r *KeyedStorer) Check() ([]ambient.ReplicaDivergence, error) {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	source, err := r.source()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, s := range r.stores {
+		if s == source {
+			continue
+		}
+
+		// A store that can't be compared is recorded as divergent.
+		_ = r.compare(source, s)
+	}
+
+	return r.divergence(), nil
+}
+
+// Repair copies the items in the collections that don't match and the items
+// each store missed from the first store that has every write.
+func (r *KeyedStorer) Repair() error {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	source, err := r.source()
+	if err != nil {
+		return err
+	}
+
+	for _, s := range r.stores {
+		if s == source {
+			continue
+		}
+
+		err = r.compare(source, s)
+		if err != nil {
+			return fmt.Errorf("replica: could not compare %v: %w", s.name, err)
+		}
+
+		for _, it := range s.missedItems() {
+			b, found, err := source.keyed.Find(it.collection, it.key)
+			if err != nil {
+				return fmt.Errorf("replica: could not read %v: %w", source.name, err)
+			}
+
+			if found {
+				err = s.keyed.Commit(it.collection, it.key, b)
+			} else {
+				err = s.keyed.Delete(it.collection, it.key)
+			}
+			if err != nil {
+				s.diverge(r.log, err)
+				return fmt.Errorf("replica: could not repair %v: %w", s.name, err)
+			}
+
+			delete(s.missed, it)
+		}
+
+		if s.divergence != nil {
+			r.log.Info("replica: repaired %v from %v", s.name, source.name)
+			s.heal()
+		}
+	}
+
+	return nil
+}
+
+// write runs the change on the primary and then on each of the mirrors. The
+// mirrors that fail record the item as missed.
+func (r *KeyedStorer) write(it item, change func(ks ambient.KeyedDataStorer) error) error {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	for i, s := range r.stores {
+		err := change(s.keyed)
+		if err != nil {
+			s.diverge(r.log, err)
+			if i == 0 {
+				// None of the stores have the change.
+				return fmt.Errorf("replica: could not write to %v: %w", s.name, err)
+			}
+			s.missed[it] = true
+			continue
+		}
+
+		// The store has the latest copy of the item. A store that didn't
+		// miss any other item is healthy again.
+		delete(s.missed, it)
+		if len(s.missed) == 0 {
+			s.heal()
+		}
+	}
+
+	return nil
+}
+
+// read runs the read on the first healthy store that didn't miss a write.
+// The other stores are tried last. The caller must hold the lock.
+func (r *KeyedStorer) read(fn func(ks ambient.KeyedDataStorer) error) error {
+	var lastErr error
+	for _, current := range []bool{true, false} {
+		for _, s := range r.stores {
+			if (s.healthy && len(s.missed) == 0) != current {
+				continue
+			}
+
+			err := fn(s.keyed)
+			if err != nil {
+				s.diverge(r.log, err)
+				lastErr = err
+				continue
+			}
+
+			if !s.healthy && len(s.missed) == 0 {
+				// The store can be read again, but it may have missed writes
+				// that aren't recorded so it's still divergent until it's
+				// checked or repaired.
+				s.healthy = true
+			}
+
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: %v", ErrNoStore, lastErr)
+}
+
+// compare records the items that don't match the source as missed. The items
+// in the collections are compared if they are set, else only the items the
+// store already missed. The store is recorded as divergent if any item
+// doesn't match or it can't be read, else its divergence is cleared. The
+// caller must hold the lock.
+func (r *KeyedStorer) compare(source *keyedStore, s *keyedStore) error {
+	items := s.missedItems()
+	if r.collections != nil {
+		collections, err := r.collections(source.keyed)
+		if err != nil {
+			return err
+		}
+
+		for _, c := range collections {
+			keys, err := allKeys(c, source, s)
+			if err != nil {
+				s.diverge(r.log, err)
+				return err
+			}
+			for _, key := range keys {
+				items = append(items, item{collection: c, key: key})
+			}
+		}
+	}
+
+	for _, it := range items {
+		same, err := sameItem(source, s, it)
+		if err != nil {
+			s.diverge(r.log, err)
+			return err
+		} else if same {
+			delete(s.missed, it)
+		} else {
+			s.missed[it] = true
+		}
+	}
+
+	if len(s.missed) > 0 {
+		s.diverge(r.log, fmt.Errorf("%v items do not match %v", len(s.missed), source.name))
+	} else if s.divergence != nil {
+		s.heal()
+	}
+
+	return nil
+}
+
+// allKeys returns the keys of the collection in any of the stores.
+func allKeys(collection string, stores ...*keyedStore) ([]string, error) {
+	seen := make(map[string]bool)
+	keys := make([]string, 0)
+	for _, s := range stores {
+		arr, err := s.keyed.Keys(collection)
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range arr {
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+
+	return keys, nil
+}
+
+// source returns the first store that can be read and didn't miss a write.
+// The store is marked as healthy since it has every write. The caller must
+// hold the lock.
+func (r *KeyedStorer) source() (*keyedStore, error) {
+	lastErr := errors.New("every store missed a write")
+	for _, s := range r.stores {
+		if len(s.missed) > 0 {
+			continue
+		}
+
+		_, err := s.keyed.Keys("")
+		if err != nil {
+			s.diverge(r.log, err)
+			lastErr = err
+			continue
+		}
+
+		s.heal()
+
+		return s, nil
+	}
+
+	return nil, fmt.Errorf("%w: %v", ErrNoStore, lastErr)
+}
+
+// sameItem returns true if the item is the same in both stores. The caller
+// must hold the lock.
+func sameItem(source *keyedStore, s *keyedStore, it item) (bool, error) {
+	want, wantFound, err := source.keyed.Find(it.collection, it.key)
+	if err != nil {
+		return false, err
+	}
+
+	b, found, err := s.keyed.Find(it.collection, it.key)
+	if err != nil {
+		return false, err
+	}
+
+	return found == wantFound && bytes.Equal(b, want), nil
+}
+
+// divergence returns the divergence of each store. The caller must hold the
+// lock.
+func (r *KeyedStorer) divergence() []ambient.ReplicaDivergence {
+	arr := make([]ambient.ReplicaDivergence, 0)
+	for _, s := range r.stores {
+		if s.divergence != nil {
+			arr = append(arr, *s.divergence)
+		}
+	}
+
+	return arr
+}
+
+// missedItems returns the items the store missed in order.
+func (s *keyedStore) missedItems() []item {
+	arr := make([]item, 0, len(s.missed))
+	for it := range s.missed {
+		arr = append(arr, it)
+	}
+	sort.Slice(arr, func(i, j int) bool {
+		if arr[i].collection != arr[j].collection {
+			return arr[i].collection < arr[j].collection
+		}
+		return arr[i].key < arr[j].key
+	})
+
+	return arr
+}
@@ -0,0 +1,75 @@
+package replica
+
+import (
+	"github.com/ambientkit/ambient"
+	"github.com/ambientkit/ambient/pkg/amberror"
+)
+
+// batcher is implemented by storers that can group many writes into a single
+// write to the underlying store.
+type batcher interface {
+	Batch(fn func() error) error
+}
+
+// ChangeToken returns the change token of the primary. Returns
+// amberror.ErrChangeTokenNotSupported if the primary doesn't have one.
+func (r *Storer) ChangeToken() (string, error) {
+	return changeToken(r.stores[0].storer)
+}
+
+// Watch watches the primary for changes. Returns
+// amberror.ErrWatchNotSupported if the primary can't be watched.
+func (r *Storer) Watch(changed func()) (func(), error) {
+	return watch(r.stores[0].storer, changed)
+}
+
+// Batch groups the writes in the function if the primary supports it. The
+// mirrors receive each write as it's made.
+func (r *Storer) Batch(fn func() error) error {
+	return batch(r.stores[0].storer, fn)
+}
+
+// ChangeToken returns the change token of the primary. Returns
+// amberror.ErrChangeTokenNotSupported if the primary doesn't have one.
+func (r *KeyedStorer) ChangeToken() (string, error) {
+	return changeToken(r.stores[0].keyed)
+}
+
+// Watch watches the primary for changes. Returns
+// amberror.ErrWatchNotSupported if the primary can't be watched.
+func (r *KeyedStorer) Watch(changed func()) (func(), error) {
+	return watch(r.stores[0].keyed, changed)
+}
+
+// Batch groups the writes in the function if the primary supports it. The
+// mirrors receive each write as it's made.
+func (r *KeyedStorer) Batch(fn func() error) error {
+	return batch(r.stores[0].keyed, fn)
+}
+
+// changeToken returns the change token of the store if it has one.
+func changeToken(s interface{}) (string, error) {
+	if t, ok := s.(ambient.ChangeTokenStorer); ok {
+		return t.ChangeToken()
+	}
+
+	return "", amberror.ErrChangeTokenNotSupported
+}
+
+// watch watches the store for changes if it can be watched.
+func watch(s interface{}, changed func()) (func(), error) {
+	if w, ok := s.(ambient.WatchableStorer); ok {
+		return w.Watch(changed)
+	}
+
+	return nil, amberror.ErrWatchNotSupported
+}
+
+// batch runs the function in a batch of the store if it supports it.
+func batch(s interface{}, fn func() error) error {
+	if b, ok := s.(batcher); ok {
+		return b.Batch(fn)
+	}
+
+	return fn()
+}
@@ -0,0 +1,230 @@
+// Package replica provides a storer that writes to a primary store and any
+// number of mirrors. Reads come from the first healthy store so the site keeps
+// loading when a store fails. Writes fail if the primary fails. Mirrors that
+// fail or miss a write are recorded as divergent until they are repaired.
+package replica
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ambientkit/ambient"
+)
+
+// ErrNoStore is returned when none of the stores can be read or written.
+var ErrNoStore = errors.New("replica: no store is available")
+
+// store is a single copy of the data.
+type store struct {
+	name       string
+	storer     ambient.DataStorer
+	healthy    bool
+	divergence *ambient.ReplicaDivergence
+}
+
+// Storer writes to every store and reads from the first healthy store. It
+// satisfies both ambient.DataStorer and ambient.SessionStorer.
+type Storer struct {
+	log    ambient.Logger
+	stores []*store
+
+	m sync.Mutex
+}
+
+// New returns a storer that writes to the primary and the mirrors. The
+// mirrors are named mirror1, mirror2, and so on.
+func New(log ambient.Logger, primary ambient.DataStorer, mirrors ...ambient.DataStorer) *Storer {
+	r := &Storer{
+		log: log,
+	}
+
+	r.stores = append(r.stores, &store{name: "primary", storer: primary, healthy: true})
+	for i, m := range mirrors {
+		r.stores = append(r.stores, &store{name: fmt.Sprintf("mirror%v", i+1), storer: m, healthy: true})
+	}
+
+	return r
+}
+
+// Save writes the data to the primary and then to each of the mirrors.
+// Returns an error if the primary fails so the mirrors are not ahead of it.
+// Mirrors that fail are recorded as divergent.
+func (r *Storer) Save(data []byte) error {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	for i, s := range r.stores {
+		err := s.storer.Save(data)
+		if err != nil {
+			s.diverge(r.log, err)
+			if i == 0 {
+				return fmt.Errorf("replica: could not write to %v: %w", s.name, err)
+			}
+			continue
+		}
+
+		s.heal()
+	}
+
+	return nil
+}
+
+// Load returns the data from the first healthy store. The unhealthy stores
+// are tried last.
+func (r *Storer) Load() ([]byte, error) {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	_, b, err := r.load()
+	return b, err
+}
+
+// Divergence returns the stores that failed or missed a write since they were
+// last repaired.
+func (r *Storer) Divergence() []ambient.ReplicaDivergence {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	arr := make([]ambient.ReplicaDivergence, 0)
+	for _, s := range r.stores {
+		if s.divergence != nil {
+			arr = append(arr, *s.divergence)
+		}
+	}
+
+	return arr
+}
+
+// Check compares every store with the first healthy store and records the
+// ones that don't match. The store that is read from is the reference so its
+// divergence is cleared.
+func (r *Storer) Check() ([]ambient.ReplicaDivergence, error) {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	source, b, err := r.load()
+	if err != nil {
+		return nil, err
+	}
+	source.heal()
+
+	for _, s := range r.stores {
+		if s == source {
+			continue
+		}
+
+		r.compare(s, b)
+	}
+
+	arr := make([]ambient.ReplicaDivergence, 0)
+	for _, s := range r.stores {
+		if s.divergence != nil {
+			arr = append(arr, *s.divergence)
+		}
+	}
+
+	return arr, nil
+}
+
+// Repair copies the data from the first healthy store to every store that
+// doesn't match it.
+func (r *Storer) Repair() error {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	source, b, err := r.load()
+	if err != nil {
+		return err
+	}
+	source.heal()
+
+	for _, s := range r.stores {
+		if s == source || !r.compare(s, b) {
+			continue
+		}
+
+		err = s.storer.Save(b)
+		if err != nil {
+			s.diverge(r.log, err)
+			return fmt.Errorf("replica: could not repair %v: %w", s.name, err)
+		}
+
+		r.log.Info("replica: repaired %v from %v", s.name, source.name)
+		s.heal()
+	}
+
+	return nil
+}
+
+// load returns the data and the store it was read from. The caller must hold
+// the lock.
+func (r *Storer) load() (*store, []byte, error) {
+	var lastErr error
+	for _, healthy := range []bool{true, false} {
+		for _, s := range r.stores {
+			if s.healthy != healthy {
+				continue
+			}
+
+			b, err := s.storer.Load()
+			if err != nil {
+				s.diverge(r.log, err)
+				lastErr = err
+				continue
+			}
+
+			if !healthy {
+				// The store can be read again, but it may have missed writes
+				// so it's still divergent until it's repaired.
+				s.healthy = true
+			}
+
+			return s, b, nil
+		}
+	}
+
+	return nil, nil, fmt.Errorf("%w: %v", ErrNoStore, lastErr)
+}
+
+// compare records the store as divergent if it can't be read or doesn't match
+// the data, else clears the divergence. Returns true if the store is
+// divergent. The caller must hold the lock.
+func (r *Storer) compare(s *store, data []byte) bool {
+	b, err := s.storer.Load()
+	if err != nil {
+		s.diverge(r.log, err)
+		return true
+	}
+
+	if !bytes.Equal(b, data) {
+		s.diverge(r.log, errors.New("data does not match"))
+		return true
+	}
+
+	s.heal()
+
+	return false
+}
+
+// diverge marks the store as unhealthy and records the error. The caller must
+// hold the lock.
+func (s *store) diverge(log ambient.Logger, err error) {
+	log.Warn("replica: %v store diverged: %v", s.name, err.Error())
+
+	s.healthy = false
+	s.divergence = &ambient.ReplicaDivergence{
+		Store: s.name,
+		Error: err.Error(),
+		Time:  time.Now().UTC(),
+	}
+}
+
+// heal marks the store as healthy and clears the divergence. The caller must
+// hold the lock.
+func (s *store) heal() {
+	s.healthy = true
+	s.divergence = nil
+}
@@ -0,0 +1,395 @@
+package replica_test
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ambientkit/ambient"
+	"github.com/ambientkit/ambient/pkg/amberror"
+	"github.com/ambientkit/ambient/pkg/mock"
+	"github.com/ambientkit/ambient/pkg/replica"
+	"github.com/stretchr/testify/assert"
+)
+
+// failingStore is a memory store that fails while down is set.
+type failingStore struct {
+	*mock.MemoryStore
+	down bool
+}
+
+func (s *failingStore) Load() ([]byte, error) {
+	if s.down {
+		return nil, errors.New("store is down")
+	}
+	return s.MemoryStore.Load()
+}
+
+func (s *failingStore) Save(b []byte) error {
+	if s.down {
+		return errors.New("store is down")
+	}
+	return s.MemoryStore.Save(b)
+}
+
+func TestReplica(t *testing.T) {
+	log, err := mock.NewLoggerPlugin(nil).Logger("test", "1.0", nil)
+	assert.NoError(t, err)
+
+	primary := &failingStore{MemoryStore: mock.NewMemoryStore()}
+	mirror := &failingStore{MemoryStore: mock.NewMemoryStore()}
+
+	r := replica.New(log, primary, mirror)
+	var _ ambient.DataStorer = r
+	var _ ambient.SessionStorer = r
+
+	// Writes go to every store.
+	assert.NoError(t, r.Save([]byte("a")))
+	b, _ := mirror.MemoryStore.Load()
+	assert.Equal(t, "a", string(b))
+	assert.Len(t, r.Divergence(), 0)
+
+	// A mirror that misses a write is recorded.
+	mirror.down = true
+	assert.NoError(t, r.Save([]byte("b")))
+	div := r.Divergence()
+	assert.Len(t, div, 1)
+	assert.Equal(t, "mirror1", div[0].Store)
+
+	// Reads fail over to the mirror when the primary is down.
+	mirror.down = false
+	primary.down = true
+	b, err = r.Load()
+	assert.NoError(t, err)
+	assert.Equal(t, "a", string(b))
+	assert.Len(t, r.Divergence(), 2)
+
+	// Writes fail if the primary fails so the mirror isn't ahead of it.
+	assert.Error(t, r.Save([]byte("c")))
+	b, _ = mirror.MemoryStore.Load()
+	assert.Equal(t, "a", string(b))
+
+	// Reads fail only if every store fails.
+	mirror.down = true
+	_, err = r.Load()
+	assert.True(t, errors.Is(err, replica.ErrNoStore))
+
+	// Repair copies the primary to the mirror.
+	primary.down = false
+	mirror.down = false
+	div, err = r.Check()
+	assert.NoError(t, err)
+	assert.Len(t, div, 1)
+	assert.Equal(t, "mirror1", div[0].Store)
+
+	assert.NoError(t, r.Repair())
+	assert.Len(t, r.Divergence(), 0)
+	b, _ = mirror.MemoryStore.Load()
+	assert.Equal(t, "b", string(b))
+	b, err = r.Load()
+	assert.NoError(t, err)
+	assert.Equal(t, "b", string(b))
+
+	// A mirror changed outside of the replica is found by a check.
+	assert.NoError(t, mirror.MemoryStore.Save([]byte("x")))
+	div, err = r.Check()
+	assert.NoError(t, err)
+	assert.Len(t, div, 1)
+	assert.NoError(t, r.Repair())
+	b, _ = mirror.MemoryStore.Load()
+	assert.Equal(t, "b", string(b))
+}
+
+// failingKeyedStore is a keyed memory store that fails while down is set.
+type failingKeyedStore struct {
+	data map[string][]byte
+	down bool
+}
+
+func newFailingKeyedStore() *failingKeyedStore {
+	return &failingKeyedStore{data: make(map[string][]byte)}
+}
+
+func (s *failingKeyedStore) Commit(collection string, key string, b []byte) error {
+	if s.down {
+		return errors.New("store is down")
+	}
+	s.data[collection+"/"+key] = b
+	return nil
+}
+
+func (s *failingKeyedStore) Find(collection string, key string) ([]byte, bool, error) {
+	if s.down {
+		return nil, false, errors.New("store is down")
+	}
+	b, found := s.data[collection+"/"+key]
+	return b, found, nil
+}
+
+func (s *failingKeyedStore) Delete(collection string, key string) error {
+	if s.down {
+		return errors.New("store is down")
+	}
+	delete(s.data, collection+"/"+key)
+	return nil
+}
+
+func (s *failingKeyedStore) Keys(collection string) ([]string, error) {
+	if s.down {
+		return nil, errors.New("store is down")
+	}
+	keys := make([]string, 0)
+	for k := range s.data {
+		if strings.HasPrefix(k, collection+"/") {
+			keys = append(keys, strings.TrimPrefix(k, collection+"/"))
+		}
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+func TestKeyedReplica(t *testing.T) {
+	log, err := mock.NewLoggerPlugin(nil).Logger("test", "1.0", nil)
+	assert.NoError(t, err)
+
+	primary := newFailingKeyedStore()
+	mirror := newFailingKeyedStore()
+
+	r := replica.NewKeyed(log, primary, mirror)
+	var _ ambient.KeyedDataStorer = r
+
+	// Writes go to every store.
+	assert.NoError(t, r.Commit("posts", "1", []byte("a")))
+	assert.Equal(t, "a", string(mirror.data["posts/1"]))
+	assert.Len(t, r.Divergence(), 0)
+
+	// A mirror that misses a write is recorded.
+	mirror.down = true
+	assert.NoError(t, r.Commit("posts", "2", []byte("b")))
+	assert.NoError(t, r.Delete("posts", "1"))
+	div := r.Divergence()
+	assert.Len(t, div, 1)
+	assert.Equal(t, "mirror1", div[0].Store)
+
+	// Writes fail if the primary fails.
+	mirror.down = false
+	primary.down = true
+	assert.Error(t, r.Commit("posts", "3", []byte("c")))
+	_, found, _ := mirror.Find("posts", "3")
+	assert.False(t, found)
+
+	// Reads fail over to the mirror when the primary is down.
+	keys, err := r.Keys("posts")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1"}, keys)
+
+	// Repair copies the items the mirror missed from the primary.
+	primary.down = false
+	div, err = r.Check()
+	assert.NoError(t, err)
+	assert.Len(t, div, 1)
+	assert.NoError(t, r.Repair())
+	assert.Len(t, r.Divergence(), 0)
+	keys, err = mirror.Keys("posts")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"2"}, keys)
+	b, found, err := r.Find("posts", "2")
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "b", string(b))
+}
+
+// tokenKeyedStore is a keyed store with a change token.
+type tokenKeyedStore struct {
+	*failingKeyedStore
+}
+
+func (s *tokenKeyedStore) ChangeToken() (string, error) {
+	return fmt.Sprint(len(s.data)), nil
+}
+
+func TestKeyedReplicaOptional(t *testing.T) {
+	log, err := mock.NewLoggerPlugin(nil).Logger("test", "1.0", nil)
+	assert.NoError(t, err)
+
+	// The change token of the primary is used.
+	r := replica.NewKeyed(log, &tokenKeyedStore{newFailingKeyedStore()}, newFailingKeyedStore())
+	assert.NoError(t, r.Commit("posts", "1", []byte("a")))
+	token, err := r.ChangeToken()
+	assert.NoError(t, err)
+	assert.Equal(t, "1", token)
+
+	// A primary without a change token or a watch has neither.
+	r = replica.NewKeyed(log, newFailingKeyedStore(), &tokenKeyedStore{newFailingKeyedStore()})
+	_, err = r.ChangeToken()
+	assert.True(t, errors.Is(err, amberror.ErrChangeTokenNotSupported))
+	_, err = r.Watch(func() {})
+	assert.True(t, errors.Is(err, amberror.ErrWatchNotSupported))
+
+	// Writes in a batch still go to every store.
+	mirror := newFailingKeyedStore()
+	r = replica.NewKeyed(log, newFailingKeyedStore(), mirror)
+	assert.NoError(t, r.Batch(func() error {
+		return r.Commit("posts", "1", []byte("a"))
+	}))
+	assert.Equal(t, "a", string(mirror.data["posts/1"]))
+}
+
+// failingSessions is a keyed session store that fails while down is set.
+type failingSessions struct {
+	*failingStore
+	sessions map[string]ambient.UserSession
+	data     map[string][]byte
+}
+
+func newFailingSessions() *failingSessions {
+	return &failingSessions{
+		failingStore: &failingStore{MemoryStore: mock.NewMemoryStore()},
+		sessions:     make(map[string]ambient.UserSession),
+		data:         make(map[string][]byte),
+	}
+}
+
+func (s *failingSessions) Commit(token string, username string, b []byte, expiry time.Time) error {
+	if s.down {
+		return errors.New("store is down")
+	}
+	s.sessions[token] = ambient.UserSession{ID: token, Username: username, Expires: expiry}
+	s.data[token] = b
+	return nil
+}
+
+func (s *failingSessions) Find(token string) ([]byte, bool, error) {
+	if s.down {
+		return nil, false, errors.New("store is down")
+	}
+	b, found := s.data[token]
+	return b, found, nil
+}
+
+func (s *failingSessions) Delete(token string) error {
+	if s.down {
+		return errors.New("store is down")
+	}
+	delete(s.sessions, token)
+	delete(s.data, token)
+	return nil
+}
+
+func (s *failingSessions) UserSessions(username string) ([]ambient.UserSession, error) {
+	if s.down {
+		return nil, errors.New("store is down")
+	}
+	arr := make([]ambient.UserSession, 0)
+	for _, us := range s.sessions {
+		if us.Username == username {
+			arr = append(arr, us)
+		}
+	}
+	return arr, nil
+}
+
+func (s *failingSessions) DeleteUserSessions(username string) error {
+	if s.down {
+		return errors.New("store is down")
+	}
+	for token, us := range s.sessions {
+		if us.Username == username {
+			delete(s.sessions, token)
+			delete(s.data, token)
+		}
+	}
+	return nil
+}
+
+func TestKeyedSessionReplica(t *testing.T) {
+	log, err := mock.NewLoggerPlugin(nil).Logger("test", "1.0", nil)
+	assert.NoError(t, err)
+
+	primary := newFailingSessions()
+	mirror := newFailingSessions()
+
+	r := replica.NewKeyedSession(log, primary, mirror)
+	var _ ambient.KeyedSessionStorer = r
+	var _ ambient.SessionStorer = r
+
+	// Sessions are written to every store.
+	expiry := time.Now().Add(time.Hour)
+	assert.NoError(t, r.Commit("a", "user", []byte("1"), expiry))
+	assert.Equal(t, "1", string(mirror.data["a"]))
+
+	// A mirror that misses writes gets them again in order.
+	mirror.down = true
+	assert.NoError(t, r.Commit("b", "user", []byte("2"), expiry))
+	assert.NoError(t, r.DeleteUserSessions("user"))
+	assert.NoError(t, r.Commit("c", "user", []byte("3"), expiry))
+	assert.Len(t, r.Divergence(), 1)
+
+	// Reads fail if none of the stores can be read.
+	primary.down = true
+	_, _, err = r.Find("c")
+	assert.Error(t, err)
+
+	primary.down = false
+	mirror.down = false
+	div, err := r.Check()
+	assert.NoError(t, err)
+	assert.Len(t, div, 1)
+	assert.NoError(t, r.Repair())
+	assert.Len(t, r.Divergence(), 0)
+	assert.Len(t, mirror.data, 1)
+	assert.Equal(t, "3", string(mirror.data["c"]))
+
+	// The primary doesn't support rewriting sessions.
+	err = r.Rewrite(func(b []byte) ([]byte, error) { return b, nil })
+	assert.True(t, errors.Is(err, amberror.ErrSessionRewriteNotSupported))
+}
+
+func TestKeyedReplicaCollections(t *testing.T) {
+	log, err := mock.NewLoggerPlugin(nil).Logger("test", "1.0", nil)
+	assert.NoError(t, err)
+
+	primary := newFailingKeyedStore()
+	assert.NoError(t, primary.Commit("posts", "1", []byte("a")))
+	assert.NoError(t, primary.Commit("site", "site", []byte("s")))
+	mirror := newFailingKeyedStore()
+	assert.NoError(t, mirror.Commit("posts", "2", []byte("old")))
+
+	r := replica.NewKeyed(log, primary, mirror)
+	r.SetCollections(func(ks ambient.KeyedDataStorer) ([]string, error) {
+		return []string{"posts", "site"}, nil
+	})
+
+	// A new mirror is compared with every item in the collections.
+	div, err := r.Check()
+	assert.NoError(t, err)
+	assert.Len(t, div, 1)
+
+	// Reads fail over to the mirror, but then prefer the store that didn't
+	// miss a write.
+	primary.down = true
+	keys, err := r.Keys("posts")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"2"}, keys)
+	primary.down = false
+	keys, err = r.Keys("posts")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1"}, keys)
+
+	// Repair fills in the mirror.
+	assert.NoError(t, r.Repair())
+	assert.Len(t, r.Divergence(), 0)
+	assert.Equal(t, primary.data, mirror.data)
+
+	// A write to the primary after a failed read heals it.
+	primary.down = true
+	_, _, err = r.Find("posts", "1")
+	assert.NoError(t, err)
+	assert.Len(t, r.Divergence(), 1)
+	primary.down = false
+	assert.NoError(t, r.Commit("posts", "3", []byte("c")))
+	assert.Len(t, r.Divergence(), 0)
+}
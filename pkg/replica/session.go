@@ -0,0 +1,254 @@
+package replica
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ambientkit/ambient"
+	"github.com/ambientkit/ambient/pkg/amberror"
+)
+
+// sessionWrite is a write to a keyed session store.
+type sessionWrite func(ks ambient.KeyedSessionStorer) error
+
+// sessionStore is a keyed copy of the sessions and the writes it missed.
+type sessionStore struct {
+	keyed  ambient.KeyedSessionStorer
+	missed []sessionWrite
+}
+
+// KeyedSessionStorer writes each session to every store that stores
+// sessions by token and reads from the first healthy store. It satisfies
+// ambient.SessionStorer and ambient.KeyedSessionStorer. The sessions can't
+// be listed so the writes a store missed are kept in order and written again
+// before its next write or when it's repaired.
+type KeyedSessionStorer struct {
+	*Storer
+	sessions []*sessionStore
+}
+
+// NewKeyedSession returns a session storer that writes to the primary and the
+// mirrors. The primary should be an ambient.KeyedSessionStorer, else the
+// sessions can't be read or written by token. Mirrors that can't store
+// sessions by token only receive the session data.
+func NewKeyedSession(log ambient.Logger, primary ambient.SessionStorer, mirrors ...ambient.SessionStorer) *KeyedSessionStorer {
+	ds := make([]ambient.DataStorer, 0, len(mirrors))
+	for _, m := range mirrors {
+		ds = append(ds, m)
+	}
+
+	r := &KeyedSessionStorer{
+		Storer: New(log, primary, ds...),
+	}
+
+	for i, ss := range append([]ambient.SessionStorer{primary}, mirrors...) {
+		ks, ok := ss.(ambient.KeyedSessionStorer)
+		if !ok {
+			log.Warn("replica: %v can't store sessions by token so it only receives the session data", r.stores[i].name)
+		}
+		r.sessions = append(r.sessions, &sessionStore{keyed: ks})
+	}
+
+	return r
+}
+
+// Commit writes the session to the primary and then to each of the mirrors.
+// Returns an error if the primary fails so the mirrors are not ahead of it.
+func (r *KeyedSessionStorer) Commit(token string, username string, b []byte, expiry time.Time) error {
+	return r.write(func(ks ambient.KeyedSessionStorer) error {
+		return ks.Commit(token, username, b, expiry)
+	})
+}
+
+// Find returns the session from the first healthy store.
+func (r *KeyedSessionStorer) Find(token string) ([]byte, bool, error) {
+	var b []byte
+	var found bool
+	err := r.read(func(ks ambient.KeyedSessionStorer) (err error) {
+		b, found, err = ks.Find(token)
+		return err
+	})
+
+	return b, found, err
+}
+
+// Delete removes the session from the primary and then from each of the
+// mirrors.
+func (r *KeyedSessionStorer) Delete(token string) error {
+	return r.write(func(ks ambient.KeyedSessionStorer) error {
+		return ks.Delete(token)
+	})
+}
+
+// UserSessions returns the sessions of the user from the first healthy store.
+func (r *KeyedSessionStorer) UserSessions(username string) ([]ambient.UserSession, error) {
+	var sessions []ambient.UserSession
+	err := r.read(func(ks ambient.KeyedSessionStorer) (err error) {
+		sessions, err = ks.UserSessions(username)
+		return err
+	})
+
+	return sessions, err
+}
+
+// DeleteUserSessions removes the sessions of the user from the primary and
+// then from each of the mirrors.
+func (r *KeyedSessionStorer) DeleteUserSessions(username string) error {
+	return r.write(func(ks ambient.KeyedSessionStorer) error {
+		return ks.DeleteUserSessions(username)
+	})
+}
+
+// Rewrite replaces the data of every session in the primary and then in each
+// of the mirrors that supports it. Returns
+// amberror.ErrSessionRewriteNotSupported if the primary doesn't support it.
+func (r *KeyedSessionStorer) Rewrite(fn func(b []byte) ([]byte, error)) error {
+	if _, ok := r.sessions[0].keyed.(ambient.SessionRewriter); !ok {
+		return amberror.ErrSessionRewriteNotSupported
+	}
+
+	return r.write(func(ks ambient.KeyedSessionStorer) error {
+		rw, ok := ks.(ambient.SessionRewriter)
+		if !ok {
+			// The sessions are written again with the new data when they
+			// change.
+			return nil
+		}
+		return rw.Rewrite(fn)
+	})
+}
+
+// Check compares the session data of every store and records the stores
+// that still have missed session writes as divergent.
+func (r *KeyedSessionStorer) Check() ([]ambient.ReplicaDivergence, error) {
+	_, err := r.Storer.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	for i, ss := range r.sessions {
+		if len(ss.missed) > 0 {
+			r.stores[i].diverge(r.log, fmt.Errorf("missed %v session writes", len(ss.missed)))
+		}
+	}
+
+	arr := make([]ambient.ReplicaDivergence, 0)
+	for _, s := range r.stores {
+		if s.divergence != nil {
+			arr = append(arr, *s.divergence)
+		}
+	}
+
+	return arr, nil
+}
+
+// Repair copies the session data to the stores that don't match it and then
+// writes the session writes each store missed.
+func (r *KeyedSessionStorer) Repair() error {
+	err := r.Storer.Repair()
+	if err != nil {
+		return err
+	}
+
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	for i, ss := range r.sessions {
+		if len(ss.missed) == 0 {
+			continue
+		}
+
+		err = r.replay(i)
+		if err != nil {
+			return fmt.Errorf("replica: could not repair %v: %w", r.stores[i].name, err)
+		}
+
+		r.log.Info("replica: repaired %v sessions", r.stores[i].name)
+	}
+
+	return nil
+}
+
+// write runs the change on the primary and then on each of the mirrors. A
+// mirror that missed writes gets them first so the writes stay in order. The
+// mirrors that fail keep the write to run it again later.
+func (r *KeyedSessionStorer) write(change sessionWrite) error {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	if r.sessions[0].keyed == nil {
+		return fmt.Errorf("%w: primary can't store sessions by token", ErrNoStore)
+	}
+
+	for i, ss := range r.sessions {
+		if ss.keyed == nil {
+			continue
+		}
+
+		if i == 0 {
+			err := change(ss.keyed)
+			if err != nil {
+				r.stores[i].diverge(r.log, err)
+				// None of the stores have the change.
+				return fmt.Errorf("replica: could not write to %v: %w", r.stores[i].name, err)
+			}
+			r.stores[i].heal()
+			continue
+		}
+
+		ss.missed = append(ss.missed, change)
+		_ = r.replay(i)
+	}
+
+	return nil
+}
+
+// replay runs the writes the store missed in order and stops at the first one
+// that fails. The store is healed once it has every write. The caller must
+// hold the lock.
+func (r *KeyedSessionStorer) replay(i int) error {
+	ss, s := r.sessions[i], r.stores[i]
+	for len(ss.missed) > 0 {
+		err := ss.missed[0](ss.keyed)
+		if err != nil {
+			s.diverge(r.log, err)
+			return err
+		}
+		ss.missed = ss.missed[1:]
+	}
+
+	s.heal()
+
+	return nil
+}
+
+// read runs the read on the first healthy store that has every session
+// write. The other stores are tried last.
+func (r *KeyedSessionStorer) read(fn func(ks ambient.KeyedSessionStorer) error) error {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	var lastErr error
+	for _, current := range []bool{true, false} {
+		for i, ss := range r.sessions {
+			s := r.stores[i]
+			if ss.keyed == nil || (s.healthy && len(ss.missed) == 0) != current {
+				continue
+			}
+
+			err := fn(ss.keyed)
+			if err != nil {
+				s.diverge(r.log, err)
+				lastErr = err
+				continue
+			}
+
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: %v", ErrNoStore, lastErr)
+}
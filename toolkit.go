@@ -3,8 +3,10 @@ package ambient
 import (
 	"encoding/json"
 	"fmt"
+	"html/template"
 	"net/http"
 	"os"
+	"time"
 )
 
 // Toolkit provides utilities to plugins.
@@ -62,3 +64,31 @@ func (t *Toolkit) sendJSON(w http.ResponseWriter, status int, response []byte) e
 
 	return nil
 }
+
+// PreviewPost renders the post with the slug using the post template. The post
+// is rendered if it's published or if the request has a valid preview token
+// for it in the query string. The post is passed to the template as "post" and
+// its title as "title". A preview of an unpublished post is not cached or
+// indexed since the token is private.
+func (t *Toolkit) PreviewPost(w http.ResponseWriter, r *http.Request, slug string, assets FileSystemReader,
+	templateName string, fm func(r *http.Request) template.FuncMap, vars map[string]interface{}) error {
+	post, err := t.Site.PreviewPostBySlug(r, slug)
+	if err != nil {
+		return t.Site.Error(err)
+	}
+
+	if !post.IsPublished(time.Now()) {
+		w.Header().Set("Cache-Control", "no-store")
+		w.Header().Set("X-Robots-Tag", "noindex")
+	}
+
+	// Copy the variables so the post isn't left in the caller's map.
+	m := make(map[string]interface{}, len(vars)+2)
+	for k, v := range vars {
+		m[k] = v
+	}
+	m["title"] = post.Title
+	m["post"] = post
+
+	return t.Render.Post(w, r, assets, templateName, fm, m)
+}
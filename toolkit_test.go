@@ -0,0 +1,59 @@
+package ambient_test
+
+import (
+	"html/template"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ambientkit/ambient"
+	"github.com/stretchr/testify/assert"
+)
+
+// previewSite returns the draft post only with the preview token.
+type previewSite struct {
+	ambient.SecureSite
+}
+
+func (previewSite) PreviewPostBySlug(r *http.Request, slug string) (ambient.PostWithID, error) {
+	if slug == "draft" && r.URL.Query().Get(ambient.PreviewTokenParam) == "token" {
+		return ambient.PostWithID{ID: "1", Post: ambient.Post{Title: "Draft", URL: "draft"}}, nil
+	}
+
+	return ambient.PostWithID{}, ambient.StatusError{Code: http.StatusNotFound}
+}
+
+func (previewSite) Error(siteError error) error {
+	return siteError
+}
+
+// postRenderer records the variables passed to the post template.
+type postRenderer struct {
+	ambient.Renderer
+	vars map[string]interface{}
+}
+
+func (p *postRenderer) Post(w http.ResponseWriter, r *http.Request, assets ambient.FileSystemReader, templateName string,
+	fm func(r *http.Request) template.FuncMap, vars map[string]interface{}) error {
+	p.vars = vars
+	return nil
+}
+
+func TestToolkitPreviewPost(t *testing.T) {
+	render := &postRenderer{}
+	tk := &ambient.Toolkit{Site: previewSite{}, Render: render}
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("GET", "/draft?preview=token", nil)
+	vars := map[string]interface{}{"theme": "dark"}
+	assert.NoError(t, tk.PreviewPost(w, r, "draft", nil, "post", nil, vars))
+	assert.Equal(t, "Draft", render.vars["title"])
+	assert.Equal(t, "dark", render.vars["theme"])
+	assert.Len(t, vars, 1)
+	assert.Equal(t, "1", render.vars["post"].(ambient.PostWithID).ID)
+	assert.Equal(t, "no-store", w.Header().Get("Cache-Control"))
+
+	r = httptest.NewRequest("GET", "/draft?preview=wrong", nil)
+	err := tk.PreviewPost(httptest.NewRecorder(), r, "draft", nil, "post", nil, nil)
+	assert.Equal(t, http.StatusNotFound, err.(ambient.StatusError).Code)
+}